@@ -0,0 +1,191 @@
+// Command reindex rebuilds the autocomplete index from the products table.
+//
+// The new index is built into a shadow key and swapped in with one RENAME,
+// searches keep using the old index meanwhile. Progress is checkpointed with
+// every batch, an interrupted run resumes where it stopped unless -restart
+// is given. With -verify nothing is written, the index is compared with the
+// products table and the command exits with status 1 when they differ.
+package main
+
+import (
+	"context"
+	"database/sql"
+	"flag"
+	"log"
+	"os"
+	"time"
+
+	"github.com/google/uuid"
+	_ "github.com/lib/pq"
+	db "github.com/siddheshRajendraNimbalkar/collage-prject-backend/db/sqlc"
+	redisClient "github.com/siddheshRajendraNimbalkar/collage-prject-backend/internal/redis"
+	"github.com/siddheshRajendraNimbalkar/collage-prject-backend/internal/search"
+	"github.com/siddheshRajendraNimbalkar/collage-prject-backend/util"
+)
+
+// maxListed caps how many product ids of each kind -verify prints.
+const maxListed = 20
+
+func main() {
+	verify := flag.Bool("verify", false, "compare the index with the products table instead of rebuilding it")
+	restart := flag.Bool("restart", false, "discard an interrupted run instead of resuming it")
+	batchSize := flag.Int("batch", 500, "products written per round trip")
+	flag.Parse()
+
+	if *batchSize <= 0 {
+		log.Fatalf("-batch must be greater than zero")
+	}
+
+	config, err := util.LoadConfig(".")
+	if err != nil {
+		log.Fatalf("Error loading config: %v", err)
+	}
+
+	// The same Redis as the server, or the swap lands on an index nobody reads
+	redisURL := redisClient.ResolveURL(config.RedisURL)
+	log.Printf("Using Redis URL: %s", redisURL)
+
+	if err := redisClient.InitRedis(redisURL); err != nil {
+		log.Fatalf("Failed to connect to Redis: %v", err)
+	}
+
+	conn, err := sql.Open(config.DBDriver, config.DBSource)
+	if err != nil {
+		log.Fatalf("Cannot connect to database: %v", err)
+	}
+	defer conn.Close()
+
+	ctx := context.Background()
+	store := db.NewStore(conn)
+
+	index := search.NewRedisIndex(redisClient.Client, search.NewDictionaryCache(store))
+	if err := index.MigrateLegacyKeys(ctx); err != nil {
+		log.Fatalf("Failed to migrate search index: %v", err)
+	}
+
+	if *verify {
+		if !verifyIndex(ctx, store, index, int32(*batchSize)) {
+			os.Exit(1)
+		}
+		return
+	}
+
+	reindex(ctx, store, index, *restart, int32(*batchSize))
+}
+
+func reindex(ctx context.Context, store *db.SQLStore, index *search.RedisIndex, restart bool, batchSize int32) {
+	checkpoint, err := index.LoadCheckpoint(ctx)
+	if err != nil {
+		log.Fatalf("Failed to load checkpoint: %v", err)
+	}
+
+	if checkpoint != nil && restart {
+		log.Printf("Discarding the run started at %s", checkpoint.StartedAt.Format("2006-01-02 15:04:05"))
+		checkpoint = nil
+	}
+
+	if checkpoint == nil {
+		// Changes queued from now on are replayed after the swap
+		outboxID, err := store.SearchOutboxHighWater(ctx)
+		if err != nil {
+			log.Fatalf("Failed to read the search outbox: %v", err)
+		}
+		if err := index.DiscardReindex(ctx); err != nil {
+			log.Fatalf("Failed to clear the shadow index: %v", err)
+		}
+		checkpoint = &search.Checkpoint{OutboxID: outboxID, StartedAt: time.Now().UTC()}
+	} else {
+		log.Printf("Resuming after %d products", checkpoint.Indexed)
+	}
+
+	total, err := store.CountPublishedProducts(ctx)
+	if err != nil {
+		log.Fatalf("Failed to count products: %v", err)
+	}
+
+	next := productPages(ctx, store, batchSize, checkpoint)
+	for {
+		docs, err := next()
+		if err != nil {
+			log.Fatalf("Failed to get products: %v", err)
+		}
+		if len(docs) == 0 {
+			break
+		}
+
+		checkpoint.Indexed += int64(len(docs))
+		if err := index.IndexShadow(ctx, docs, *checkpoint); err != nil {
+			log.Fatalf("Failed to index products: %v", err)
+		}
+		log.Printf("Indexed %d of %d products", checkpoint.Indexed, max(total, checkpoint.Indexed))
+	}
+
+	if err := index.SwapShadow(ctx); err != nil {
+		log.Fatalf("Failed to swap in the new index: %v", err)
+	}
+
+	requeued, err := store.RequeueSearchOutboxAfter(ctx, checkpoint.OutboxID)
+	if err != nil {
+		log.Fatalf("Failed to requeue changes made during the reindex: %v", err)
+	}
+
+	log.Printf("Reindexed %d products in %s, %d changes made meanwhile requeued",
+		checkpoint.Indexed, time.Since(checkpoint.StartedAt).Round(time.Second), requeued)
+}
+
+func verifyIndex(ctx context.Context, store *db.SQLStore, index *search.RedisIndex, batchSize int32) bool {
+	diff, err := index.Verify(ctx, productPages(ctx, store, batchSize, &search.Checkpoint{}))
+	if err != nil {
+		log.Fatalf("Failed to verify the index: %v", err)
+	}
+
+	report("missing from the index", diff.Missing)
+	report("outdated in the index", diff.Outdated)
+	report("in the index but not searchable", diff.Stale)
+
+	if diff.Empty() {
+		log.Printf("The index matches the products table")
+		return true
+	}
+	return false
+}
+
+// productPages pages through searchable products after the checkpoint's
+// position and advances it with every page.
+func productPages(ctx context.Context, store *db.SQLStore, batchSize int32, checkpoint *search.Checkpoint) func() ([]search.Document, error) {
+	return func() ([]search.Document, error) {
+		params := db.GetAllProductsParams{LimitCount: batchSize}
+		if checkpoint.AfterID != uuid.Nil {
+			params.AfterCreatedAt = sql.NullTime{Time: checkpoint.AfterCreatedAt, Valid: true}
+			params.AfterID = uuid.NullUUID{UUID: checkpoint.AfterID, Valid: true}
+		}
+
+		products, err := store.GetAllProducts(ctx, params)
+		if err != nil || len(products) == 0 {
+			return nil, err
+		}
+
+		last := products[len(products)-1]
+		checkpoint.AfterCreatedAt = last.CreatedAt.Time
+		checkpoint.AfterID = last.ID
+
+		docs := make([]search.Document, 0, len(products))
+		for _, product := range products {
+			docs = append(docs, search.ProductDocument(product))
+		}
+		return docs, nil
+	}
+}
+
+func report(kind string, ids []string) {
+	if len(ids) == 0 {
+		return
+	}
+	log.Printf("%d products %s", len(ids), kind)
+	for _, id := range ids[:min(len(ids), maxListed)] {
+		log.Printf("  %s", id)
+	}
+	if len(ids) > maxListed {
+		log.Printf("  and %d more", len(ids)-maxListed)
+	}
+}
@@ -0,0 +1,10 @@
+ALTER TABLE orders DROP CONSTRAINT orders_product_id_fkey;
+ALTER TABLE orders
+    ADD CONSTRAINT orders_product_id_fkey
+        FOREIGN KEY (product_id) REFERENCES products(id) ON DELETE CASCADE;
+
+DROP INDEX IF EXISTS products_status_created_at_idx;
+
+ALTER TABLE products
+    DROP COLUMN IF EXISTS deleted_at,
+    DROP COLUMN IF EXISTS status;
@@ -0,0 +1,12 @@
+ALTER TABLE products
+    ADD COLUMN status VARCHAR(20) NOT NULL DEFAULT 'published'
+        CHECK (status IN ('draft', 'published', 'archived')),
+    ADD COLUMN deleted_at TIMESTAMP;
+
+CREATE INDEX products_status_created_at_idx ON products (status, created_at DESC) WHERE deleted_at IS NULL;
+
+-- Orders are sales history and must outlive the product they point at.
+ALTER TABLE orders DROP CONSTRAINT orders_product_id_fkey;
+ALTER TABLE orders
+    ADD CONSTRAINT orders_product_id_fkey
+        FOREIGN KEY (product_id) REFERENCES products(id) ON DELETE RESTRICT;
@@ -0,0 +1,10 @@
+DROP INDEX IF EXISTS products_category_id_idx;
+
+ALTER TABLE products DROP COLUMN IF EXISTS category_id;
+
+ALTER TABLE users DROP CONSTRAINT users_role_check;
+ALTER TABLE users
+    ADD CONSTRAINT users_role_check
+        CHECK (role IN ('college_staff', 'ngo_staff', 'self_staff'));
+
+DROP TABLE IF EXISTS categories;
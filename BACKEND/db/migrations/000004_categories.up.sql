@@ -0,0 +1,53 @@
+CREATE TABLE categories (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    parent_id UUID REFERENCES categories(id) ON DELETE RESTRICT,
+    slug VARCHAR(100) NOT NULL,
+    name VARCHAR(100) NOT NULL,
+    icon TEXT NOT NULL DEFAULT '',
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP
+);
+
+-- Slugs are unique among siblings; root categories share the nil parent.
+CREATE UNIQUE INDEX categories_parent_slug_idx
+    ON categories (COALESCE(parent_id, '00000000-0000-0000-0000-000000000000'::uuid), slug);
+
+ALTER TABLE users DROP CONSTRAINT users_role_check;
+ALTER TABLE users
+    ADD CONSTRAINT users_role_check
+        CHECK (role IN ('college_staff', 'ngo_staff', 'self_staff', 'admin'));
+
+ALTER TABLE products ADD COLUMN category_id UUID REFERENCES categories(id) ON DELETE RESTRICT;
+
+-- Map the existing free-text category/type pairs onto a two level tree:
+-- every category becomes a root and every type a child of its category.
+INSERT INTO categories (slug, name)
+SELECT DISTINCT
+    trim(both '-' from regexp_replace(lower(trim(category)), '[^a-z0-9]+', '-', 'g')),
+    initcap(lower(trim(category)))
+FROM products
+ON CONFLICT DO NOTHING;
+
+INSERT INTO categories (parent_id, slug, name)
+SELECT DISTINCT
+    root.id,
+    trim(both '-' from regexp_replace(lower(trim(p.type)), '[^a-z0-9]+', '-', 'g')),
+    initcap(lower(trim(p.type)))
+FROM products p
+JOIN categories root
+    ON root.parent_id IS NULL
+   AND root.slug = trim(both '-' from regexp_replace(lower(trim(p.category)), '[^a-z0-9]+', '-', 'g'))
+ON CONFLICT DO NOTHING;
+
+UPDATE products p
+SET category_id = child.id,
+    category = root.slug,
+    type = child.slug
+FROM categories root
+JOIN categories child ON child.parent_id = root.id
+WHERE root.parent_id IS NULL
+  AND root.slug = trim(both '-' from regexp_replace(lower(trim(p.category)), '[^a-z0-9]+', '-', 'g'))
+  AND child.slug = trim(both '-' from regexp_replace(lower(trim(p.type)), '[^a-z0-9]+', '-', 'g'));
+
+ALTER TABLE products ALTER COLUMN category_id SET NOT NULL;
+
+CREATE INDEX products_category_id_idx ON products (category_id);
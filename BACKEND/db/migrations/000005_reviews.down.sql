@@ -0,0 +1,6 @@
+ALTER TABLE products
+    DROP COLUMN IF EXISTS rating_count,
+    DROP COLUMN IF EXISTS rating_average;
+
+DROP TABLE IF EXISTS review_votes;
+DROP TABLE IF EXISTS reviews;
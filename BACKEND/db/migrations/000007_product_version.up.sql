@@ -0,0 +1,3 @@
+-- Bumped on every write that staff could otherwise overwrite, used for
+-- optimistic concurrency in UpdateProduct
+ALTER TABLE products ADD COLUMN version INT NOT NULL DEFAULT 1;
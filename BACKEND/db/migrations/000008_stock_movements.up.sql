@@ -0,0 +1,35 @@
+CREATE TABLE stock_movements (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    product_id UUID NOT NULL REFERENCES products(id) ON DELETE RESTRICT,
+    delta INT NOT NULL CHECK (delta <> 0),
+    stock_after INT NOT NULL CHECK (stock_after >= 0),
+    reason VARCHAR(30) NOT NULL CHECK (reason IN ('initial', 'sale', 'cancel', 'return', 'manual_adjustment', 'import')),
+    actor_id UUID REFERENCES users(id) ON DELETE SET NULL,
+    reference_id UUID,
+    note TEXT NOT NULL DEFAULT '',
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE INDEX stock_movements_product_id_idx ON stock_movements (product_id, created_at DESC);
+
+-- The ledger is append-only, corrections are new movements. The one update
+-- let through is ON DELETE SET NULL clearing actor_id when a user is deleted.
+CREATE FUNCTION stock_movements_append_only() RETURNS trigger AS $$
+BEGIN
+    IF TG_OP = 'UPDATE' AND OLD.actor_id IS NOT NULL AND NEW.actor_id IS NULL
+       AND (to_jsonb(NEW) - 'actor_id') = (to_jsonb(OLD) - 'actor_id') THEN
+        RETURN NEW;
+    END IF;
+    RAISE EXCEPTION 'stock_movements is append-only';
+END;
+$$ LANGUAGE plpgsql;
+
+CREATE TRIGGER stock_movements_append_only
+BEFORE UPDATE OR DELETE ON stock_movements
+FOR EACH ROW EXECUTE FUNCTION stock_movements_append_only();
+
+-- Opening balance so the ledger adds up to the current stock
+INSERT INTO stock_movements (product_id, delta, stock_after, reason, actor_id, note)
+SELECT id, stock, stock, 'initial', created_by, 'opening balance'
+FROM products
+WHERE stock <> 0;
@@ -0,0 +1,8 @@
+DROP INDEX IF EXISTS notifications_pending_email_idx;
+
+ALTER TABLE notifications
+    DROP COLUMN IF EXISTS email_attempts,
+    DROP COLUMN IF EXISTS emailed_at,
+    DROP COLUMN IF EXISTS send_email;
+
+ALTER TABLE products DROP COLUMN IF EXISTS low_stock_threshold;
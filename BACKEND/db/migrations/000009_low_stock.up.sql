@@ -0,0 +1,12 @@
+ALTER TABLE products
+    ADD COLUMN low_stock_threshold INT NOT NULL DEFAULT 0 CHECK (low_stock_threshold >= 0);
+
+-- Notifications can also go out by email, the dispatcher picks up the
+-- pending ones and records delivery here
+ALTER TABLE notifications
+    ADD COLUMN send_email BOOLEAN NOT NULL DEFAULT false,
+    ADD COLUMN emailed_at TIMESTAMP,
+    ADD COLUMN email_attempts INT NOT NULL DEFAULT 0;
+
+CREATE INDEX notifications_pending_email_idx ON notifications (created_at)
+    WHERE send_email AND emailed_at IS NULL;
@@ -0,0 +1,27 @@
+-- List prices are recorded whenever products.price changes, sales are
+-- scheduled ahead with a fixed window. products.price stays the current list
+-- price, the effective price is the lower of it and a running sale.
+CREATE TABLE product_prices (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    product_id UUID NOT NULL REFERENCES products(id) ON DELETE RESTRICT,
+    price DECIMAL(10,2) NOT NULL CHECK (price > 0),
+    kind VARCHAR(10) NOT NULL CHECK (kind IN ('list', 'sale')),
+    valid_from TIMESTAMP NOT NULL,
+    valid_to TIMESTAMP,
+    created_by UUID REFERENCES users(id) ON DELETE SET NULL,
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    CHECK (valid_to IS NULL OR valid_to > valid_from),
+    CHECK (kind = 'list' OR valid_to IS NOT NULL)
+);
+
+CREATE INDEX product_prices_product_id_idx ON product_prices (product_id, valid_from DESC);
+
+-- Price windows are UTC like the queries that open and close them, while
+-- products.created_at holds the session's local time
+INSERT INTO product_prices (product_id, price, kind, valid_from, created_by)
+SELECT id, price, 'list',
+       LEAST(COALESCE(created_at AT TIME ZONE current_setting('TimeZone') AT TIME ZONE 'UTC',
+                      NOW() AT TIME ZONE 'UTC'),
+             NOW() AT TIME ZONE 'UTC'),
+       created_by
+FROM products;
@@ -0,0 +1,38 @@
+-- scope_id is the seller's user id, a category id (including its
+-- subcategories) or a product id depending on scope
+CREATE TABLE coupons (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    code VARCHAR(50) NOT NULL,
+    discount_type VARCHAR(20) NOT NULL CHECK (discount_type IN ('percentage', 'fixed')),
+    amount DECIMAL(10,2) NOT NULL CHECK (amount > 0),
+    min_order_value DECIMAL(10,2) NOT NULL DEFAULT 0 CHECK (min_order_value >= 0),
+    max_uses INT CHECK (max_uses > 0),
+    max_uses_per_user INT CHECK (max_uses_per_user > 0),
+    used_count INT NOT NULL DEFAULT 0 CHECK (used_count >= 0),
+    valid_from TIMESTAMP NOT NULL,
+    valid_to TIMESTAMP,
+    scope VARCHAR(20) NOT NULL CHECK (scope IN ('seller', 'category', 'product')),
+    scope_id UUID NOT NULL,
+    created_by UUID REFERENCES users(id) ON DELETE SET NULL,
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    CHECK (discount_type <> 'percentage' OR amount <= 100),
+    CHECK (valid_to IS NULL OR valid_to > valid_from)
+);
+
+-- Codes are stored upper case
+CREATE UNIQUE INDEX coupons_code_idx ON coupons (code);
+
+ALTER TABLE orders
+    ADD COLUMN discount_total DECIMAL(10,2) NOT NULL DEFAULT 0 CHECK (discount_total >= 0);
+
+CREATE TABLE order_discounts (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    order_id UUID NOT NULL REFERENCES orders(id) ON DELETE CASCADE,
+    coupon_id UUID NOT NULL REFERENCES coupons(id) ON DELETE RESTRICT,
+    code VARCHAR(50) NOT NULL,
+    amount DECIMAL(10,2) NOT NULL CHECK (amount > 0),
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE INDEX order_discounts_order_id_idx ON order_discounts (order_id);
+CREATE INDEX order_discounts_coupon_id_idx ON order_discounts (coupon_id);
@@ -0,0 +1,13 @@
+ALTER TABLE products
+    ADD COLUMN kind VARCHAR(20) NOT NULL DEFAULT 'physical' CHECK (kind IN ('physical', 'bundle'));
+
+-- A bundle holds no stock of its own, it is sold out of its components
+CREATE TABLE bundle_items (
+    bundle_id UUID NOT NULL REFERENCES products(id) ON DELETE CASCADE,
+    component_id UUID NOT NULL REFERENCES products(id) ON DELETE RESTRICT,
+    quantity INT NOT NULL CHECK (quantity > 0),
+    PRIMARY KEY (bundle_id, component_id),
+    CHECK (bundle_id <> component_id)
+);
+
+CREATE INDEX bundle_items_component_id_idx ON bundle_items (component_id);
@@ -0,0 +1,7 @@
+DROP TABLE IF EXISTS download_grants;
+DROP TABLE IF EXISTS digital_assets;
+
+DELETE FROM products WHERE kind = 'digital';
+ALTER TABLE products DROP CONSTRAINT IF EXISTS products_kind_check;
+ALTER TABLE products
+    ADD CONSTRAINT products_kind_check CHECK (kind IN ('physical', 'bundle'));
@@ -0,0 +1,28 @@
+ALTER TABLE products DROP CONSTRAINT IF EXISTS products_kind_check;
+ALTER TABLE products
+    ADD CONSTRAINT products_kind_check CHECK (kind IN ('physical', 'bundle', 'digital'));
+
+-- The file behind a digital product, kept in the configured storage
+CREATE TABLE digital_assets (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    product_id UUID NOT NULL UNIQUE REFERENCES products(id) ON DELETE CASCADE,
+    storage_key TEXT NOT NULL,
+    file_name VARCHAR(255) NOT NULL,
+    content_type VARCHAR(255) NOT NULL,
+    size_bytes BIGINT NOT NULL CHECK (size_bytes >= 0),
+    download_limit INT NOT NULL DEFAULT 5 CHECK (download_limit > 0),
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP
+);
+
+-- One grant per completed order, the limit is copied from the asset when
+-- the first link is issued
+CREATE TABLE download_grants (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    order_id UUID NOT NULL UNIQUE REFERENCES orders(id) ON DELETE CASCADE,
+    asset_id UUID NOT NULL REFERENCES digital_assets(id) ON DELETE CASCADE,
+    user_id UUID NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    download_limit INT NOT NULL CHECK (download_limit > 0),
+    download_count INT NOT NULL DEFAULT 0 CHECK (download_count >= 0),
+    last_downloaded_at TIMESTAMP,
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP
+);
@@ -0,0 +1,6 @@
+DROP TABLE IF EXISTS answer_flags;
+DROP TABLE IF EXISTS question_flags;
+DROP TABLE IF EXISTS answer_votes;
+DROP TABLE IF EXISTS question_votes;
+DROP TABLE IF EXISTS product_answers;
+DROP TABLE IF EXISTS product_questions;
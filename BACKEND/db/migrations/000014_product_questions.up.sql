@@ -0,0 +1,57 @@
+CREATE TABLE product_questions (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    product_id UUID NOT NULL REFERENCES products(id) ON DELETE CASCADE,
+    user_id UUID NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    body TEXT NOT NULL,
+    upvote_count INT NOT NULL DEFAULT 0,
+    flag_count INT NOT NULL DEFAULT 0,
+    hidden BOOLEAN NOT NULL DEFAULT false,
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE INDEX product_questions_product_id_idx ON product_questions (product_id, created_at DESC);
+
+CREATE TABLE product_answers (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    question_id UUID NOT NULL REFERENCES product_questions(id) ON DELETE CASCADE,
+    user_id UUID NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    body TEXT NOT NULL,
+    upvote_count INT NOT NULL DEFAULT 0,
+    flag_count INT NOT NULL DEFAULT 0,
+    hidden BOOLEAN NOT NULL DEFAULT false,
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE INDEX product_answers_question_id_idx ON product_answers (question_id);
+
+CREATE TABLE question_votes (
+    question_id UUID NOT NULL REFERENCES product_questions(id) ON DELETE CASCADE,
+    user_id UUID NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    PRIMARY KEY (question_id, user_id)
+);
+
+CREATE TABLE answer_votes (
+    answer_id UUID NOT NULL REFERENCES product_answers(id) ON DELETE CASCADE,
+    user_id UUID NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    PRIMARY KEY (answer_id, user_id)
+);
+
+-- One flag per user and post, enough flags hide the post until an admin
+-- reviews it
+CREATE TABLE question_flags (
+    question_id UUID NOT NULL REFERENCES product_questions(id) ON DELETE CASCADE,
+    user_id UUID NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    reason VARCHAR(50) NOT NULL,
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    PRIMARY KEY (question_id, user_id)
+);
+
+CREATE TABLE answer_flags (
+    answer_id UUID NOT NULL REFERENCES product_answers(id) ON DELETE CASCADE,
+    user_id UUID NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    reason VARCHAR(50) NOT NULL,
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    PRIMARY KEY (answer_id, user_id)
+);
@@ -0,0 +1,14 @@
+DROP TABLE IF EXISTS moderation_log;
+DROP INDEX IF EXISTS products_pending_review_idx;
+
+UPDATE products SET status = 'draft' WHERE status IN ('pending_review', 'rejected');
+ALTER TABLE products DROP CONSTRAINT products_status_check;
+ALTER TABLE products
+    ADD CONSTRAINT products_status_check
+        CHECK (status IN ('draft', 'published', 'archived'));
+
+UPDATE users SET role = 'self_staff' WHERE role = 'moderator';
+ALTER TABLE users DROP CONSTRAINT users_role_check;
+ALTER TABLE users
+    ADD CONSTRAINT users_role_check
+        CHECK (role IN ('college_staff', 'ngo_staff', 'self_staff', 'admin'));
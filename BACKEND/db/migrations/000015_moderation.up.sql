@@ -0,0 +1,26 @@
+-- Moderators review listings, like admins they are promoted in the database
+ALTER TABLE users DROP CONSTRAINT users_role_check;
+ALTER TABLE users
+    ADD CONSTRAINT users_role_check
+        CHECK (role IN ('college_staff', 'ngo_staff', 'self_staff', 'admin', 'moderator'));
+
+ALTER TABLE products DROP CONSTRAINT products_status_check;
+ALTER TABLE products
+    ADD CONSTRAINT products_status_check
+        CHECK (status IN ('draft', 'pending_review', 'published', 'rejected', 'archived'));
+
+CREATE INDEX products_pending_review_idx ON products (created_at)
+    WHERE status = 'pending_review' AND deleted_at IS NULL;
+
+-- Every moderation decision, moderator_id is NULL for the automated pre-screen
+CREATE TABLE moderation_log (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    product_id UUID NOT NULL REFERENCES products(id) ON DELETE CASCADE,
+    moderator_id UUID REFERENCES users(id) ON DELETE SET NULL,
+    action VARCHAR(20) NOT NULL
+        CHECK (action IN ('submitted', 'approved', 'rejected')),
+    reason TEXT NOT NULL DEFAULT '',
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE INDEX moderation_log_product_id_idx ON moderation_log (product_id, created_at DESC);
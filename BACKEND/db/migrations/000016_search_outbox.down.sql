@@ -0,0 +1,4 @@
+DROP TRIGGER IF EXISTS products_search_outbox_update ON products;
+DROP TRIGGER IF EXISTS products_search_outbox_insert_delete ON products;
+DROP FUNCTION IF EXISTS products_search_outbox();
+DROP TABLE IF EXISTS search_outbox;
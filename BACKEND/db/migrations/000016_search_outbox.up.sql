@@ -0,0 +1,39 @@
+-- Every product change queues the product for the search index in the same
+-- transaction, a background worker applies the queue to Redis and retries
+-- until it succeeds
+CREATE TABLE search_outbox (
+    id BIGSERIAL PRIMARY KEY,
+    product_id UUID NOT NULL,
+    attempts INT NOT NULL DEFAULT 0,
+    last_error TEXT NOT NULL DEFAULT '',
+    next_attempt_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    processed_at TIMESTAMP,
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE INDEX search_outbox_pending_idx ON search_outbox (next_attempt_at)
+    WHERE processed_at IS NULL;
+
+CREATE FUNCTION products_search_outbox() RETURNS trigger AS $$
+BEGIN
+    IF TG_OP = 'DELETE' THEN
+        INSERT INTO search_outbox (product_id) VALUES (OLD.id);
+        RETURN OLD;
+    END IF;
+    INSERT INTO search_outbox (product_id) VALUES (NEW.id);
+    RETURN NEW;
+END;
+$$ LANGUAGE plpgsql;
+
+CREATE TRIGGER products_search_outbox_insert_delete
+AFTER INSERT OR DELETE ON products
+FOR EACH ROW EXECUTE FUNCTION products_search_outbox();
+
+CREATE TRIGGER products_search_outbox_update
+AFTER UPDATE ON products
+FOR EACH ROW WHEN (OLD.* IS DISTINCT FROM NEW.*)
+EXECUTE FUNCTION products_search_outbox();
+
+-- Start from a clean slate, whatever drift the index has picked up so far
+INSERT INTO search_outbox (product_id)
+SELECT id FROM products;
@@ -0,0 +1,7 @@
+DROP TRIGGER IF EXISTS search_languages_backfill ON search_languages;
+DROP FUNCTION IF EXISTS search_languages_backfill();
+DROP TRIGGER IF EXISTS products_search_document ON products;
+DROP FUNCTION IF EXISTS products_search_document();
+DROP FUNCTION IF EXISTS product_search_document(REGCONFIG, products);
+DROP TABLE IF EXISTS product_search;
+DROP TABLE IF EXISTS search_languages;
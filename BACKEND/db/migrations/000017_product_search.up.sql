@@ -0,0 +1,57 @@
+-- Full-text search documents, one per product and text search configuration
+-- so a query is matched against stems of the language it was written in
+CREATE TABLE search_languages (
+    name TEXT PRIMARY KEY
+);
+
+INSERT INTO search_languages (name) VALUES ('english'), ('simple');
+
+CREATE TABLE product_search (
+    product_id UUID NOT NULL REFERENCES products(id) ON DELETE CASCADE,
+    language TEXT NOT NULL REFERENCES search_languages(name) ON DELETE CASCADE,
+    document TSVECTOR NOT NULL,
+    PRIMARY KEY (product_id, language)
+);
+
+CREATE INDEX product_search_document_idx ON product_search USING GIN (document);
+
+-- Name weighs the most, then category and type, then the description
+CREATE FUNCTION product_search_document(cfg REGCONFIG, p products) RETURNS tsvector AS $$
+    SELECT setweight(to_tsvector(cfg, coalesce(p.name, '')), 'A')
+        || setweight(to_tsvector(cfg, coalesce(p.category, '')), 'B')
+        || setweight(to_tsvector(cfg, coalesce(p.type, '')), 'B')
+        || setweight(to_tsvector(cfg, coalesce(p.description, '')), 'C');
+$$ LANGUAGE sql IMMUTABLE;
+
+CREATE FUNCTION products_search_document() RETURNS trigger AS $$
+BEGIN
+    INSERT INTO product_search (product_id, language, document)
+    SELECT NEW.id, l.name, product_search_document(l.name::regconfig, NEW)
+    FROM search_languages l
+    ON CONFLICT (product_id, language) DO UPDATE SET document = EXCLUDED.document;
+    RETURN NEW;
+END;
+$$ LANGUAGE plpgsql;
+
+CREATE TRIGGER products_search_document
+AFTER INSERT OR UPDATE OF name, description, category, type ON products
+FOR EACH ROW EXECUTE FUNCTION products_search_document();
+
+-- A language added later is filled in for every product
+CREATE FUNCTION search_languages_backfill() RETURNS trigger AS $$
+BEGIN
+    INSERT INTO product_search (product_id, language, document)
+    SELECT p.id, NEW.name, product_search_document(NEW.name::regconfig, p)
+    FROM products p
+    ON CONFLICT (product_id, language) DO UPDATE SET document = EXCLUDED.document;
+    RETURN NEW;
+END;
+$$ LANGUAGE plpgsql;
+
+CREATE TRIGGER search_languages_backfill
+AFTER INSERT ON search_languages
+FOR EACH ROW EXECUTE FUNCTION search_languages_backfill();
+
+INSERT INTO product_search (product_id, language, document)
+SELECT p.id, l.name, product_search_document(l.name::regconfig, p)
+FROM products p CROSS JOIN search_languages l;
@@ -0,0 +1,2 @@
+DROP MATERIALIZED VIEW IF EXISTS search_terms;
+DROP INDEX IF EXISTS products_name_trgm_idx;
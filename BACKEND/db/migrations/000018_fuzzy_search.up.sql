@@ -0,0 +1,19 @@
+CREATE EXTENSION IF NOT EXISTS pg_trgm;
+
+-- Trigram index for typo tolerant matching of product names
+CREATE INDEX products_name_trgm_idx ON products USING GIN (name gin_trgm_ops);
+
+-- Vocabulary of every word used in published products, the source of "did
+-- you mean" suggestions. Words come from the unstemmed 'simple' documents so
+-- a suggestion is always a real word, and only from listings searchers can
+-- see so drafts and rejected listings don't leak through suggestions
+CREATE MATERIALIZED VIEW search_terms AS
+SELECT word::text AS word, ndoc::int AS ndoc
+FROM ts_stat('SELECT ps.document FROM product_search ps
+              JOIN products p ON p.id = ps.product_id
+              WHERE ps.language = ''simple''
+                AND p.status = ''published'' AND p.deleted_at IS NULL')
+WHERE length(word) >= 3 AND word !~ '^[0-9]+$';
+
+CREATE UNIQUE INDEX search_terms_word_idx ON search_terms (word);
+CREATE INDEX search_terms_word_trgm_idx ON search_terms USING GIN (word gin_trgm_ops);
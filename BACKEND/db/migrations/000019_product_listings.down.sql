@@ -0,0 +1,3 @@
+DROP INDEX IF EXISTS products_category_type_idx;
+DROP INDEX IF EXISTS orders_product_id_idx;
+DROP VIEW IF EXISTS product_listings;
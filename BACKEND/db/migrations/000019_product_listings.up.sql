@@ -0,0 +1,36 @@
+-- What product listings filter and sort on beyond the products row: the
+-- price an order is charged right now, whether it can be ordered at all, how
+-- many units were sold and the seller's organization
+CREATE VIEW product_listings AS
+SELECT
+    p.id,
+    COALESCE(u.organization_name, '')::text AS organization_name,
+    COALESCE(sale.price, p.price)::numeric AS effective_price,
+    (CASE p.kind
+        WHEN 'digital' THEN TRUE
+        WHEN 'bundle' THEN EXISTS (
+                SELECT 1 FROM bundle_items b WHERE b.bundle_id = p.id
+            ) AND NOT EXISTS (
+                SELECT 1 FROM bundle_items b
+                JOIN products c ON c.id = b.component_id
+                WHERE b.bundle_id = p.id AND (c.deleted_at IS NOT NULL OR c.stock < b.quantity)
+            )
+        ELSE p.stock > 0
+    END)::boolean AS in_stock,
+    COALESCE(sold.units, 0)::bigint AS units_sold
+FROM products p
+LEFT JOIN users u ON u.id = p.created_by
+LEFT JOIN LATERAL (
+    SELECT pp.price FROM product_prices pp
+    WHERE pp.product_id = p.id AND pp.kind = 'sale'
+      AND pp.valid_from <= (NOW() AT TIME ZONE 'UTC') AND pp.valid_to > (NOW() AT TIME ZONE 'UTC')
+    ORDER BY pp.valid_from DESC
+    LIMIT 1
+) sale ON TRUE
+LEFT JOIN LATERAL (
+    SELECT SUM(o.quantity) AS units FROM orders o
+    WHERE o.product_id = p.id AND o.status <> 'cancelled'
+) sold ON TRUE;
+
+CREATE INDEX orders_product_id_idx ON orders (product_id);
+CREATE INDEX products_category_type_idx ON products (category, type) WHERE deleted_at IS NULL;
@@ -0,0 +1,6 @@
+DROP INDEX IF EXISTS wishlist_items_user_id_created_at_idx;
+DROP INDEX IF EXISTS cart_user_id_created_at_idx;
+DROP INDEX IF EXISTS orders_user_id_created_at_idx;
+DROP INDEX IF EXISTS products_created_by_created_at_idx;
+DROP INDEX IF EXISTS products_status_created_at_idx;
+CREATE INDEX products_status_created_at_idx ON products (status, created_at DESC) WHERE deleted_at IS NULL;
@@ -0,0 +1,7 @@
+-- Indexes matching the (created_at, id) order of the paginated lists
+DROP INDEX IF EXISTS products_status_created_at_idx;
+CREATE INDEX products_status_created_at_idx ON products (status, created_at DESC, id DESC) WHERE deleted_at IS NULL;
+CREATE INDEX products_created_by_created_at_idx ON products (created_by, created_at DESC, id DESC) WHERE deleted_at IS NULL;
+CREATE INDEX orders_user_id_created_at_idx ON orders (user_id, created_at DESC, id DESC);
+CREATE INDEX cart_user_id_created_at_idx ON cart (user_id, created_at DESC, id DESC);
+CREATE INDEX wishlist_items_user_id_created_at_idx ON wishlist_items (user_id, created_at DESC, id DESC);
@@ -0,0 +1,21 @@
+DROP TRIGGER IF EXISTS search_stopwords_reindex ON search_stopwords;
+DROP TRIGGER IF EXISTS search_synonym_groups_reindex ON search_synonym_groups;
+DROP FUNCTION IF EXISTS search_dictionary_reindex();
+DROP TRIGGER IF EXISTS search_stopwords_rebuild ON search_stopwords;
+DROP FUNCTION IF EXISTS search_stopwords_rebuild();
+DROP FUNCTION IF EXISTS search_query(REGCONFIG, TEXT[]);
+
+CREATE OR REPLACE FUNCTION product_search_document(cfg REGCONFIG, p products) RETURNS tsvector AS $$
+    SELECT setweight(to_tsvector(cfg, coalesce(p.name, '')), 'A')
+        || setweight(to_tsvector(cfg, coalesce(p.category, '')), 'B')
+        || setweight(to_tsvector(cfg, coalesce(p.type, '')), 'B')
+        || setweight(to_tsvector(cfg, coalesce(p.description, '')), 'C');
+$$ LANGUAGE sql IMMUTABLE;
+
+DROP TABLE IF EXISTS search_stopwords;
+DROP TABLE IF EXISTS search_synonym_groups;
+
+UPDATE product_search ps
+SET document = product_search_document(ps.language::regconfig, p)
+FROM products p
+WHERE p.id = ps.product_id;
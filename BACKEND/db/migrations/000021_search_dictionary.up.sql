@@ -0,0 +1,74 @@
+-- Admin-managed search vocabulary. Every term of a synonym group matches the
+-- others, terms can be phrases such as 'pen drive' and 'usb flash drive'.
+CREATE TABLE search_synonym_groups (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    terms TEXT[] NOT NULL CHECK (cardinality(terms) >= 2),
+    created_by UUID REFERENCES users(id) ON DELETE SET NULL,
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE TABLE search_stopwords (
+    word TEXT PRIMARY KEY,
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP
+);
+
+-- Documents are NFKC normalised and leave out the stop words, stemmed by
+-- the document's own configuration
+CREATE OR REPLACE FUNCTION product_search_document(cfg REGCONFIG, p products) RETURNS tsvector AS $$
+    SELECT ts_delete(
+        setweight(to_tsvector(cfg, normalize(coalesce(p.name, ''), NFKC)), 'A')
+            || setweight(to_tsvector(cfg, normalize(coalesce(p.category, ''), NFKC)), 'B')
+            || setweight(to_tsvector(cfg, normalize(coalesce(p.type, ''), NFKC)), 'B')
+            || setweight(to_tsvector(cfg, normalize(coalesce(p.description, ''), NFKC)), 'C'),
+        ARRAY(SELECT unnest(tsvector_to_array(to_tsvector(cfg, s.word))) FROM search_stopwords s)
+    );
+$$ LANGUAGE sql STABLE;
+
+-- search_query ORs the tsqueries of every alternative spelling of a query,
+-- the alternatives are the query with synonyms substituted
+CREATE FUNCTION search_query(cfg REGCONFIG, alternatives TEXT[]) RETURNS tsquery AS $$
+    SELECT string_agg('(' || alt.query::text || ')', ' | ')::tsquery
+    FROM (SELECT websearch_to_tsquery(cfg, a) AS query FROM unnest(alternatives) a) alt
+    WHERE numnode(alt.query) > 0;
+$$ LANGUAGE sql STABLE;
+
+CREATE FUNCTION search_stopwords_rebuild() RETURNS trigger AS $$
+BEGIN
+    UPDATE product_search ps
+    SET document = product_search_document(ps.language::regconfig, p)
+    FROM products p
+    WHERE p.id = ps.product_id;
+    RETURN NULL;
+END;
+$$ LANGUAGE plpgsql;
+
+CREATE TRIGGER search_stopwords_rebuild
+AFTER INSERT OR DELETE ON search_stopwords
+FOR EACH STATEMENT EXECUTE FUNCTION search_stopwords_rebuild();
+
+-- The autocomplete index applies the dictionary while indexing, so every
+-- searchable product is queued again when it changes
+CREATE FUNCTION search_dictionary_reindex() RETURNS trigger AS $$
+BEGIN
+    INSERT INTO search_outbox (product_id)
+    SELECT id FROM products WHERE status = 'published' AND deleted_at IS NULL;
+    RETURN NULL;
+END;
+$$ LANGUAGE plpgsql;
+
+CREATE TRIGGER search_synonym_groups_reindex
+AFTER INSERT OR UPDATE OR DELETE ON search_synonym_groups
+FOR EACH STATEMENT EXECUTE FUNCTION search_dictionary_reindex();
+
+CREATE TRIGGER search_stopwords_reindex
+AFTER INSERT OR DELETE ON search_stopwords
+FOR EACH STATEMENT EXECUTE FUNCTION search_dictionary_reindex();
+
+UPDATE product_search ps
+SET document = product_search_document(ps.language::regconfig, p)
+FROM products p
+WHERE p.id = ps.product_id;
+
+-- Rebuild the autocomplete index with normalised, rune-safe prefixes
+INSERT INTO search_outbox (product_id)
+SELECT id FROM products WHERE status = 'published' AND deleted_at IS NULL;
@@ -0,0 +1,2 @@
+DROP INDEX IF EXISTS orders_product_created_at_idx;
+DROP TABLE IF EXISTS product_views;
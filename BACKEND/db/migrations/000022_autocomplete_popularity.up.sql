@@ -0,0 +1,16 @@
+-- Daily views of public product pages, one of the signals autocomplete
+-- suggestions are ranked by
+CREATE TABLE product_views (
+    product_id UUID NOT NULL REFERENCES products(id) ON DELETE CASCADE,
+    day DATE NOT NULL,
+    views BIGINT NOT NULL DEFAULT 0,
+    PRIMARY KEY (product_id, day)
+);
+
+CREATE INDEX product_views_day_idx ON product_views (day);
+
+CREATE INDEX orders_product_created_at_idx ON orders (product_id, created_at);
+
+-- Autocomplete entries now carry a rank, rebuild the index
+INSERT INTO search_outbox (product_id)
+SELECT id FROM products WHERE status = 'published' AND deleted_at IS NULL;
@@ -0,0 +1,22 @@
+-- Searches and the results clicked from them. Queries are stored normalised
+-- and without the user, session or address that sent them. Times are UTC
+-- like the day bounds of the reports.
+CREATE TABLE search_queries (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    query TEXT NOT NULL,
+    source TEXT NOT NULL CHECK (source IN ('search', 'autocomplete')),
+    result_count INT NOT NULL CHECK (result_count >= 0),
+    created_at TIMESTAMP NOT NULL DEFAULT (NOW() AT TIME ZONE 'UTC')
+);
+
+CREATE INDEX search_queries_created_at_idx ON search_queries (created_at);
+
+CREATE TABLE search_clicks (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    search_query_id UUID NOT NULL REFERENCES search_queries(id) ON DELETE CASCADE,
+    product_id UUID NOT NULL REFERENCES products(id) ON DELETE CASCADE,
+    position INT NOT NULL CHECK (position >= 0),
+    created_at TIMESTAMP NOT NULL DEFAULT (NOW() AT TIME ZONE 'UTC')
+);
+
+CREATE INDEX search_clicks_search_query_id_idx ON search_clicks (search_query_id);
@@ -0,0 +1,33 @@
+-- name: CreateBundleItem :exec
+INSERT INTO bundle_items (bundle_id, component_id, quantity)
+VALUES ($1, $2, $3);
+
+-- name: ListBundleItems :many
+SELECT * FROM bundle_items
+WHERE bundle_id = $1
+ORDER BY component_id;
+
+-- name: ListBundleComponents :many
+SELECT b.bundle_id, b.component_id, b.quantity, p.name,
+       (CASE WHEN p.deleted_at IS NULL THEN p.stock ELSE 0 END)::int AS stock
+FROM bundle_items b
+JOIN products p ON p.id = b.component_id
+WHERE b.bundle_id = ANY(sqlc.arg(bundle_ids)::uuid[])
+ORDER BY b.bundle_id, b.component_id;
+
+-- name: ListBundlesBackInStock :many
+-- Bundles containing the component that could not be put together with
+-- old_stock of it and can be now. Runs after the component's stock changed,
+-- so every component, this one included, is checked at its current stock
+SELECT b.bundle_id
+FROM bundle_items b
+WHERE b.component_id = sqlc.arg(component_id)
+  AND sqlc.arg(old_stock)::int < b.quantity
+  AND NOT EXISTS (
+      SELECT 1
+      FROM bundle_items o
+      JOIN products c ON c.id = o.component_id
+      WHERE o.bundle_id = b.bundle_id
+        AND (c.deleted_at IS NOT NULL OR c.stock < o.quantity)
+  )
+ORDER BY b.bundle_id;
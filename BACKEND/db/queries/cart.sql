@@ -4,7 +4,12 @@ VALUES ($1, $2, $3)
 RETURNING *;
 
 -- name: GetCartByUserID :many
-SELECT * FROM cart WHERE user_id = $1;
+SELECT * FROM cart
+WHERE user_id = sqlc.arg(user_id)
+  AND (sqlc.narg(after_created_at)::timestamp IS NULL
+       OR (created_at, id) < (sqlc.narg(after_created_at)::timestamp, sqlc.narg(after_id)::uuid))
+ORDER BY created_at DESC, id DESC
+LIMIT sqlc.arg(limit_count);
 
 -- name: GetCartByID :one
 SELECT * FROM cart WHERE id = $1;
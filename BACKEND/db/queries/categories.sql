@@ -0,0 +1,59 @@
+-- name: CreateCategory :one
+INSERT INTO categories (parent_id, slug, name, icon)
+VALUES ($1, $2, $3, $4)
+RETURNING *;
+
+-- name: GetCategoryByID :one
+SELECT * FROM categories WHERE id = $1;
+
+-- name: GetRootCategoryBySlug :one
+SELECT * FROM categories WHERE parent_id IS NULL AND slug = $1;
+
+-- name: GetChildCategoryBySlug :one
+SELECT * FROM categories WHERE parent_id = $1 AND slug = $2;
+
+-- name: ListCategories :many
+SELECT * FROM categories
+ORDER BY name;
+
+-- name: UpdateCategory :one
+UPDATE categories
+SET parent_id = $2, slug = $3, name = $4, icon = $5
+WHERE id = $1
+RETURNING *;
+
+-- name: DeleteCategory :exec
+DELETE FROM categories WHERE id = $1;
+
+-- name: CountCategoryChildren :one
+SELECT COUNT(*) FROM categories WHERE parent_id = $1;
+
+-- name: CountProductsInCategory :one
+SELECT COUNT(*) FROM products WHERE category_id = $1;
+
+-- name: IsCategoryDescendant :one
+WITH RECURSIVE subtree AS (
+    SELECT c.id FROM categories c WHERE c.id = sqlc.arg(ancestor_id)::uuid
+    UNION ALL
+    SELECT c.id FROM categories c JOIN subtree s ON c.parent_id = s.id
+)
+SELECT EXISTS (SELECT 1 FROM subtree WHERE subtree.id = sqlc.arg(category_id)::uuid);
+
+-- name: RefreshProductCategoryLabels :exec
+WITH RECURSIVE subtree AS (
+    SELECT c.id FROM categories c WHERE c.id = sqlc.arg(category_id)::uuid
+    UNION ALL
+    SELECT c.id FROM categories c JOIN subtree s ON c.parent_id = s.id
+), ancestry AS (
+    SELECT c.id AS leaf_id, c.id AS ancestor_id, c.parent_id
+    FROM categories c WHERE c.id IN (SELECT id FROM subtree)
+    UNION ALL
+    SELECT a.leaf_id, c.id, c.parent_id
+    FROM ancestry a JOIN categories c ON c.id = a.parent_id
+)
+UPDATE products p
+SET category = root.slug, type = leaf.slug
+FROM ancestry a
+JOIN categories root ON root.id = a.ancestor_id
+JOIN categories leaf ON leaf.id = a.leaf_id
+WHERE a.parent_id IS NULL AND p.category_id = a.leaf_id;
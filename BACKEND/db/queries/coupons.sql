@@ -0,0 +1,34 @@
+-- name: CreateCoupon :one
+INSERT INTO coupons (
+    code, discount_type, amount, min_order_value, max_uses, max_uses_per_user,
+    valid_from, valid_to, scope, scope_id, created_by
+) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11)
+RETURNING *;
+
+-- name: GetCouponByCode :one
+SELECT * FROM coupons WHERE code = $1;
+
+-- name: GetCouponByCodeForUpdate :one
+SELECT * FROM coupons WHERE code = $1
+FOR UPDATE;
+
+-- name: CountUserCouponUses :one
+SELECT COUNT(*) FROM order_discounts d
+JOIN orders o ON o.id = d.order_id
+WHERE d.coupon_id = $1 AND o.user_id = $2 AND o.status <> 'cancelled';
+
+-- name: IncrementCouponUse :exec
+UPDATE coupons
+SET used_count = used_count + 1
+WHERE id = $1;
+
+-- name: ReleaseOrderCoupons :exec
+UPDATE coupons c
+SET used_count = c.used_count - 1
+FROM order_discounts d
+WHERE d.order_id = $1 AND d.coupon_id = c.id;
+
+-- name: CreateOrderDiscount :one
+INSERT INTO order_discounts (order_id, coupon_id, code, amount)
+VALUES ($1, $2, $3, $4)
+RETURNING *;
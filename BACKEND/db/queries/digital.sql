@@ -0,0 +1,33 @@
+-- name: UpsertDigitalAsset :one
+INSERT INTO digital_assets (product_id, storage_key, file_name, content_type, size_bytes, download_limit)
+VALUES ($1, $2, $3, $4, $5, $6)
+ON CONFLICT (product_id) DO UPDATE
+SET storage_key = EXCLUDED.storage_key,
+    file_name = EXCLUDED.file_name,
+    content_type = EXCLUDED.content_type,
+    size_bytes = EXCLUDED.size_bytes,
+    download_limit = EXCLUDED.download_limit,
+    created_at = CURRENT_TIMESTAMP
+RETURNING *;
+
+-- name: GetDigitalAssetByProductID :one
+SELECT * FROM digital_assets WHERE product_id = $1;
+
+-- name: GetDigitalAssetByID :one
+SELECT * FROM digital_assets WHERE id = $1;
+
+-- name: CreateDownloadGrant :one
+INSERT INTO download_grants (order_id, asset_id, user_id, download_limit)
+VALUES ($1, $2, $3, $4)
+ON CONFLICT (order_id) DO UPDATE SET order_id = EXCLUDED.order_id
+RETURNING *;
+
+-- name: GetDownloadGrant :one
+SELECT * FROM download_grants WHERE id = $1;
+
+-- name: ConsumeDownload :one
+UPDATE download_grants
+SET download_count = download_count + 1,
+    last_downloaded_at = CURRENT_TIMESTAMP
+WHERE id = $1 AND download_count < download_limit
+RETURNING *;
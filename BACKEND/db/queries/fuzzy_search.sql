@@ -0,0 +1,56 @@
+-- name: SetSimilarityThreshold :exec
+-- Scoped to the current transaction, the trigram operators below compare
+-- against these settings and only the operators can use the trigram indexes
+SELECT set_config('pg_trgm.similarity_threshold', sqlc.arg(threshold)::text, true),
+       set_config('pg_trgm.word_similarity_threshold', sqlc.arg(threshold)::text, true);
+
+-- name: FuzzySearchProducts :many
+-- Products whose name is close to the query but that full-text search did not
+-- match in the given language. Pages are keyed on the similarity in
+-- millionths, between 0 and 1000000
+SELECT sqlc.embed(p), s.similarity, s.similarity_key
+FROM products p
+JOIN product_search ps ON ps.product_id = p.id AND ps.language = sqlc.arg(language)::text
+CROSS JOIN LATERAL (
+    SELECT word_similarity(sqlc.arg(query)::text, p.name)::real AS similarity,
+           round(word_similarity(sqlc.arg(query)::text, p.name) * 1000000)::bigint AS similarity_key
+) s
+WHERE sqlc.arg(query)::text <% p.name
+  AND NOT COALESCE(ps.document @@ search_query(sqlc.arg(language)::text::regconfig, sqlc.arg(queries)::text[]), FALSE)
+  AND p.status = 'published'
+  AND p.deleted_at IS NULL
+  AND (sqlc.narg(after_key)::bigint IS NULL
+       OR (s.similarity_key, p.created_at, p.id) < (sqlc.narg(after_key)::bigint, sqlc.narg(after_created_at)::timestamp, sqlc.narg(after_id)::uuid))
+ORDER BY s.similarity_key DESC, p.created_at DESC, p.id DESC
+LIMIT sqlc.arg(limit_count);
+
+-- name: CountFuzzySearchProducts :one
+SELECT COUNT(*)
+FROM products p
+JOIN product_search ps ON ps.product_id = p.id AND ps.language = sqlc.arg(language)::text
+WHERE sqlc.arg(query)::text <% p.name
+  AND NOT COALESCE(ps.document @@ search_query(sqlc.arg(language)::text::regconfig, sqlc.arg(queries)::text[]), FALSE)
+  AND p.status = 'published'
+  AND p.deleted_at IS NULL;
+
+-- name: FuzzyMatchProducts :many
+SELECT * FROM products p
+WHERE sqlc.arg(query)::text <% p.name
+  AND p.status = 'published'
+  AND p.deleted_at IS NULL
+ORDER BY word_similarity(sqlc.arg(query)::text, p.name) DESC, p.created_at DESC, p.id
+LIMIT sqlc.arg(limit_count);
+
+-- name: SearchTermExists :one
+SELECT EXISTS(SELECT 1 FROM search_terms WHERE word = $1);
+
+-- name: SimilarSearchTerm :one
+-- The closest known word, ties go to the word used by more products
+SELECT word
+FROM search_terms
+WHERE word % sqlc.arg(term)::text
+ORDER BY similarity(word, sqlc.arg(term)::text) DESC, ndoc DESC, word
+LIMIT 1;
+
+-- name: RefreshSearchTerms :exec
+REFRESH MATERIALIZED VIEW CONCURRENTLY search_terms;
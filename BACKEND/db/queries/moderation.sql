@@ -0,0 +1,24 @@
+-- name: CreateModerationLog :one
+INSERT INTO moderation_log (product_id, moderator_id, action, reason)
+VALUES ($1, $2, $3, $4)
+RETURNING *;
+
+-- name: ListModerationLog :many
+SELECT * FROM moderation_log
+WHERE product_id = sqlc.arg(product_id)
+  AND (sqlc.narg(after_created_at)::timestamp IS NULL
+       OR (created_at, id) < (sqlc.narg(after_created_at)::timestamp, sqlc.narg(after_id)::uuid))
+ORDER BY created_at DESC, id DESC
+LIMIT sqlc.arg(limit_count);
+
+-- name: ListPendingProducts :many
+SELECT * FROM products
+WHERE status = 'pending_review' AND deleted_at IS NULL
+  AND (sqlc.narg(after_created_at)::timestamp IS NULL
+       OR (created_at, id) > (sqlc.narg(after_created_at)::timestamp, sqlc.narg(after_id)::uuid))
+ORDER BY created_at ASC, id ASC
+LIMIT sqlc.arg(limit_count);
+
+-- name: CountPendingProducts :one
+SELECT COUNT(*) FROM products
+WHERE status = 'pending_review' AND deleted_at IS NULL;
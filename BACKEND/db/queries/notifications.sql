@@ -0,0 +1,45 @@
+-- name: EnqueueBackInStockNotifications :execrows
+INSERT INTO notifications (user_id, kind, title, body, product_id)
+SELECT w.user_id, 'back_in_stock', 'Back in stock', p.name || ' is available again', p.id
+FROM wishlist_items w
+JOIN products p ON p.id = w.product_id
+WHERE w.product_id = $1 AND p.status = 'published' AND p.deleted_at IS NULL;
+
+-- name: ListNotifications :many
+SELECT * FROM notifications
+WHERE user_id = sqlc.arg(user_id)
+  AND (NOT sqlc.arg(unread_only)::boolean OR read_at IS NULL)
+  AND (sqlc.narg(after_created_at)::timestamp IS NULL
+       OR (created_at, id) < (sqlc.narg(after_created_at)::timestamp, sqlc.narg(after_id)::uuid))
+ORDER BY created_at DESC, id DESC
+LIMIT sqlc.arg(limit_count);
+
+-- name: MarkNotificationRead :one
+UPDATE notifications
+SET read_at = COALESCE(read_at, NOW())
+WHERE id = $1 AND user_id = $2
+RETURNING *;
+
+-- name: CreateNotification :one
+INSERT INTO notifications (user_id, kind, title, body, product_id, send_email)
+VALUES ($1, $2, $3, $4, $5, $6)
+RETURNING *;
+
+-- name: ListPendingEmailNotifications :many
+SELECT n.id, n.title, n.body, u.email
+FROM notifications n
+JOIN users u ON u.id = n.user_id
+WHERE n.send_email AND n.emailed_at IS NULL
+  AND n.email_attempts < sqlc.arg(max_attempts)
+ORDER BY n.created_at
+LIMIT sqlc.arg(limit_count);
+
+-- name: MarkNotificationEmailed :exec
+UPDATE notifications
+SET emailed_at = NOW()
+WHERE id = $1;
+
+-- name: RecordNotificationEmailFailure :exec
+UPDATE notifications
+SET email_attempts = email_attempts + 1
+WHERE id = $1;
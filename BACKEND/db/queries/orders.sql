@@ -1,22 +1,29 @@
 -- name: CreateOrder :one
-INSERT INTO orders (user_id, product_id, quantity, total_price, status)
-VALUES ($1, $2, $3, $4, 'pending')
+INSERT INTO orders (user_id, product_id, quantity, total_price, discount_total, status)
+VALUES ($1, $2, $3, $4, $5, 'pending')
 RETURNING *;
 
 -- name: GetOrderByID :one
 SELECT * FROM orders WHERE id = $1;
 
 -- name: GetOrdersByUser :many
-SELECT * FROM orders WHERE user_id = $1 ORDER BY created_at DESC;
+SELECT * FROM orders
+WHERE user_id = sqlc.arg(user_id)
+  AND (sqlc.narg(after_created_at)::timestamp IS NULL
+       OR (created_at, id) < (sqlc.narg(after_created_at)::timestamp, sqlc.narg(after_id)::uuid))
+ORDER BY created_at DESC, id DESC
+LIMIT sqlc.arg(limit_count);
 
 -- name: UpdateOrderStatus :one
+-- Orders only move on from pending, cancelling goes through CancelOrder
 UPDATE orders
 SET status = $2
-WHERE id = $1
+WHERE id = $1 AND status = 'pending'
 RETURNING *;
 
 -- name: CancelOrder :one
+-- Only pending orders can be cancelled, completed ones were delivered
 UPDATE orders
 SET status = 'cancelled'
-WHERE id = $1
+WHERE id = $1 AND status = 'pending'
 RETURNING *;
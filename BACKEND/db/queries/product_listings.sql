@@ -0,0 +1,109 @@
+-- name: QueryProducts :many
+-- The filters are repeated in the count and facet queries below. An empty
+-- array or organization and a NULL bound leave that filter out. Every sort
+-- orders by sort_key descending, then newest first, so one keyset condition
+-- pages them all: prices in cents (negated for price_asc), the rating in
+-- hundredths and the relevance in millionths
+SELECT sqlc.embed(p), k.sort_key
+FROM products p
+JOIN product_listings l ON l.id = p.id
+LEFT JOIN product_search ps ON ps.product_id = p.id AND ps.language = sqlc.arg(language)::text
+CROSS JOIN (SELECT search_query(sqlc.arg(language)::text::regconfig, sqlc.arg(queries)::text[]) AS query) q
+CROSS JOIN LATERAL (
+    SELECT (CASE sqlc.arg(sort)::text
+        WHEN 'price_asc' THEN -round(l.effective_price * 100)
+        WHEN 'price_desc' THEN round(l.effective_price * 100)
+        WHEN 'popularity' THEN l.units_sold
+        WHEN 'rating' THEN round(p.rating_average * 100)
+        WHEN 'relevance' THEN round(COALESCE(ts_rank_cd('{0.1, 0.2, 0.4, 1.0}', ps.document, q.query), 0) * 1000000)
+        ELSE 0
+    END)::bigint AS sort_key
+) k
+WHERE p.status = 'published'
+  AND p.deleted_at IS NULL
+  AND (cardinality(sqlc.arg(queries)::text[]) = 0 OR ps.document @@ q.query)
+  AND (cardinality(sqlc.arg(categories)::text[]) = 0 OR p.category = ANY(sqlc.arg(categories)::text[]))
+  AND (cardinality(sqlc.arg(types)::text[]) = 0 OR p.type = ANY(sqlc.arg(types)::text[]))
+  AND (sqlc.narg(min_price)::numeric IS NULL OR l.effective_price >= sqlc.narg(min_price)::numeric)
+  AND (sqlc.narg(max_price)::numeric IS NULL OR l.effective_price <= sqlc.narg(max_price)::numeric)
+  AND (NOT sqlc.arg(in_stock_only)::boolean OR l.in_stock)
+  AND (sqlc.narg(seller_id)::uuid IS NULL OR p.created_by = sqlc.narg(seller_id)::uuid)
+  AND (sqlc.arg(organization)::text = '' OR lower(l.organization_name) = lower(sqlc.arg(organization)::text))
+  AND (sqlc.narg(min_rating)::numeric IS NULL OR p.rating_average >= sqlc.narg(min_rating)::numeric)
+  AND (sqlc.narg(after_key)::bigint IS NULL
+       OR (k.sort_key, p.created_at, p.id) < (sqlc.narg(after_key)::bigint, sqlc.narg(after_created_at)::timestamp, sqlc.narg(after_id)::uuid))
+ORDER BY k.sort_key DESC, p.created_at DESC, p.id DESC
+LIMIT sqlc.arg(limit_count);
+
+-- name: CountQueryProducts :one
+SELECT COUNT(*)
+FROM products p
+JOIN product_listings l ON l.id = p.id
+LEFT JOIN product_search ps ON ps.product_id = p.id AND ps.language = sqlc.arg(language)::text
+CROSS JOIN (SELECT search_query(sqlc.arg(language)::text::regconfig, sqlc.arg(queries)::text[]) AS query) q
+WHERE p.status = 'published'
+  AND p.deleted_at IS NULL
+  AND (cardinality(sqlc.arg(queries)::text[]) = 0 OR ps.document @@ q.query)
+  AND (cardinality(sqlc.arg(categories)::text[]) = 0 OR p.category = ANY(sqlc.arg(categories)::text[]))
+  AND (cardinality(sqlc.arg(types)::text[]) = 0 OR p.type = ANY(sqlc.arg(types)::text[]))
+  AND (sqlc.narg(min_price)::numeric IS NULL OR l.effective_price >= sqlc.narg(min_price)::numeric)
+  AND (sqlc.narg(max_price)::numeric IS NULL OR l.effective_price <= sqlc.narg(max_price)::numeric)
+  AND (NOT sqlc.arg(in_stock_only)::boolean OR l.in_stock)
+  AND (sqlc.narg(seller_id)::uuid IS NULL OR p.created_by = sqlc.narg(seller_id)::uuid)
+  AND (sqlc.arg(organization)::text = '' OR lower(l.organization_name) = lower(sqlc.arg(organization)::text))
+  AND (sqlc.narg(min_rating)::numeric IS NULL OR p.rating_average >= sqlc.narg(min_rating)::numeric);
+
+-- name: QueryProductFacets :many
+-- Each facet is counted without its own filter so the other values stay
+-- selectable. Price buckets are numbered by width_bucket over price_bounds,
+-- 0 is below the first bound and len(price_bounds) at or above the last one
+SELECT 'category'::text AS facet, p.category::text AS value, COUNT(*) AS count
+FROM products p
+JOIN product_listings l ON l.id = p.id
+LEFT JOIN product_search ps ON ps.product_id = p.id AND ps.language = sqlc.arg(language)::text
+CROSS JOIN (SELECT search_query(sqlc.arg(language)::text::regconfig, sqlc.arg(queries)::text[]) AS query) q
+WHERE p.status = 'published'
+  AND p.deleted_at IS NULL
+  AND (cardinality(sqlc.arg(queries)::text[]) = 0 OR ps.document @@ q.query)
+  AND (cardinality(sqlc.arg(types)::text[]) = 0 OR p.type = ANY(sqlc.arg(types)::text[]))
+  AND (sqlc.narg(min_price)::numeric IS NULL OR l.effective_price >= sqlc.narg(min_price)::numeric)
+  AND (sqlc.narg(max_price)::numeric IS NULL OR l.effective_price <= sqlc.narg(max_price)::numeric)
+  AND (NOT sqlc.arg(in_stock_only)::boolean OR l.in_stock)
+  AND (sqlc.narg(seller_id)::uuid IS NULL OR p.created_by = sqlc.narg(seller_id)::uuid)
+  AND (sqlc.arg(organization)::text = '' OR lower(l.organization_name) = lower(sqlc.arg(organization)::text))
+  AND (sqlc.narg(min_rating)::numeric IS NULL OR p.rating_average >= sqlc.narg(min_rating)::numeric)
+GROUP BY p.category
+UNION ALL
+SELECT 'type'::text AS facet, p.type::text AS value, COUNT(*) AS count
+FROM products p
+JOIN product_listings l ON l.id = p.id
+LEFT JOIN product_search ps ON ps.product_id = p.id AND ps.language = sqlc.arg(language)::text
+CROSS JOIN (SELECT search_query(sqlc.arg(language)::text::regconfig, sqlc.arg(queries)::text[]) AS query) q
+WHERE p.status = 'published'
+  AND p.deleted_at IS NULL
+  AND (cardinality(sqlc.arg(queries)::text[]) = 0 OR ps.document @@ q.query)
+  AND (cardinality(sqlc.arg(categories)::text[]) = 0 OR p.category = ANY(sqlc.arg(categories)::text[]))
+  AND (sqlc.narg(min_price)::numeric IS NULL OR l.effective_price >= sqlc.narg(min_price)::numeric)
+  AND (sqlc.narg(max_price)::numeric IS NULL OR l.effective_price <= sqlc.narg(max_price)::numeric)
+  AND (NOT sqlc.arg(in_stock_only)::boolean OR l.in_stock)
+  AND (sqlc.narg(seller_id)::uuid IS NULL OR p.created_by = sqlc.narg(seller_id)::uuid)
+  AND (sqlc.arg(organization)::text = '' OR lower(l.organization_name) = lower(sqlc.arg(organization)::text))
+  AND (sqlc.narg(min_rating)::numeric IS NULL OR p.rating_average >= sqlc.narg(min_rating)::numeric)
+GROUP BY p.type
+UNION ALL
+SELECT 'price'::text AS facet, width_bucket(l.effective_price, sqlc.arg(price_bounds)::numeric[])::text AS value, COUNT(*) AS count
+FROM products p
+JOIN product_listings l ON l.id = p.id
+LEFT JOIN product_search ps ON ps.product_id = p.id AND ps.language = sqlc.arg(language)::text
+CROSS JOIN (SELECT search_query(sqlc.arg(language)::text::regconfig, sqlc.arg(queries)::text[]) AS query) q
+WHERE p.status = 'published'
+  AND p.deleted_at IS NULL
+  AND (cardinality(sqlc.arg(queries)::text[]) = 0 OR ps.document @@ q.query)
+  AND (cardinality(sqlc.arg(categories)::text[]) = 0 OR p.category = ANY(sqlc.arg(categories)::text[]))
+  AND (cardinality(sqlc.arg(types)::text[]) = 0 OR p.type = ANY(sqlc.arg(types)::text[]))
+  AND (NOT sqlc.arg(in_stock_only)::boolean OR l.in_stock)
+  AND (sqlc.narg(seller_id)::uuid IS NULL OR p.created_by = sqlc.narg(seller_id)::uuid)
+  AND (sqlc.arg(organization)::text = '' OR lower(l.organization_name) = lower(sqlc.arg(organization)::text))
+  AND (sqlc.narg(min_rating)::numeric IS NULL OR p.rating_average >= sqlc.narg(min_rating)::numeric)
+GROUP BY 2
+ORDER BY facet, count DESC, value;
@@ -0,0 +1,33 @@
+-- name: RecordProductView :exec
+INSERT INTO product_views (product_id, day, views)
+VALUES (sqlc.arg(product_id), CURRENT_DATE, 1)
+ON CONFLICT (product_id, day) DO UPDATE SET views = product_views.views + 1;
+
+-- name: ListProductPopularity :many
+-- Ranking signals of published products since a point in time, paged by id
+SELECT
+    p.id,
+    p.created_at,
+    COALESCE((
+        SELECT COUNT(*) FROM orders o
+        WHERE o.product_id = p.id AND o.status <> 'cancelled'
+          AND o.created_at >= sqlc.arg(since)::timestamp
+    ), 0)::bigint AS orders,
+    COALESCE((
+        SELECT SUM(v.views) FROM product_views v
+        WHERE v.product_id = p.id AND v.day >= sqlc.arg(since)::date
+    ), 0)::bigint AS views
+FROM products p
+WHERE p.status = 'published' AND p.deleted_at IS NULL
+  AND p.id > sqlc.arg(after_id)
+ORDER BY p.id
+LIMIT sqlc.arg(limit_count);
+
+-- name: EnqueueSearchOutbox :exec
+-- Queues products for reindexing, e.g. after their ranking changed
+INSERT INTO search_outbox (product_id)
+SELECT unnest(sqlc.arg(product_ids)::uuid[]);
+
+-- name: DeleteProductViewsBefore :execrows
+DELETE FROM product_views
+WHERE day < sqlc.arg(before)::date;
@@ -0,0 +1,39 @@
+-- name: CreateProductPrice :one
+INSERT INTO product_prices (product_id, price, kind, valid_from, valid_to, created_by)
+VALUES ($1, $2, $3, $4, $5, $6)
+RETURNING *;
+
+-- name: RecordListPrice :exec
+WITH closed AS (
+    UPDATE product_prices
+    SET valid_to = (NOW() AT TIME ZONE 'UTC')
+    WHERE product_id = sqlc.arg(product_id) AND kind = 'list' AND valid_to IS NULL
+)
+INSERT INTO product_prices (product_id, price, kind, valid_from, created_by)
+VALUES (sqlc.arg(product_id), sqlc.arg(price), 'list', (NOW() AT TIME ZONE 'UTC'), sqlc.arg(created_by));
+
+-- name: GetActiveSalePrice :one
+SELECT * FROM product_prices
+WHERE product_id = $1 AND kind = 'sale'
+  AND valid_from <= (NOW() AT TIME ZONE 'UTC') AND valid_to > (NOW() AT TIME ZONE 'UTC')
+ORDER BY valid_from DESC
+LIMIT 1;
+
+-- name: ListActiveSalePrices :many
+SELECT DISTINCT ON (product_id) * FROM product_prices
+WHERE product_id = ANY(sqlc.arg(product_ids)::uuid[]) AND kind = 'sale'
+  AND valid_from <= (NOW() AT TIME ZONE 'UTC') AND valid_to > (NOW() AT TIME ZONE 'UTC')
+ORDER BY product_id, valid_from DESC;
+
+-- name: CountOverlappingSales :one
+SELECT COUNT(*) FROM product_prices
+WHERE product_id = sqlc.arg(product_id) AND kind = 'sale'
+  AND valid_from < sqlc.arg(ends_at)::timestamp AND valid_to > sqlc.arg(starts_at)::timestamp;
+
+-- name: ListPriceHistory :many
+SELECT * FROM product_prices
+WHERE product_id = sqlc.arg(product_id)
+  AND (sqlc.narg(after_valid_from)::timestamp IS NULL
+       OR (valid_from, created_at, id) < (sqlc.narg(after_valid_from)::timestamp, sqlc.narg(after_created_at)::timestamp, sqlc.narg(after_id)::uuid))
+ORDER BY valid_from DESC, created_at DESC, id DESC
+LIMIT sqlc.arg(limit_count);
@@ -1,47 +1,127 @@
 -- name: CreateProduct :one
-INSERT INTO products (name, description, price, stock, product_url, category, type, created_by)
-VALUES ($1, $2, $3, $4, $5, $6, $7, $8)
+INSERT INTO products (name, description, price, stock, product_url, category, type, created_by, status, category_id, low_stock_threshold, kind)
+VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12)
 RETURNING *;
 
 -- name: GetProductByID :one
+SELECT * FROM products WHERE id = $1 AND deleted_at IS NULL;
+
+-- name: GetProductByIDIncludingDeleted :one
 SELECT * FROM products WHERE id = $1;
 
 -- name: GetProductByUserID :many
-SELECT * FROM products WHERE created_by = $1;
+SELECT * FROM products
+WHERE created_by = $1 AND deleted_at IS NULL
+ORDER BY created_at DESC;
+
+-- name: ListProductsByCreator :many
+SELECT * FROM products
+WHERE created_by = sqlc.arg(created_by) AND deleted_at IS NULL
+  AND (sqlc.narg(after_created_at)::timestamp IS NULL
+       OR (created_at, id) < (sqlc.narg(after_created_at)::timestamp, sqlc.narg(after_id)::uuid))
+ORDER BY created_at DESC, id DESC
+LIMIT sqlc.arg(limit_count);
 
--- name: GetProductByName :many
-SELECT * FROM products WHERE name = $1;
+-- name: ListProductsByName :many
+-- Names containing the query, exact matches (sort key 1) first
+SELECT sqlc.embed(p), k.sort_key
+FROM products p
+CROSS JOIN LATERAL (
+    SELECT (CASE WHEN lower(p.name) = lower(sqlc.arg(name)::text) THEN 1 ELSE 0 END)::bigint AS sort_key
+) k
+WHERE p.name ILIKE '%' || sqlc.arg(name)::text || '%'
+  AND p.status = 'published' AND p.deleted_at IS NULL
+  AND (sqlc.narg(after_key)::bigint IS NULL
+       OR (k.sort_key, p.created_at, p.id) < (sqlc.narg(after_key)::bigint, sqlc.narg(after_created_at)::timestamp, sqlc.narg(after_id)::uuid))
+ORDER BY k.sort_key DESC, p.created_at DESC, p.id DESC
+LIMIT sqlc.arg(limit_count);
 
 -- name: GetAllProducts :many
 SELECT * FROM products
-ORDER BY created_at DESC
-LIMIT $1 OFFSET $2;
+WHERE status = 'published' AND deleted_at IS NULL
+  AND (sqlc.narg(after_created_at)::timestamp IS NULL
+       OR (created_at, id) < (sqlc.narg(after_created_at)::timestamp, sqlc.narg(after_id)::uuid))
+ORDER BY created_at DESC, id DESC
+LIMIT sqlc.arg(limit_count);
+
+-- name: CountPublishedProducts :one
+SELECT COUNT(*) FROM products
+WHERE status = 'published' AND deleted_at IS NULL;
 
 -- name: UpdateProduct :one
 UPDATE products
-SET 
+SET
     name = $2,
     description = $3,
     price = $4,
     stock = $5,
     product_url = $6,
     category = $7,
-    type = $8
-WHERE id = $1
+    type = $8,
+    category_id = $9,
+    version = version + 1
+WHERE id = $1 AND version = $10 AND deleted_at IS NULL
 RETURNING *;
 
 -- name: UpdateProductStock :exec
 UPDATE products
-SET stock = $2
+SET stock = $2, version = version + 1
 WHERE id = $1;
 
--- name: DeleteProduct :exec
-DELETE FROM products WHERE id = $1;
+-- name: UpdateProductStatus :one
+UPDATE products
+SET status = $2, version = version + 1
+WHERE id = $1 AND deleted_at IS NULL
+RETURNING *;
+
+-- name: SoftDeleteProduct :exec
+UPDATE products
+SET deleted_at = NOW()
+WHERE id = $1 AND deleted_at IS NULL;
+
+-- name: RestoreProduct :one
+UPDATE products
+SET deleted_at = NULL
+WHERE id = $1 AND deleted_at IS NOT NULL
+RETURNING *;
 
 -- name: ListProductsByCategory :many
-SELECT * FROM products WHERE category = $1
-ORDER BY created_at DESC;
+WITH RECURSIVE subtree AS (
+    SELECT c.id FROM categories c WHERE c.id = sqlc.arg(category_id)::uuid
+    UNION ALL
+    SELECT c.id FROM categories c JOIN subtree s ON c.parent_id = s.id
+)
+SELECT p.* FROM products p
+WHERE p.category_id IN (SELECT id FROM subtree)
+  AND p.status = 'published' AND p.deleted_at IS NULL
+  AND (sqlc.narg(after_created_at)::timestamp IS NULL
+       OR (p.created_at, p.id) < (sqlc.narg(after_created_at)::timestamp, sqlc.narg(after_id)::uuid))
+ORDER BY p.created_at DESC, p.id DESC
+LIMIT sqlc.arg(limit_count);
+
+-- name: GetProductForUpdate :one
+SELECT * FROM products WHERE id = $1 AND deleted_at IS NULL
+FOR UPDATE;
 
--- name: ListProductsByType :many
-SELECT * FROM products WHERE type = $1 AND category = $2
-ORDER BY created_at DESC;
\ No newline at end of file
+-- name: GetProductForUpdateIncludingDeleted :one
+SELECT * FROM products WHERE id = $1
+FOR UPDATE;
+
+-- name: SetLowStockThreshold :one
+UPDATE products
+SET low_stock_threshold = $2
+WHERE id = $1 AND deleted_at IS NULL
+RETURNING *;
+
+-- name: ListLowStockProducts :many
+-- Emptiest first, the sort key is the negated stock
+SELECT * FROM products
+WHERE created_by = sqlc.arg(created_by)
+  AND deleted_at IS NULL
+  AND status <> 'archived'
+  AND kind = 'physical'
+  AND stock <= low_stock_threshold
+  AND (sqlc.narg(after_key)::bigint IS NULL
+       OR (-stock::bigint, created_at, id) < (sqlc.narg(after_key)::bigint, sqlc.narg(after_created_at)::timestamp, sqlc.narg(after_id)::uuid))
+ORDER BY stock ASC, created_at DESC, id DESC
+LIMIT sqlc.arg(limit_count);
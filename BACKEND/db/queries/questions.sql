@@ -0,0 +1,95 @@
+-- name: CreateQuestion :one
+INSERT INTO product_questions (product_id, user_id, body)
+VALUES ($1, $2, $3)
+RETURNING *;
+
+-- name: GetQuestionByID :one
+SELECT * FROM product_questions WHERE id = $1;
+
+-- name: ListProductQuestions :many
+-- Ordered by a sort key descending, then newest first, so one keyset
+-- condition pages both sorts. questionSortKey in gapi computes the same key.
+SELECT * FROM product_questions
+WHERE product_id = sqlc.arg(product_id) AND NOT hidden
+  AND (sqlc.narg(after_key)::bigint IS NULL
+       OR (CASE WHEN sqlc.arg(sort)::text = 'top' THEN upvote_count ELSE 0 END::bigint, created_at, id)
+          < (sqlc.narg(after_key)::bigint, sqlc.narg(after_created_at)::timestamp, sqlc.narg(after_id)::uuid))
+ORDER BY
+    CASE WHEN sqlc.arg(sort)::text = 'top' THEN upvote_count ELSE 0 END DESC,
+    created_at DESC,
+    id DESC
+LIMIT sqlc.arg(limit_count);
+
+-- name: CountProductQuestions :one
+SELECT COUNT(*) FROM product_questions WHERE product_id = $1 AND NOT hidden;
+
+-- name: CreateAnswer :one
+INSERT INTO product_answers (question_id, user_id, body)
+VALUES ($1, $2, $3)
+RETURNING *;
+
+-- name: GetAnswerByID :one
+SELECT * FROM product_answers WHERE id = $1;
+
+-- name: ListAnswersByQuestionIDs :many
+SELECT * FROM product_answers
+WHERE question_id = ANY(sqlc.arg(question_ids)::uuid[]) AND NOT hidden
+ORDER BY upvote_count DESC, created_at ASC;
+
+-- name: CreateQuestionVote :execrows
+INSERT INTO question_votes (question_id, user_id)
+VALUES ($1, $2)
+ON CONFLICT DO NOTHING;
+
+-- name: IncrementQuestionUpvotes :one
+UPDATE product_questions
+SET upvote_count = upvote_count + 1
+WHERE id = $1
+RETURNING *;
+
+-- name: CreateAnswerVote :execrows
+INSERT INTO answer_votes (answer_id, user_id)
+VALUES ($1, $2)
+ON CONFLICT DO NOTHING;
+
+-- name: IncrementAnswerUpvotes :one
+UPDATE product_answers
+SET upvote_count = upvote_count + 1
+WHERE id = $1
+RETURNING *;
+
+-- name: CreateQuestionFlag :execrows
+INSERT INTO question_flags (question_id, user_id, reason)
+VALUES ($1, $2, $3)
+ON CONFLICT DO NOTHING;
+
+-- name: IncrementQuestionFlags :one
+UPDATE product_questions
+SET flag_count = flag_count + 1,
+    hidden = hidden OR flag_count + 1 >= sqlc.arg(hide_threshold)::int
+WHERE id = sqlc.arg(id)
+RETURNING *;
+
+-- name: CreateAnswerFlag :execrows
+INSERT INTO answer_flags (answer_id, user_id, reason)
+VALUES ($1, $2, $3)
+ON CONFLICT DO NOTHING;
+
+-- name: IncrementAnswerFlags :one
+UPDATE product_answers
+SET flag_count = flag_count + 1,
+    hidden = hidden OR flag_count + 1 >= sqlc.arg(hide_threshold)::int
+WHERE id = sqlc.arg(id)
+RETURNING *;
+
+-- name: SetQuestionHidden :one
+UPDATE product_questions
+SET hidden = $2
+WHERE id = $1
+RETURNING *;
+
+-- name: SetAnswerHidden :one
+UPDATE product_answers
+SET hidden = $2
+WHERE id = $1
+RETURNING *;
@@ -0,0 +1,71 @@
+-- name: CreateReview :one
+INSERT INTO reviews (product_id, user_id, rating, title, body, images)
+VALUES ($1, $2, $3, $4, $5, $6)
+RETURNING *;
+
+-- name: GetReviewByID :one
+SELECT * FROM reviews WHERE id = $1;
+
+-- name: HasCompletedOrder :one
+SELECT EXISTS (
+    SELECT 1 FROM orders
+    WHERE user_id = $1 AND product_id = $2 AND status = 'completed'
+);
+
+-- name: ListProductReviews :many
+-- Every sort orders by a sort key descending, then newest first, so one
+-- keyset condition pages them all. reviewSortKey in gapi computes the same key.
+SELECT * FROM reviews
+WHERE product_id = sqlc.arg(product_id)
+  AND (sqlc.narg(after_key)::bigint IS NULL
+       OR (CASE sqlc.arg(sort)::text
+               WHEN 'highest' THEN rating
+               WHEN 'lowest' THEN -rating
+               WHEN 'helpful' THEN helpful_count
+               ELSE 0
+           END::bigint, created_at, id)
+          < (sqlc.narg(after_key)::bigint, sqlc.narg(after_created_at)::timestamp, sqlc.narg(after_id)::uuid))
+ORDER BY
+    CASE sqlc.arg(sort)::text
+        WHEN 'highest' THEN rating
+        WHEN 'lowest' THEN -rating
+        WHEN 'helpful' THEN helpful_count
+        ELSE 0
+    END DESC,
+    created_at DESC,
+    id DESC
+LIMIT sqlc.arg(limit_count);
+
+-- name: CountProductReviews :one
+SELECT COUNT(*) FROM reviews WHERE product_id = $1;
+
+-- name: RefreshProductRating :exec
+UPDATE products p
+SET
+    rating_average = stats.rating_average,
+    rating_count = stats.rating_count
+FROM (
+    SELECT
+        COALESCE(ROUND(AVG(r.rating), 2), 0)::decimal AS rating_average,
+        COUNT(*)::int AS rating_count
+    FROM reviews r
+    WHERE r.product_id = sqlc.arg(product_id)
+) stats
+WHERE p.id = sqlc.arg(product_id);
+
+-- name: CreateReviewVote :execrows
+INSERT INTO review_votes (review_id, user_id)
+VALUES ($1, $2)
+ON CONFLICT DO NOTHING;
+
+-- name: IncrementReviewHelpful :one
+UPDATE reviews
+SET helpful_count = helpful_count + 1
+WHERE id = $1
+RETURNING *;
+
+-- name: SetSellerReply :one
+UPDATE reviews
+SET seller_reply = $2, seller_replied_at = NOW()
+WHERE id = $1
+RETURNING *;
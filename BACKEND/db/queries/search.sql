@@ -0,0 +1,38 @@
+-- name: SearchProducts :many
+-- Headlines are only computed for the rows of the requested page, ts_headline
+-- is costly enough for the planner to evaluate it after the limit. Pages are
+-- keyed on the rank in millionths, rounded so the key compares exactly
+SELECT sqlc.embed(p),
+       r.rank,
+       r.rank_key,
+       ts_headline(sqlc.arg(language)::text::regconfig, p.name, q.query,
+           'HighlightAll=true, StartSel=<mark>, StopSel=</mark>')::text AS name_highlight,
+       ts_headline(sqlc.arg(language)::text::regconfig, p.description, q.query,
+           'MaxFragments=2, MaxWords=20, MinWords=5, FragmentDelimiter=" … ", StartSel=<mark>, StopSel=</mark>')::text AS snippet
+FROM product_search ps
+JOIN products p ON p.id = ps.product_id
+CROSS JOIN (SELECT search_query(sqlc.arg(language)::text::regconfig, sqlc.arg(queries)::text[]) AS query) q
+CROSS JOIN LATERAL (
+    SELECT ts_rank_cd('{0.1, 0.2, 0.4, 1.0}', ps.document, q.query)::real AS rank,
+           round(ts_rank_cd('{0.1, 0.2, 0.4, 1.0}', ps.document, q.query) * 1000000)::bigint AS rank_key
+) r
+WHERE ps.language = sqlc.arg(language)::text
+  AND ps.document @@ q.query
+  AND p.status = 'published'
+  AND p.deleted_at IS NULL
+  AND (sqlc.narg(after_key)::bigint IS NULL
+       OR (r.rank_key, p.created_at, p.id) < (sqlc.narg(after_key)::bigint, sqlc.narg(after_created_at)::timestamp, sqlc.narg(after_id)::uuid))
+ORDER BY r.rank_key DESC, p.created_at DESC, p.id DESC
+LIMIT sqlc.arg(limit_count);
+
+-- name: CountSearchProducts :one
+SELECT COUNT(*)
+FROM product_search ps
+JOIN products p ON p.id = ps.product_id
+WHERE ps.language = sqlc.arg(language)::text
+  AND ps.document @@ search_query(sqlc.arg(language)::text::regconfig, sqlc.arg(queries)::text[])
+  AND p.status = 'published'
+  AND p.deleted_at IS NULL;
+
+-- name: SearchLanguageExists :one
+SELECT EXISTS(SELECT 1 FROM search_languages WHERE name = $1);
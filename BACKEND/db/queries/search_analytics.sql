@@ -0,0 +1,54 @@
+-- name: LogSearchQuery :one
+INSERT INTO search_queries (query, source, result_count)
+VALUES (sqlc.arg(query), sqlc.arg(source), sqlc.arg(result_count))
+RETURNING id;
+
+-- name: LogSearchClick :exec
+INSERT INTO search_clicks (search_query_id, product_id, position)
+VALUES (sqlc.arg(search_query_id), sqlc.arg(product_id), sqlc.arg(position));
+
+-- name: TopSearchQueries :many
+-- Most frequent queries of a period, an empty source counts both
+SELECT
+    q.query,
+    COUNT(*)::bigint AS searches,
+    AVG(q.result_count)::float8 AS avg_results,
+    COUNT(c.search_query_id)::bigint AS clicked_searches
+FROM search_queries q
+LEFT JOIN (
+    SELECT DISTINCT search_query_id FROM search_clicks
+) c ON c.search_query_id = q.id
+WHERE q.created_at >= sqlc.arg(from_time)::timestamp
+  AND q.created_at < sqlc.arg(to_time)::timestamp
+  AND (sqlc.arg(source)::text = '' OR q.source = sqlc.arg(source)::text)
+GROUP BY q.query
+ORDER BY searches DESC, q.query
+LIMIT sqlc.arg(limit_count);
+
+-- name: ZeroResultSearchQueries :many
+-- Queries that found nothing, what students look for and can't get
+SELECT
+    q.query,
+    COUNT(*)::bigint AS searches,
+    MAX(q.created_at)::timestamp AS last_searched_at
+FROM search_queries q
+WHERE q.result_count = 0
+  AND q.created_at >= sqlc.arg(from_time)::timestamp
+  AND q.created_at < sqlc.arg(to_time)::timestamp
+  AND (sqlc.arg(source)::text = '' OR q.source = sqlc.arg(source)::text)
+GROUP BY q.query
+ORDER BY searches DESC, last_searched_at DESC
+LIMIT sqlc.arg(limit_count);
+
+-- name: SearchClickThrough :one
+SELECT
+    COUNT(*)::bigint AS searches,
+    COUNT(c.search_query_id)::bigint AS clicked_searches,
+    COALESCE(SUM(c.clicks), 0)::bigint AS clicks
+FROM search_queries q
+LEFT JOIN (
+    SELECT search_query_id, COUNT(*) AS clicks FROM search_clicks GROUP BY search_query_id
+) c ON c.search_query_id = q.id
+WHERE q.created_at >= sqlc.arg(from_time)::timestamp
+  AND q.created_at < sqlc.arg(to_time)::timestamp
+  AND (sqlc.arg(source)::text = '' OR q.source = sqlc.arg(source)::text);
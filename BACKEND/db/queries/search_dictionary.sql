@@ -0,0 +1,23 @@
+-- name: CreateSynonymGroup :one
+INSERT INTO search_synonym_groups (terms, created_by)
+VALUES ($1, $2)
+RETURNING *;
+
+-- name: DeleteSynonymGroup :execrows
+DELETE FROM search_synonym_groups WHERE id = $1;
+
+-- name: ListSynonymGroups :many
+SELECT * FROM search_synonym_groups
+ORDER BY created_at, id;
+
+-- name: AddStopWord :execrows
+INSERT INTO search_stopwords (word)
+VALUES ($1)
+ON CONFLICT (word) DO NOTHING;
+
+-- name: RemoveStopWord :execrows
+DELETE FROM search_stopwords WHERE word = $1;
+
+-- name: ListStopWords :many
+SELECT word FROM search_stopwords
+ORDER BY word;
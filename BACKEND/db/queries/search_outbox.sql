@@ -0,0 +1,43 @@
+-- name: ListPendingSearchOutbox :many
+-- Pending entries grouped per product, a product changed several times is
+-- only indexed once, in its latest state
+SELECT
+    product_id,
+    MAX(id)::bigint AS last_id,
+    MAX(attempts)::int AS attempts
+FROM search_outbox
+WHERE processed_at IS NULL AND next_attempt_at <= CURRENT_TIMESTAMP
+GROUP BY product_id
+ORDER BY MIN(id)
+LIMIT sqlc.arg(limit_count);
+
+-- name: MarkSearchOutboxProcessed :exec
+UPDATE search_outbox
+SET processed_at = CURRENT_TIMESTAMP
+WHERE product_id = sqlc.arg(product_id)
+  AND id <= sqlc.arg(last_id)
+  AND processed_at IS NULL;
+
+-- name: RecordSearchOutboxFailure :exec
+-- Retries back off exponentially, capped at about 17 minutes
+UPDATE search_outbox
+SET attempts = attempts + 1,
+    last_error = sqlc.arg(last_error),
+    next_attempt_at = CURRENT_TIMESTAMP + make_interval(secs => power(2, LEAST(attempts + 1, 10)))
+WHERE product_id = sqlc.arg(product_id)
+  AND id <= sqlc.arg(last_id)
+  AND processed_at IS NULL;
+
+-- name: DeleteProcessedSearchOutbox :execrows
+DELETE FROM search_outbox
+WHERE processed_at < sqlc.arg(processed_before)::timestamp;
+
+-- name: SearchOutboxHighWater :one
+-- The newest outbox entry, changes queued after it are requeued once a
+-- reindex swapped its shadow index in
+SELECT COALESCE(MAX(id), 0)::bigint FROM search_outbox;
+
+-- name: RequeueSearchOutboxAfter :execrows
+INSERT INTO search_outbox (product_id)
+SELECT DISTINCT o.product_id FROM search_outbox o
+WHERE o.id > sqlc.arg(after_id);
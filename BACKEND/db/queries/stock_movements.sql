@@ -0,0 +1,12 @@
+-- name: CreateStockMovement :one
+INSERT INTO stock_movements (product_id, delta, stock_after, reason, actor_id, reference_id, note)
+VALUES ($1, $2, $3, $4, $5, $6, $7)
+RETURNING *;
+
+-- name: ListStockMovements :many
+SELECT * FROM stock_movements
+WHERE product_id = sqlc.arg(product_id)
+  AND (sqlc.narg(after_created_at)::timestamp IS NULL
+       OR (created_at, id) < (sqlc.narg(after_created_at)::timestamp, sqlc.narg(after_id)::uuid))
+ORDER BY created_at DESC, id DESC
+LIMIT sqlc.arg(limit_count);
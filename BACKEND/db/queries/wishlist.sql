@@ -0,0 +1,18 @@
+-- name: AddToWishlist :one
+INSERT INTO wishlist_items (user_id, product_id)
+VALUES ($1, $2)
+ON CONFLICT (user_id, product_id) DO UPDATE SET user_id = EXCLUDED.user_id
+RETURNING *;
+
+-- name: RemoveFromWishlist :execrows
+DELETE FROM wishlist_items WHERE user_id = $1 AND product_id = $2;
+
+-- name: ListWishlist :many
+SELECT sqlc.embed(w), sqlc.embed(p)
+FROM wishlist_items w
+JOIN products p ON p.id = w.product_id
+WHERE w.user_id = sqlc.arg(user_id) AND p.deleted_at IS NULL
+  AND (sqlc.narg(after_created_at)::timestamp IS NULL
+       OR (w.created_at, w.id) < (sqlc.narg(after_created_at)::timestamp, sqlc.narg(after_id)::uuid))
+ORDER BY w.created_at DESC, w.id DESC
+LIMIT sqlc.arg(limit_count);
@@ -0,0 +1,150 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.30.0
+// source: bundles.sql
+
+package db
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/lib/pq"
+)
+
+const createBundleItem = `-- name: CreateBundleItem :exec
+INSERT INTO bundle_items (bundle_id, component_id, quantity)
+VALUES ($1, $2, $3)
+`
+
+type CreateBundleItemParams struct {
+	BundleID    uuid.UUID `db:"bundle_id" json:"bundle_id"`
+	ComponentID uuid.UUID `db:"component_id" json:"component_id"`
+	Quantity    int32     `db:"quantity" json:"quantity"`
+}
+
+func (q *Queries) CreateBundleItem(ctx context.Context, arg CreateBundleItemParams) error {
+	_, err := q.db.ExecContext(ctx, createBundleItem, arg.BundleID, arg.ComponentID, arg.Quantity)
+	return err
+}
+
+const listBundleComponents = `-- name: ListBundleComponents :many
+SELECT b.bundle_id, b.component_id, b.quantity, p.name,
+       (CASE WHEN p.deleted_at IS NULL THEN p.stock ELSE 0 END)::int AS stock
+FROM bundle_items b
+JOIN products p ON p.id = b.component_id
+WHERE b.bundle_id = ANY($1::uuid[])
+ORDER BY b.bundle_id, b.component_id
+`
+
+type ListBundleComponentsRow struct {
+	BundleID    uuid.UUID `db:"bundle_id" json:"bundle_id"`
+	ComponentID uuid.UUID `db:"component_id" json:"component_id"`
+	Quantity    int32     `db:"quantity" json:"quantity"`
+	Name        string    `db:"name" json:"name"`
+	Stock       int32     `db:"stock" json:"stock"`
+}
+
+func (q *Queries) ListBundleComponents(ctx context.Context, bundleIds []uuid.UUID) ([]ListBundleComponentsRow, error) {
+	rows, err := q.db.QueryContext(ctx, listBundleComponents, pq.Array(bundleIds))
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []ListBundleComponentsRow{}
+	for rows.Next() {
+		var i ListBundleComponentsRow
+		if err := rows.Scan(
+			&i.BundleID,
+			&i.ComponentID,
+			&i.Quantity,
+			&i.Name,
+			&i.Stock,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listBundleItems = `-- name: ListBundleItems :many
+SELECT bundle_id, component_id, quantity FROM bundle_items
+WHERE bundle_id = $1
+ORDER BY component_id
+`
+
+func (q *Queries) ListBundleItems(ctx context.Context, bundleID uuid.UUID) ([]BundleItem, error) {
+	rows, err := q.db.QueryContext(ctx, listBundleItems, bundleID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []BundleItem{}
+	for rows.Next() {
+		var i BundleItem
+		if err := rows.Scan(&i.BundleID, &i.ComponentID, &i.Quantity); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listBundlesBackInStock = `-- name: ListBundlesBackInStock :many
+SELECT b.bundle_id
+FROM bundle_items b
+WHERE b.component_id = $1
+  AND $2::int < b.quantity
+  AND NOT EXISTS (
+      SELECT 1
+      FROM bundle_items o
+      JOIN products c ON c.id = o.component_id
+      WHERE o.bundle_id = b.bundle_id
+        AND (c.deleted_at IS NOT NULL OR c.stock < o.quantity)
+  )
+ORDER BY b.bundle_id
+`
+
+type ListBundlesBackInStockParams struct {
+	ComponentID uuid.UUID `db:"component_id" json:"component_id"`
+	OldStock    int32     `db:"old_stock" json:"old_stock"`
+}
+
+// Bundles containing the component that could not be put together with
+// old_stock of it and can be now. Runs after the component's stock changed,
+// so every component, this one included, is checked at its current stock
+func (q *Queries) ListBundlesBackInStock(ctx context.Context, arg ListBundlesBackInStockParams) ([]uuid.UUID, error) {
+	rows, err := q.db.QueryContext(ctx, listBundlesBackInStock, arg.ComponentID, arg.OldStock)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []uuid.UUID{}
+	for rows.Next() {
+		var bundle_id uuid.UUID
+		if err := rows.Scan(&bundle_id); err != nil {
+			return nil, err
+		}
+		items = append(items, bundle_id)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
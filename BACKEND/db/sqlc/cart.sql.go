@@ -7,6 +7,7 @@ package db
 
 import (
 	"context"
+	"database/sql"
 
 	"github.com/google/uuid"
 )
@@ -72,11 +73,28 @@ func (q *Queries) GetCartByID(ctx context.Context, id uuid.UUID) (Cart, error) {
 }
 
 const getCartByUserID = `-- name: GetCartByUserID :many
-SELECT id, user_id, product_id, quantity, created_at FROM cart WHERE user_id = $1
+SELECT id, user_id, product_id, quantity, created_at FROM cart
+WHERE user_id = $1
+  AND ($2::timestamp IS NULL
+       OR (created_at, id) < ($2::timestamp, $3::uuid))
+ORDER BY created_at DESC, id DESC
+LIMIT $4
 `
 
-func (q *Queries) GetCartByUserID(ctx context.Context, userID uuid.NullUUID) ([]Cart, error) {
-	rows, err := q.db.QueryContext(ctx, getCartByUserID, userID)
+type GetCartByUserIDParams struct {
+	UserID         uuid.NullUUID `db:"user_id" json:"user_id"`
+	AfterCreatedAt sql.NullTime  `db:"after_created_at" json:"after_created_at"`
+	AfterID        uuid.NullUUID `db:"after_id" json:"after_id"`
+	LimitCount     int32         `db:"limit_count" json:"limit_count"`
+}
+
+func (q *Queries) GetCartByUserID(ctx context.Context, arg GetCartByUserIDParams) ([]Cart, error) {
+	rows, err := q.db.QueryContext(ctx, getCartByUserID,
+		arg.UserID,
+		arg.AfterCreatedAt,
+		arg.AfterID,
+		arg.LimitCount,
+	)
 	if err != nil {
 		return nil, err
 	}
@@ -0,0 +1,250 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.30.0
+// source: categories.sql
+
+package db
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+)
+
+const countCategoryChildren = `-- name: CountCategoryChildren :one
+SELECT COUNT(*) FROM categories WHERE parent_id = $1
+`
+
+func (q *Queries) CountCategoryChildren(ctx context.Context, parentID uuid.NullUUID) (int64, error) {
+	row := q.db.QueryRowContext(ctx, countCategoryChildren, parentID)
+	var count int64
+	err := row.Scan(&count)
+	return count, err
+}
+
+const countProductsInCategory = `-- name: CountProductsInCategory :one
+SELECT COUNT(*) FROM products WHERE category_id = $1
+`
+
+func (q *Queries) CountProductsInCategory(ctx context.Context, categoryID uuid.UUID) (int64, error) {
+	row := q.db.QueryRowContext(ctx, countProductsInCategory, categoryID)
+	var count int64
+	err := row.Scan(&count)
+	return count, err
+}
+
+const createCategory = `-- name: CreateCategory :one
+INSERT INTO categories (parent_id, slug, name, icon)
+VALUES ($1, $2, $3, $4)
+RETURNING id, parent_id, slug, name, icon, created_at
+`
+
+type CreateCategoryParams struct {
+	ParentID uuid.NullUUID `db:"parent_id" json:"parent_id"`
+	Slug     string        `db:"slug" json:"slug"`
+	Name     string        `db:"name" json:"name"`
+	Icon     string        `db:"icon" json:"icon"`
+}
+
+func (q *Queries) CreateCategory(ctx context.Context, arg CreateCategoryParams) (Category, error) {
+	row := q.db.QueryRowContext(ctx, createCategory,
+		arg.ParentID,
+		arg.Slug,
+		arg.Name,
+		arg.Icon,
+	)
+	var i Category
+	err := row.Scan(
+		&i.ID,
+		&i.ParentID,
+		&i.Slug,
+		&i.Name,
+		&i.Icon,
+		&i.CreatedAt,
+	)
+	return i, err
+}
+
+const deleteCategory = `-- name: DeleteCategory :exec
+DELETE FROM categories WHERE id = $1
+`
+
+func (q *Queries) DeleteCategory(ctx context.Context, id uuid.UUID) error {
+	_, err := q.db.ExecContext(ctx, deleteCategory, id)
+	return err
+}
+
+const getCategoryByID = `-- name: GetCategoryByID :one
+SELECT id, parent_id, slug, name, icon, created_at FROM categories WHERE id = $1
+`
+
+func (q *Queries) GetCategoryByID(ctx context.Context, id uuid.UUID) (Category, error) {
+	row := q.db.QueryRowContext(ctx, getCategoryByID, id)
+	var i Category
+	err := row.Scan(
+		&i.ID,
+		&i.ParentID,
+		&i.Slug,
+		&i.Name,
+		&i.Icon,
+		&i.CreatedAt,
+	)
+	return i, err
+}
+
+const getChildCategoryBySlug = `-- name: GetChildCategoryBySlug :one
+SELECT id, parent_id, slug, name, icon, created_at FROM categories WHERE parent_id = $1 AND slug = $2
+`
+
+type GetChildCategoryBySlugParams struct {
+	ParentID uuid.NullUUID `db:"parent_id" json:"parent_id"`
+	Slug     string        `db:"slug" json:"slug"`
+}
+
+func (q *Queries) GetChildCategoryBySlug(ctx context.Context, arg GetChildCategoryBySlugParams) (Category, error) {
+	row := q.db.QueryRowContext(ctx, getChildCategoryBySlug, arg.ParentID, arg.Slug)
+	var i Category
+	err := row.Scan(
+		&i.ID,
+		&i.ParentID,
+		&i.Slug,
+		&i.Name,
+		&i.Icon,
+		&i.CreatedAt,
+	)
+	return i, err
+}
+
+const getRootCategoryBySlug = `-- name: GetRootCategoryBySlug :one
+SELECT id, parent_id, slug, name, icon, created_at FROM categories WHERE parent_id IS NULL AND slug = $1
+`
+
+func (q *Queries) GetRootCategoryBySlug(ctx context.Context, slug string) (Category, error) {
+	row := q.db.QueryRowContext(ctx, getRootCategoryBySlug, slug)
+	var i Category
+	err := row.Scan(
+		&i.ID,
+		&i.ParentID,
+		&i.Slug,
+		&i.Name,
+		&i.Icon,
+		&i.CreatedAt,
+	)
+	return i, err
+}
+
+const isCategoryDescendant = `-- name: IsCategoryDescendant :one
+WITH RECURSIVE subtree AS (
+    SELECT c.id FROM categories c WHERE c.id = $2::uuid
+    UNION ALL
+    SELECT c.id FROM categories c JOIN subtree s ON c.parent_id = s.id
+)
+SELECT EXISTS (SELECT 1 FROM subtree WHERE subtree.id = $1::uuid)
+`
+
+type IsCategoryDescendantParams struct {
+	CategoryID uuid.UUID `db:"category_id" json:"category_id"`
+	AncestorID uuid.UUID `db:"ancestor_id" json:"ancestor_id"`
+}
+
+func (q *Queries) IsCategoryDescendant(ctx context.Context, arg IsCategoryDescendantParams) (bool, error) {
+	row := q.db.QueryRowContext(ctx, isCategoryDescendant, arg.CategoryID, arg.AncestorID)
+	var exists bool
+	err := row.Scan(&exists)
+	return exists, err
+}
+
+const listCategories = `-- name: ListCategories :many
+SELECT id, parent_id, slug, name, icon, created_at FROM categories
+ORDER BY name
+`
+
+func (q *Queries) ListCategories(ctx context.Context) ([]Category, error) {
+	rows, err := q.db.QueryContext(ctx, listCategories)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []Category{}
+	for rows.Next() {
+		var i Category
+		if err := rows.Scan(
+			&i.ID,
+			&i.ParentID,
+			&i.Slug,
+			&i.Name,
+			&i.Icon,
+			&i.CreatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const refreshProductCategoryLabels = `-- name: RefreshProductCategoryLabels :exec
+WITH RECURSIVE subtree AS (
+    SELECT c.id FROM categories c WHERE c.id = $1::uuid
+    UNION ALL
+    SELECT c.id FROM categories c JOIN subtree s ON c.parent_id = s.id
+), ancestry AS (
+    SELECT c.id AS leaf_id, c.id AS ancestor_id, c.parent_id
+    FROM categories c WHERE c.id IN (SELECT id FROM subtree)
+    UNION ALL
+    SELECT a.leaf_id, c.id, c.parent_id
+    FROM ancestry a JOIN categories c ON c.id = a.parent_id
+)
+UPDATE products p
+SET category = root.slug, type = leaf.slug
+FROM ancestry a
+JOIN categories root ON root.id = a.ancestor_id
+JOIN categories leaf ON leaf.id = a.leaf_id
+WHERE a.parent_id IS NULL AND p.category_id = a.leaf_id
+`
+
+func (q *Queries) RefreshProductCategoryLabels(ctx context.Context, categoryID uuid.UUID) error {
+	_, err := q.db.ExecContext(ctx, refreshProductCategoryLabels, categoryID)
+	return err
+}
+
+const updateCategory = `-- name: UpdateCategory :one
+UPDATE categories
+SET parent_id = $2, slug = $3, name = $4, icon = $5
+WHERE id = $1
+RETURNING id, parent_id, slug, name, icon, created_at
+`
+
+type UpdateCategoryParams struct {
+	ID       uuid.UUID     `db:"id" json:"id"`
+	ParentID uuid.NullUUID `db:"parent_id" json:"parent_id"`
+	Slug     string        `db:"slug" json:"slug"`
+	Name     string        `db:"name" json:"name"`
+	Icon     string        `db:"icon" json:"icon"`
+}
+
+func (q *Queries) UpdateCategory(ctx context.Context, arg UpdateCategoryParams) (Category, error) {
+	row := q.db.QueryRowContext(ctx, updateCategory,
+		arg.ID,
+		arg.ParentID,
+		arg.Slug,
+		arg.Name,
+		arg.Icon,
+	)
+	var i Category
+	err := row.Scan(
+		&i.ID,
+		&i.ParentID,
+		&i.Slug,
+		&i.Name,
+		&i.Icon,
+		&i.CreatedAt,
+	)
+	return i, err
+}
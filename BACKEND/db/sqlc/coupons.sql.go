@@ -0,0 +1,196 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.30.0
+// source: coupons.sql
+
+package db
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+const countUserCouponUses = `-- name: CountUserCouponUses :one
+SELECT COUNT(*) FROM order_discounts d
+JOIN orders o ON o.id = d.order_id
+WHERE d.coupon_id = $1 AND o.user_id = $2 AND o.status <> 'cancelled'
+`
+
+type CountUserCouponUsesParams struct {
+	CouponID uuid.UUID     `db:"coupon_id" json:"coupon_id"`
+	UserID   uuid.NullUUID `db:"user_id" json:"user_id"`
+}
+
+func (q *Queries) CountUserCouponUses(ctx context.Context, arg CountUserCouponUsesParams) (int64, error) {
+	row := q.db.QueryRowContext(ctx, countUserCouponUses, arg.CouponID, arg.UserID)
+	var count int64
+	err := row.Scan(&count)
+	return count, err
+}
+
+const createCoupon = `-- name: CreateCoupon :one
+INSERT INTO coupons (
+    code, discount_type, amount, min_order_value, max_uses, max_uses_per_user,
+    valid_from, valid_to, scope, scope_id, created_by
+) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11)
+RETURNING id, code, discount_type, amount, min_order_value, max_uses, max_uses_per_user, used_count, valid_from, valid_to, scope, scope_id, created_by, created_at
+`
+
+type CreateCouponParams struct {
+	Code           string        `db:"code" json:"code"`
+	DiscountType   string        `db:"discount_type" json:"discount_type"`
+	Amount         string        `db:"amount" json:"amount"`
+	MinOrderValue  string        `db:"min_order_value" json:"min_order_value"`
+	MaxUses        sql.NullInt32 `db:"max_uses" json:"max_uses"`
+	MaxUsesPerUser sql.NullInt32 `db:"max_uses_per_user" json:"max_uses_per_user"`
+	ValidFrom      time.Time     `db:"valid_from" json:"valid_from"`
+	ValidTo        sql.NullTime  `db:"valid_to" json:"valid_to"`
+	Scope          string        `db:"scope" json:"scope"`
+	ScopeID        uuid.UUID     `db:"scope_id" json:"scope_id"`
+	CreatedBy      uuid.NullUUID `db:"created_by" json:"created_by"`
+}
+
+func (q *Queries) CreateCoupon(ctx context.Context, arg CreateCouponParams) (Coupon, error) {
+	row := q.db.QueryRowContext(ctx, createCoupon,
+		arg.Code,
+		arg.DiscountType,
+		arg.Amount,
+		arg.MinOrderValue,
+		arg.MaxUses,
+		arg.MaxUsesPerUser,
+		arg.ValidFrom,
+		arg.ValidTo,
+		arg.Scope,
+		arg.ScopeID,
+		arg.CreatedBy,
+	)
+	var i Coupon
+	err := row.Scan(
+		&i.ID,
+		&i.Code,
+		&i.DiscountType,
+		&i.Amount,
+		&i.MinOrderValue,
+		&i.MaxUses,
+		&i.MaxUsesPerUser,
+		&i.UsedCount,
+		&i.ValidFrom,
+		&i.ValidTo,
+		&i.Scope,
+		&i.ScopeID,
+		&i.CreatedBy,
+		&i.CreatedAt,
+	)
+	return i, err
+}
+
+const createOrderDiscount = `-- name: CreateOrderDiscount :one
+INSERT INTO order_discounts (order_id, coupon_id, code, amount)
+VALUES ($1, $2, $3, $4)
+RETURNING id, order_id, coupon_id, code, amount, created_at
+`
+
+type CreateOrderDiscountParams struct {
+	OrderID  uuid.UUID `db:"order_id" json:"order_id"`
+	CouponID uuid.UUID `db:"coupon_id" json:"coupon_id"`
+	Code     string    `db:"code" json:"code"`
+	Amount   string    `db:"amount" json:"amount"`
+}
+
+func (q *Queries) CreateOrderDiscount(ctx context.Context, arg CreateOrderDiscountParams) (OrderDiscount, error) {
+	row := q.db.QueryRowContext(ctx, createOrderDiscount,
+		arg.OrderID,
+		arg.CouponID,
+		arg.Code,
+		arg.Amount,
+	)
+	var i OrderDiscount
+	err := row.Scan(
+		&i.ID,
+		&i.OrderID,
+		&i.CouponID,
+		&i.Code,
+		&i.Amount,
+		&i.CreatedAt,
+	)
+	return i, err
+}
+
+const getCouponByCode = `-- name: GetCouponByCode :one
+SELECT id, code, discount_type, amount, min_order_value, max_uses, max_uses_per_user, used_count, valid_from, valid_to, scope, scope_id, created_by, created_at FROM coupons WHERE code = $1
+`
+
+func (q *Queries) GetCouponByCode(ctx context.Context, code string) (Coupon, error) {
+	row := q.db.QueryRowContext(ctx, getCouponByCode, code)
+	var i Coupon
+	err := row.Scan(
+		&i.ID,
+		&i.Code,
+		&i.DiscountType,
+		&i.Amount,
+		&i.MinOrderValue,
+		&i.MaxUses,
+		&i.MaxUsesPerUser,
+		&i.UsedCount,
+		&i.ValidFrom,
+		&i.ValidTo,
+		&i.Scope,
+		&i.ScopeID,
+		&i.CreatedBy,
+		&i.CreatedAt,
+	)
+	return i, err
+}
+
+const getCouponByCodeForUpdate = `-- name: GetCouponByCodeForUpdate :one
+SELECT id, code, discount_type, amount, min_order_value, max_uses, max_uses_per_user, used_count, valid_from, valid_to, scope, scope_id, created_by, created_at FROM coupons WHERE code = $1
+FOR UPDATE
+`
+
+func (q *Queries) GetCouponByCodeForUpdate(ctx context.Context, code string) (Coupon, error) {
+	row := q.db.QueryRowContext(ctx, getCouponByCodeForUpdate, code)
+	var i Coupon
+	err := row.Scan(
+		&i.ID,
+		&i.Code,
+		&i.DiscountType,
+		&i.Amount,
+		&i.MinOrderValue,
+		&i.MaxUses,
+		&i.MaxUsesPerUser,
+		&i.UsedCount,
+		&i.ValidFrom,
+		&i.ValidTo,
+		&i.Scope,
+		&i.ScopeID,
+		&i.CreatedBy,
+		&i.CreatedAt,
+	)
+	return i, err
+}
+
+const incrementCouponUse = `-- name: IncrementCouponUse :exec
+UPDATE coupons
+SET used_count = used_count + 1
+WHERE id = $1
+`
+
+func (q *Queries) IncrementCouponUse(ctx context.Context, id uuid.UUID) error {
+	_, err := q.db.ExecContext(ctx, incrementCouponUse, id)
+	return err
+}
+
+const releaseOrderCoupons = `-- name: ReleaseOrderCoupons :exec
+UPDATE coupons c
+SET used_count = c.used_count - 1
+FROM order_discounts d
+WHERE d.order_id = $1 AND d.coupon_id = c.id
+`
+
+func (q *Queries) ReleaseOrderCoupons(ctx context.Context, orderID uuid.UUID) error {
+	_, err := q.db.ExecContext(ctx, releaseOrderCoupons, orderID)
+	return err
+}
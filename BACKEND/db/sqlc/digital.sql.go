@@ -0,0 +1,176 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.30.0
+// source: digital.sql
+
+package db
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+)
+
+const consumeDownload = `-- name: ConsumeDownload :one
+UPDATE download_grants
+SET download_count = download_count + 1,
+    last_downloaded_at = CURRENT_TIMESTAMP
+WHERE id = $1 AND download_count < download_limit
+RETURNING id, order_id, asset_id, user_id, download_limit, download_count, last_downloaded_at, created_at
+`
+
+func (q *Queries) ConsumeDownload(ctx context.Context, id uuid.UUID) (DownloadGrant, error) {
+	row := q.db.QueryRowContext(ctx, consumeDownload, id)
+	var i DownloadGrant
+	err := row.Scan(
+		&i.ID,
+		&i.OrderID,
+		&i.AssetID,
+		&i.UserID,
+		&i.DownloadLimit,
+		&i.DownloadCount,
+		&i.LastDownloadedAt,
+		&i.CreatedAt,
+	)
+	return i, err
+}
+
+const createDownloadGrant = `-- name: CreateDownloadGrant :one
+INSERT INTO download_grants (order_id, asset_id, user_id, download_limit)
+VALUES ($1, $2, $3, $4)
+ON CONFLICT (order_id) DO UPDATE SET order_id = EXCLUDED.order_id
+RETURNING id, order_id, asset_id, user_id, download_limit, download_count, last_downloaded_at, created_at
+`
+
+type CreateDownloadGrantParams struct {
+	OrderID       uuid.UUID `db:"order_id" json:"order_id"`
+	AssetID       uuid.UUID `db:"asset_id" json:"asset_id"`
+	UserID        uuid.UUID `db:"user_id" json:"user_id"`
+	DownloadLimit int32     `db:"download_limit" json:"download_limit"`
+}
+
+func (q *Queries) CreateDownloadGrant(ctx context.Context, arg CreateDownloadGrantParams) (DownloadGrant, error) {
+	row := q.db.QueryRowContext(ctx, createDownloadGrant,
+		arg.OrderID,
+		arg.AssetID,
+		arg.UserID,
+		arg.DownloadLimit,
+	)
+	var i DownloadGrant
+	err := row.Scan(
+		&i.ID,
+		&i.OrderID,
+		&i.AssetID,
+		&i.UserID,
+		&i.DownloadLimit,
+		&i.DownloadCount,
+		&i.LastDownloadedAt,
+		&i.CreatedAt,
+	)
+	return i, err
+}
+
+const getDigitalAssetByID = `-- name: GetDigitalAssetByID :one
+SELECT id, product_id, storage_key, file_name, content_type, size_bytes, download_limit, created_at FROM digital_assets WHERE id = $1
+`
+
+func (q *Queries) GetDigitalAssetByID(ctx context.Context, id uuid.UUID) (DigitalAsset, error) {
+	row := q.db.QueryRowContext(ctx, getDigitalAssetByID, id)
+	var i DigitalAsset
+	err := row.Scan(
+		&i.ID,
+		&i.ProductID,
+		&i.StorageKey,
+		&i.FileName,
+		&i.ContentType,
+		&i.SizeBytes,
+		&i.DownloadLimit,
+		&i.CreatedAt,
+	)
+	return i, err
+}
+
+const getDigitalAssetByProductID = `-- name: GetDigitalAssetByProductID :one
+SELECT id, product_id, storage_key, file_name, content_type, size_bytes, download_limit, created_at FROM digital_assets WHERE product_id = $1
+`
+
+func (q *Queries) GetDigitalAssetByProductID(ctx context.Context, productID uuid.UUID) (DigitalAsset, error) {
+	row := q.db.QueryRowContext(ctx, getDigitalAssetByProductID, productID)
+	var i DigitalAsset
+	err := row.Scan(
+		&i.ID,
+		&i.ProductID,
+		&i.StorageKey,
+		&i.FileName,
+		&i.ContentType,
+		&i.SizeBytes,
+		&i.DownloadLimit,
+		&i.CreatedAt,
+	)
+	return i, err
+}
+
+const getDownloadGrant = `-- name: GetDownloadGrant :one
+SELECT id, order_id, asset_id, user_id, download_limit, download_count, last_downloaded_at, created_at FROM download_grants WHERE id = $1
+`
+
+func (q *Queries) GetDownloadGrant(ctx context.Context, id uuid.UUID) (DownloadGrant, error) {
+	row := q.db.QueryRowContext(ctx, getDownloadGrant, id)
+	var i DownloadGrant
+	err := row.Scan(
+		&i.ID,
+		&i.OrderID,
+		&i.AssetID,
+		&i.UserID,
+		&i.DownloadLimit,
+		&i.DownloadCount,
+		&i.LastDownloadedAt,
+		&i.CreatedAt,
+	)
+	return i, err
+}
+
+const upsertDigitalAsset = `-- name: UpsertDigitalAsset :one
+INSERT INTO digital_assets (product_id, storage_key, file_name, content_type, size_bytes, download_limit)
+VALUES ($1, $2, $3, $4, $5, $6)
+ON CONFLICT (product_id) DO UPDATE
+SET storage_key = EXCLUDED.storage_key,
+    file_name = EXCLUDED.file_name,
+    content_type = EXCLUDED.content_type,
+    size_bytes = EXCLUDED.size_bytes,
+    download_limit = EXCLUDED.download_limit,
+    created_at = CURRENT_TIMESTAMP
+RETURNING id, product_id, storage_key, file_name, content_type, size_bytes, download_limit, created_at
+`
+
+type UpsertDigitalAssetParams struct {
+	ProductID     uuid.UUID `db:"product_id" json:"product_id"`
+	StorageKey    string    `db:"storage_key" json:"storage_key"`
+	FileName      string    `db:"file_name" json:"file_name"`
+	ContentType   string    `db:"content_type" json:"content_type"`
+	SizeBytes     int64     `db:"size_bytes" json:"size_bytes"`
+	DownloadLimit int32     `db:"download_limit" json:"download_limit"`
+}
+
+func (q *Queries) UpsertDigitalAsset(ctx context.Context, arg UpsertDigitalAssetParams) (DigitalAsset, error) {
+	row := q.db.QueryRowContext(ctx, upsertDigitalAsset,
+		arg.ProductID,
+		arg.StorageKey,
+		arg.FileName,
+		arg.ContentType,
+		arg.SizeBytes,
+		arg.DownloadLimit,
+	)
+	var i DigitalAsset
+	err := row.Scan(
+		&i.ID,
+		&i.ProductID,
+		&i.StorageKey,
+		&i.FileName,
+		&i.ContentType,
+		&i.SizeBytes,
+		&i.DownloadLimit,
+		&i.CreatedAt,
+	)
+	return i, err
+}
@@ -0,0 +1,230 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.30.0
+// source: fuzzy_search.sql
+
+package db
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/google/uuid"
+	"github.com/lib/pq"
+)
+
+const countFuzzySearchProducts = `-- name: CountFuzzySearchProducts :one
+SELECT COUNT(*)
+FROM products p
+JOIN product_search ps ON ps.product_id = p.id AND ps.language = $1::text
+WHERE $2::text <% p.name
+  AND NOT COALESCE(ps.document @@ search_query($1::text::regconfig, $3::text[]), FALSE)
+  AND p.status = 'published'
+  AND p.deleted_at IS NULL
+`
+
+type CountFuzzySearchProductsParams struct {
+	Language string   `db:"language" json:"language"`
+	Query    string   `db:"query" json:"query"`
+	Queries  []string `db:"queries" json:"queries"`
+}
+
+func (q *Queries) CountFuzzySearchProducts(ctx context.Context, arg CountFuzzySearchProductsParams) (int64, error) {
+	row := q.db.QueryRowContext(ctx, countFuzzySearchProducts, arg.Language, arg.Query, pq.Array(arg.Queries))
+	var count int64
+	err := row.Scan(&count)
+	return count, err
+}
+
+const fuzzyMatchProducts = `-- name: FuzzyMatchProducts :many
+SELECT id, name, description, price, stock, product_url, category, type, created_by, created_at, status, deleted_at, category_id, rating_average, rating_count, version, low_stock_threshold, kind FROM products p
+WHERE $1::text <% p.name
+  AND p.status = 'published'
+  AND p.deleted_at IS NULL
+ORDER BY word_similarity($1::text, p.name) DESC, p.created_at DESC, p.id
+LIMIT $2
+`
+
+type FuzzyMatchProductsParams struct {
+	Query      string `db:"query" json:"query"`
+	LimitCount int32  `db:"limit_count" json:"limit_count"`
+}
+
+func (q *Queries) FuzzyMatchProducts(ctx context.Context, arg FuzzyMatchProductsParams) ([]Product, error) {
+	rows, err := q.db.QueryContext(ctx, fuzzyMatchProducts, arg.Query, arg.LimitCount)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []Product{}
+	for rows.Next() {
+		var i Product
+		if err := rows.Scan(
+			&i.ID,
+			&i.Name,
+			&i.Description,
+			&i.Price,
+			&i.Stock,
+			&i.ProductUrl,
+			&i.Category,
+			&i.Type,
+			&i.CreatedBy,
+			&i.CreatedAt,
+			&i.Status,
+			&i.DeletedAt,
+			&i.CategoryID,
+			&i.RatingAverage,
+			&i.RatingCount,
+			&i.Version,
+			&i.LowStockThreshold,
+			&i.Kind,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const fuzzySearchProducts = `-- name: FuzzySearchProducts :many
+SELECT p.id, p.name, p.description, p.price, p.stock, p.product_url, p.category, p.type, p.created_by, p.created_at, p.status, p.deleted_at, p.category_id, p.rating_average, p.rating_count, p.version, p.low_stock_threshold, p.kind, s.similarity, s.similarity_key
+FROM products p
+JOIN product_search ps ON ps.product_id = p.id AND ps.language = $1::text
+CROSS JOIN LATERAL (
+    SELECT word_similarity($2::text, p.name)::real AS similarity,
+           round(word_similarity($2::text, p.name) * 1000000)::bigint AS similarity_key
+) s
+WHERE $2::text <% p.name
+  AND NOT COALESCE(ps.document @@ search_query($1::text::regconfig, $3::text[]), FALSE)
+  AND p.status = 'published'
+  AND p.deleted_at IS NULL
+  AND ($4::bigint IS NULL
+       OR (s.similarity_key, p.created_at, p.id) < ($4::bigint, $5::timestamp, $6::uuid))
+ORDER BY s.similarity_key DESC, p.created_at DESC, p.id DESC
+LIMIT $7
+`
+
+type FuzzySearchProductsParams struct {
+	Language       string        `db:"language" json:"language"`
+	Query          string        `db:"query" json:"query"`
+	Queries        []string      `db:"queries" json:"queries"`
+	AfterKey       sql.NullInt64 `db:"after_key" json:"after_key"`
+	AfterCreatedAt sql.NullTime  `db:"after_created_at" json:"after_created_at"`
+	AfterID        uuid.NullUUID `db:"after_id" json:"after_id"`
+	LimitCount     int32         `db:"limit_count" json:"limit_count"`
+}
+
+type FuzzySearchProductsRow struct {
+	Product       Product `db:"product" json:"product"`
+	Similarity    float32 `db:"similarity" json:"similarity"`
+	SimilarityKey int64   `db:"similarity_key" json:"similarity_key"`
+}
+
+// Products whose name is close to the query but that full-text search did not
+// match in the given language. Pages are keyed on the similarity in
+// millionths, between 0 and 1000000
+func (q *Queries) FuzzySearchProducts(ctx context.Context, arg FuzzySearchProductsParams) ([]FuzzySearchProductsRow, error) {
+	rows, err := q.db.QueryContext(ctx, fuzzySearchProducts,
+		arg.Language,
+		arg.Query,
+		pq.Array(arg.Queries),
+		arg.AfterKey,
+		arg.AfterCreatedAt,
+		arg.AfterID,
+		arg.LimitCount,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []FuzzySearchProductsRow{}
+	for rows.Next() {
+		var i FuzzySearchProductsRow
+		if err := rows.Scan(
+			&i.Product.ID,
+			&i.Product.Name,
+			&i.Product.Description,
+			&i.Product.Price,
+			&i.Product.Stock,
+			&i.Product.ProductUrl,
+			&i.Product.Category,
+			&i.Product.Type,
+			&i.Product.CreatedBy,
+			&i.Product.CreatedAt,
+			&i.Product.Status,
+			&i.Product.DeletedAt,
+			&i.Product.CategoryID,
+			&i.Product.RatingAverage,
+			&i.Product.RatingCount,
+			&i.Product.Version,
+			&i.Product.LowStockThreshold,
+			&i.Product.Kind,
+			&i.Similarity,
+			&i.SimilarityKey,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const refreshSearchTerms = `-- name: RefreshSearchTerms :exec
+REFRESH MATERIALIZED VIEW CONCURRENTLY search_terms
+`
+
+func (q *Queries) RefreshSearchTerms(ctx context.Context) error {
+	_, err := q.db.ExecContext(ctx, refreshSearchTerms)
+	return err
+}
+
+const searchTermExists = `-- name: SearchTermExists :one
+SELECT EXISTS(SELECT 1 FROM search_terms WHERE word = $1)
+`
+
+func (q *Queries) SearchTermExists(ctx context.Context, word string) (bool, error) {
+	row := q.db.QueryRowContext(ctx, searchTermExists, word)
+	var exists bool
+	err := row.Scan(&exists)
+	return exists, err
+}
+
+const setSimilarityThreshold = `-- name: SetSimilarityThreshold :exec
+SELECT set_config('pg_trgm.similarity_threshold', $1::text, true),
+       set_config('pg_trgm.word_similarity_threshold', $1::text, true)
+`
+
+// Scoped to the current transaction, the trigram operators below compare
+// against these settings and only the operators can use the trigram indexes
+func (q *Queries) SetSimilarityThreshold(ctx context.Context, threshold string) error {
+	_, err := q.db.ExecContext(ctx, setSimilarityThreshold, threshold)
+	return err
+}
+
+const similarSearchTerm = `-- name: SimilarSearchTerm :one
+SELECT word
+FROM search_terms
+WHERE word % $1::text
+ORDER BY similarity(word, $1::text) DESC, ndoc DESC, word
+LIMIT 1
+`
+
+// The closest known word, ties go to the word used by more products
+func (q *Queries) SimilarSearchTerm(ctx context.Context, term string) (string, error) {
+	row := q.db.QueryRowContext(ctx, similarSearchTerm, term)
+	var word string
+	err := row.Scan(&word)
+	return word, err
+}
@@ -11,6 +11,25 @@ import (
 	"github.com/google/uuid"
 )
 
+type AnswerFlag struct {
+	AnswerID  uuid.UUID    `db:"answer_id" json:"answer_id"`
+	UserID    uuid.UUID    `db:"user_id" json:"user_id"`
+	Reason    string       `db:"reason" json:"reason"`
+	CreatedAt sql.NullTime `db:"created_at" json:"created_at"`
+}
+
+type AnswerVote struct {
+	AnswerID  uuid.UUID    `db:"answer_id" json:"answer_id"`
+	UserID    uuid.UUID    `db:"user_id" json:"user_id"`
+	CreatedAt sql.NullTime `db:"created_at" json:"created_at"`
+}
+
+type BundleItem struct {
+	BundleID    uuid.UUID `db:"bundle_id" json:"bundle_id"`
+	ComponentID uuid.UUID `db:"component_id" json:"component_id"`
+	Quantity    int32     `db:"quantity" json:"quantity"`
+}
+
 type Cart struct {
 	ID        uuid.UUID     `db:"id" json:"id"`
 	UserID    uuid.NullUUID `db:"user_id" json:"user_id"`
@@ -19,29 +38,251 @@ type Cart struct {
 	CreatedAt sql.NullTime  `db:"created_at" json:"created_at"`
 }
 
-type Order struct {
-	ID         uuid.UUID      `db:"id" json:"id"`
-	UserID     uuid.NullUUID  `db:"user_id" json:"user_id"`
-	ProductID  uuid.NullUUID  `db:"product_id" json:"product_id"`
-	Quantity   int32          `db:"quantity" json:"quantity"`
-	TotalPrice string         `db:"total_price" json:"total_price"`
-	Status     sql.NullString `db:"status" json:"status"`
-	CreatedAt  sql.NullTime   `db:"created_at" json:"created_at"`
+type Category struct {
+	ID        uuid.UUID     `db:"id" json:"id"`
+	ParentID  uuid.NullUUID `db:"parent_id" json:"parent_id"`
+	Slug      string        `db:"slug" json:"slug"`
+	Name      string        `db:"name" json:"name"`
+	Icon      string        `db:"icon" json:"icon"`
+	CreatedAt sql.NullTime  `db:"created_at" json:"created_at"`
 }
 
-type Product struct {
+type Coupon struct {
+	ID             uuid.UUID     `db:"id" json:"id"`
+	Code           string        `db:"code" json:"code"`
+	DiscountType   string        `db:"discount_type" json:"discount_type"`
+	Amount         string        `db:"amount" json:"amount"`
+	MinOrderValue  string        `db:"min_order_value" json:"min_order_value"`
+	MaxUses        sql.NullInt32 `db:"max_uses" json:"max_uses"`
+	MaxUsesPerUser sql.NullInt32 `db:"max_uses_per_user" json:"max_uses_per_user"`
+	UsedCount      int32         `db:"used_count" json:"used_count"`
+	ValidFrom      time.Time     `db:"valid_from" json:"valid_from"`
+	ValidTo        sql.NullTime  `db:"valid_to" json:"valid_to"`
+	Scope          string        `db:"scope" json:"scope"`
+	ScopeID        uuid.UUID     `db:"scope_id" json:"scope_id"`
+	CreatedBy      uuid.NullUUID `db:"created_by" json:"created_by"`
+	CreatedAt      sql.NullTime  `db:"created_at" json:"created_at"`
+}
+
+type DigitalAsset struct {
+	ID            uuid.UUID    `db:"id" json:"id"`
+	ProductID     uuid.UUID    `db:"product_id" json:"product_id"`
+	StorageKey    string       `db:"storage_key" json:"storage_key"`
+	FileName      string       `db:"file_name" json:"file_name"`
+	ContentType   string       `db:"content_type" json:"content_type"`
+	SizeBytes     int64        `db:"size_bytes" json:"size_bytes"`
+	DownloadLimit int32        `db:"download_limit" json:"download_limit"`
+	CreatedAt     sql.NullTime `db:"created_at" json:"created_at"`
+}
+
+type DownloadGrant struct {
+	ID               uuid.UUID    `db:"id" json:"id"`
+	OrderID          uuid.UUID    `db:"order_id" json:"order_id"`
+	AssetID          uuid.UUID    `db:"asset_id" json:"asset_id"`
+	UserID           uuid.UUID    `db:"user_id" json:"user_id"`
+	DownloadLimit    int32        `db:"download_limit" json:"download_limit"`
+	DownloadCount    int32        `db:"download_count" json:"download_count"`
+	LastDownloadedAt sql.NullTime `db:"last_downloaded_at" json:"last_downloaded_at"`
+	CreatedAt        sql.NullTime `db:"created_at" json:"created_at"`
+}
+
+type ModerationLog struct {
 	ID          uuid.UUID     `db:"id" json:"id"`
-	Name        string        `db:"name" json:"name"`
-	Description string        `db:"description" json:"description"`
-	Price       string        `db:"price" json:"price"`
-	Stock       int32         `db:"stock" json:"stock"`
-	ProductUrl  string        `db:"product_url" json:"product_url"`
-	Category    string        `db:"category" json:"category"`
-	Type        string        `db:"type" json:"type"`
-	CreatedBy   uuid.NullUUID `db:"created_by" json:"created_by"`
+	ProductID   uuid.UUID     `db:"product_id" json:"product_id"`
+	ModeratorID uuid.NullUUID `db:"moderator_id" json:"moderator_id"`
+	Action      string        `db:"action" json:"action"`
+	Reason      string        `db:"reason" json:"reason"`
 	CreatedAt   sql.NullTime  `db:"created_at" json:"created_at"`
 }
 
+type Notification struct {
+	ID            uuid.UUID     `db:"id" json:"id"`
+	UserID        uuid.UUID     `db:"user_id" json:"user_id"`
+	Kind          string        `db:"kind" json:"kind"`
+	Title         string        `db:"title" json:"title"`
+	Body          string        `db:"body" json:"body"`
+	ProductID     uuid.NullUUID `db:"product_id" json:"product_id"`
+	ReadAt        sql.NullTime  `db:"read_at" json:"read_at"`
+	CreatedAt     sql.NullTime  `db:"created_at" json:"created_at"`
+	SendEmail     bool          `db:"send_email" json:"send_email"`
+	EmailedAt     sql.NullTime  `db:"emailed_at" json:"emailed_at"`
+	EmailAttempts int32         `db:"email_attempts" json:"email_attempts"`
+}
+
+type Order struct {
+	ID            uuid.UUID      `db:"id" json:"id"`
+	UserID        uuid.NullUUID  `db:"user_id" json:"user_id"`
+	ProductID     uuid.NullUUID  `db:"product_id" json:"product_id"`
+	Quantity      int32          `db:"quantity" json:"quantity"`
+	TotalPrice    string         `db:"total_price" json:"total_price"`
+	Status        sql.NullString `db:"status" json:"status"`
+	CreatedAt     sql.NullTime   `db:"created_at" json:"created_at"`
+	DiscountTotal string         `db:"discount_total" json:"discount_total"`
+}
+
+type OrderDiscount struct {
+	ID        uuid.UUID    `db:"id" json:"id"`
+	OrderID   uuid.UUID    `db:"order_id" json:"order_id"`
+	CouponID  uuid.UUID    `db:"coupon_id" json:"coupon_id"`
+	Code      string       `db:"code" json:"code"`
+	Amount    string       `db:"amount" json:"amount"`
+	CreatedAt sql.NullTime `db:"created_at" json:"created_at"`
+}
+
+type Product struct {
+	ID                uuid.UUID     `db:"id" json:"id"`
+	Name              string        `db:"name" json:"name"`
+	Description       string        `db:"description" json:"description"`
+	Price             string        `db:"price" json:"price"`
+	Stock             int32         `db:"stock" json:"stock"`
+	ProductUrl        string        `db:"product_url" json:"product_url"`
+	Category          string        `db:"category" json:"category"`
+	Type              string        `db:"type" json:"type"`
+	CreatedBy         uuid.NullUUID `db:"created_by" json:"created_by"`
+	CreatedAt         sql.NullTime  `db:"created_at" json:"created_at"`
+	Status            string        `db:"status" json:"status"`
+	DeletedAt         sql.NullTime  `db:"deleted_at" json:"deleted_at"`
+	CategoryID        uuid.UUID     `db:"category_id" json:"category_id"`
+	RatingAverage     string        `db:"rating_average" json:"rating_average"`
+	RatingCount       int32         `db:"rating_count" json:"rating_count"`
+	Version           int32         `db:"version" json:"version"`
+	LowStockThreshold int32         `db:"low_stock_threshold" json:"low_stock_threshold"`
+	Kind              string        `db:"kind" json:"kind"`
+}
+
+type ProductAnswer struct {
+	ID          uuid.UUID    `db:"id" json:"id"`
+	QuestionID  uuid.UUID    `db:"question_id" json:"question_id"`
+	UserID      uuid.UUID    `db:"user_id" json:"user_id"`
+	Body        string       `db:"body" json:"body"`
+	UpvoteCount int32        `db:"upvote_count" json:"upvote_count"`
+	FlagCount   int32        `db:"flag_count" json:"flag_count"`
+	Hidden      bool         `db:"hidden" json:"hidden"`
+	CreatedAt   sql.NullTime `db:"created_at" json:"created_at"`
+}
+
+type ProductListing struct {
+	ID               uuid.UUID `db:"id" json:"id"`
+	OrganizationName string    `db:"organization_name" json:"organization_name"`
+	EffectivePrice   string    `db:"effective_price" json:"effective_price"`
+	InStock          bool      `db:"in_stock" json:"in_stock"`
+	UnitsSold        int64     `db:"units_sold" json:"units_sold"`
+}
+
+type ProductPrice struct {
+	ID        uuid.UUID     `db:"id" json:"id"`
+	ProductID uuid.UUID     `db:"product_id" json:"product_id"`
+	Price     string        `db:"price" json:"price"`
+	Kind      string        `db:"kind" json:"kind"`
+	ValidFrom time.Time     `db:"valid_from" json:"valid_from"`
+	ValidTo   sql.NullTime  `db:"valid_to" json:"valid_to"`
+	CreatedBy uuid.NullUUID `db:"created_by" json:"created_by"`
+	CreatedAt sql.NullTime  `db:"created_at" json:"created_at"`
+}
+
+type ProductQuestion struct {
+	ID          uuid.UUID    `db:"id" json:"id"`
+	ProductID   uuid.UUID    `db:"product_id" json:"product_id"`
+	UserID      uuid.UUID    `db:"user_id" json:"user_id"`
+	Body        string       `db:"body" json:"body"`
+	UpvoteCount int32        `db:"upvote_count" json:"upvote_count"`
+	FlagCount   int32        `db:"flag_count" json:"flag_count"`
+	Hidden      bool         `db:"hidden" json:"hidden"`
+	CreatedAt   sql.NullTime `db:"created_at" json:"created_at"`
+}
+
+type ProductSearch struct {
+	ProductID uuid.UUID   `db:"product_id" json:"product_id"`
+	Language  string      `db:"language" json:"language"`
+	Document  interface{} `db:"document" json:"document"`
+}
+
+type ProductView struct {
+	ProductID uuid.UUID `db:"product_id" json:"product_id"`
+	Day       time.Time `db:"day" json:"day"`
+	Views     int64     `db:"views" json:"views"`
+}
+
+type QuestionFlag struct {
+	QuestionID uuid.UUID    `db:"question_id" json:"question_id"`
+	UserID     uuid.UUID    `db:"user_id" json:"user_id"`
+	Reason     string       `db:"reason" json:"reason"`
+	CreatedAt  sql.NullTime `db:"created_at" json:"created_at"`
+}
+
+type QuestionVote struct {
+	QuestionID uuid.UUID    `db:"question_id" json:"question_id"`
+	UserID     uuid.UUID    `db:"user_id" json:"user_id"`
+	CreatedAt  sql.NullTime `db:"created_at" json:"created_at"`
+}
+
+type Review struct {
+	ID              uuid.UUID      `db:"id" json:"id"`
+	ProductID       uuid.UUID      `db:"product_id" json:"product_id"`
+	UserID          uuid.UUID      `db:"user_id" json:"user_id"`
+	Rating          int32          `db:"rating" json:"rating"`
+	Title           string         `db:"title" json:"title"`
+	Body            string         `db:"body" json:"body"`
+	Images          []string       `db:"images" json:"images"`
+	HelpfulCount    int32          `db:"helpful_count" json:"helpful_count"`
+	SellerReply     sql.NullString `db:"seller_reply" json:"seller_reply"`
+	SellerRepliedAt sql.NullTime   `db:"seller_replied_at" json:"seller_replied_at"`
+	CreatedAt       sql.NullTime   `db:"created_at" json:"created_at"`
+}
+
+type ReviewVote struct {
+	ReviewID  uuid.UUID    `db:"review_id" json:"review_id"`
+	UserID    uuid.UUID    `db:"user_id" json:"user_id"`
+	CreatedAt sql.NullTime `db:"created_at" json:"created_at"`
+}
+
+type SearchClick struct {
+	ID            uuid.UUID `db:"id" json:"id"`
+	SearchQueryID uuid.UUID `db:"search_query_id" json:"search_query_id"`
+	ProductID     uuid.UUID `db:"product_id" json:"product_id"`
+	Position      int32     `db:"position" json:"position"`
+	CreatedAt     time.Time `db:"created_at" json:"created_at"`
+}
+
+type SearchLanguage struct {
+	Name string `db:"name" json:"name"`
+}
+
+type SearchOutbox struct {
+	ID            int64        `db:"id" json:"id"`
+	ProductID     uuid.UUID    `db:"product_id" json:"product_id"`
+	Attempts      int32        `db:"attempts" json:"attempts"`
+	LastError     string       `db:"last_error" json:"last_error"`
+	NextAttemptAt time.Time    `db:"next_attempt_at" json:"next_attempt_at"`
+	ProcessedAt   sql.NullTime `db:"processed_at" json:"processed_at"`
+	CreatedAt     sql.NullTime `db:"created_at" json:"created_at"`
+}
+
+type SearchQuery struct {
+	ID          uuid.UUID `db:"id" json:"id"`
+	Query       string    `db:"query" json:"query"`
+	Source      string    `db:"source" json:"source"`
+	ResultCount int32     `db:"result_count" json:"result_count"`
+	CreatedAt   time.Time `db:"created_at" json:"created_at"`
+}
+
+type SearchStopword struct {
+	Word      string       `db:"word" json:"word"`
+	CreatedAt sql.NullTime `db:"created_at" json:"created_at"`
+}
+
+type SearchSynonymGroup struct {
+	ID        uuid.UUID     `db:"id" json:"id"`
+	Terms     []string      `db:"terms" json:"terms"`
+	CreatedBy uuid.NullUUID `db:"created_by" json:"created_by"`
+	CreatedAt sql.NullTime  `db:"created_at" json:"created_at"`
+}
+
+type SearchTerm struct {
+	Word string `db:"word" json:"word"`
+	Ndoc int32  `db:"ndoc" json:"ndoc"`
+}
+
 type Session struct {
 	ID         uuid.UUID     `db:"id" json:"id"`
 	UserID     uuid.NullUUID `db:"user_id" json:"user_id"`
@@ -51,6 +292,18 @@ type Session struct {
 	TokenBlock sql.NullBool  `db:"token_block" json:"token_block"`
 }
 
+type StockMovement struct {
+	ID          uuid.UUID     `db:"id" json:"id"`
+	ProductID   uuid.UUID     `db:"product_id" json:"product_id"`
+	Delta       int32         `db:"delta" json:"delta"`
+	StockAfter  int32         `db:"stock_after" json:"stock_after"`
+	Reason      string        `db:"reason" json:"reason"`
+	ActorID     uuid.NullUUID `db:"actor_id" json:"actor_id"`
+	ReferenceID uuid.NullUUID `db:"reference_id" json:"reference_id"`
+	Note        string        `db:"note" json:"note"`
+	CreatedAt   sql.NullTime  `db:"created_at" json:"created_at"`
+}
+
 type User struct {
 	ID               uuid.UUID    `db:"id" json:"id"`
 	Name             string       `db:"name" json:"name"`
@@ -61,3 +314,10 @@ type User struct {
 	UserImage        string       `db:"user_image" json:"user_image"`
 	CreatedAt        sql.NullTime `db:"created_at" json:"created_at"`
 }
+
+type WishlistItem struct {
+	ID        uuid.UUID    `db:"id" json:"id"`
+	UserID    uuid.UUID    `db:"user_id" json:"user_id"`
+	ProductID uuid.UUID    `db:"product_id" json:"product_id"`
+	CreatedAt sql.NullTime `db:"created_at" json:"created_at"`
+}
@@ -0,0 +1,165 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.30.0
+// source: moderation.sql
+
+package db
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/google/uuid"
+)
+
+const countPendingProducts = `-- name: CountPendingProducts :one
+SELECT COUNT(*) FROM products
+WHERE status = 'pending_review' AND deleted_at IS NULL
+`
+
+func (q *Queries) CountPendingProducts(ctx context.Context) (int64, error) {
+	row := q.db.QueryRowContext(ctx, countPendingProducts)
+	var count int64
+	err := row.Scan(&count)
+	return count, err
+}
+
+const createModerationLog = `-- name: CreateModerationLog :one
+INSERT INTO moderation_log (product_id, moderator_id, action, reason)
+VALUES ($1, $2, $3, $4)
+RETURNING id, product_id, moderator_id, action, reason, created_at
+`
+
+type CreateModerationLogParams struct {
+	ProductID   uuid.UUID     `db:"product_id" json:"product_id"`
+	ModeratorID uuid.NullUUID `db:"moderator_id" json:"moderator_id"`
+	Action      string        `db:"action" json:"action"`
+	Reason      string        `db:"reason" json:"reason"`
+}
+
+func (q *Queries) CreateModerationLog(ctx context.Context, arg CreateModerationLogParams) (ModerationLog, error) {
+	row := q.db.QueryRowContext(ctx, createModerationLog,
+		arg.ProductID,
+		arg.ModeratorID,
+		arg.Action,
+		arg.Reason,
+	)
+	var i ModerationLog
+	err := row.Scan(
+		&i.ID,
+		&i.ProductID,
+		&i.ModeratorID,
+		&i.Action,
+		&i.Reason,
+		&i.CreatedAt,
+	)
+	return i, err
+}
+
+const listModerationLog = `-- name: ListModerationLog :many
+SELECT id, product_id, moderator_id, action, reason, created_at FROM moderation_log
+WHERE product_id = $1
+  AND ($2::timestamp IS NULL
+       OR (created_at, id) < ($2::timestamp, $3::uuid))
+ORDER BY created_at DESC, id DESC
+LIMIT $4
+`
+
+type ListModerationLogParams struct {
+	ProductID      uuid.UUID     `db:"product_id" json:"product_id"`
+	AfterCreatedAt sql.NullTime  `db:"after_created_at" json:"after_created_at"`
+	AfterID        uuid.NullUUID `db:"after_id" json:"after_id"`
+	LimitCount     int32         `db:"limit_count" json:"limit_count"`
+}
+
+func (q *Queries) ListModerationLog(ctx context.Context, arg ListModerationLogParams) ([]ModerationLog, error) {
+	rows, err := q.db.QueryContext(ctx, listModerationLog,
+		arg.ProductID,
+		arg.AfterCreatedAt,
+		arg.AfterID,
+		arg.LimitCount,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []ModerationLog{}
+	for rows.Next() {
+		var i ModerationLog
+		if err := rows.Scan(
+			&i.ID,
+			&i.ProductID,
+			&i.ModeratorID,
+			&i.Action,
+			&i.Reason,
+			&i.CreatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listPendingProducts = `-- name: ListPendingProducts :many
+SELECT id, name, description, price, stock, product_url, category, type, created_by, created_at, status, deleted_at, category_id, rating_average, rating_count, version, low_stock_threshold, kind FROM products
+WHERE status = 'pending_review' AND deleted_at IS NULL
+  AND ($1::timestamp IS NULL
+       OR (created_at, id) > ($1::timestamp, $2::uuid))
+ORDER BY created_at ASC, id ASC
+LIMIT $3
+`
+
+type ListPendingProductsParams struct {
+	AfterCreatedAt sql.NullTime  `db:"after_created_at" json:"after_created_at"`
+	AfterID        uuid.NullUUID `db:"after_id" json:"after_id"`
+	LimitCount     int32         `db:"limit_count" json:"limit_count"`
+}
+
+func (q *Queries) ListPendingProducts(ctx context.Context, arg ListPendingProductsParams) ([]Product, error) {
+	rows, err := q.db.QueryContext(ctx, listPendingProducts, arg.AfterCreatedAt, arg.AfterID, arg.LimitCount)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []Product{}
+	for rows.Next() {
+		var i Product
+		if err := rows.Scan(
+			&i.ID,
+			&i.Name,
+			&i.Description,
+			&i.Price,
+			&i.Stock,
+			&i.ProductUrl,
+			&i.Category,
+			&i.Type,
+			&i.CreatedBy,
+			&i.CreatedAt,
+			&i.Status,
+			&i.DeletedAt,
+			&i.CategoryID,
+			&i.RatingAverage,
+			&i.RatingCount,
+			&i.Version,
+			&i.LowStockThreshold,
+			&i.Kind,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
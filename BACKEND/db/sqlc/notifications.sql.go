@@ -0,0 +1,232 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.30.0
+// source: notifications.sql
+
+package db
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/google/uuid"
+)
+
+const createNotification = `-- name: CreateNotification :one
+INSERT INTO notifications (user_id, kind, title, body, product_id, send_email)
+VALUES ($1, $2, $3, $4, $5, $6)
+RETURNING id, user_id, kind, title, body, product_id, read_at, created_at, send_email, emailed_at, email_attempts
+`
+
+type CreateNotificationParams struct {
+	UserID    uuid.UUID     `db:"user_id" json:"user_id"`
+	Kind      string        `db:"kind" json:"kind"`
+	Title     string        `db:"title" json:"title"`
+	Body      string        `db:"body" json:"body"`
+	ProductID uuid.NullUUID `db:"product_id" json:"product_id"`
+	SendEmail bool          `db:"send_email" json:"send_email"`
+}
+
+func (q *Queries) CreateNotification(ctx context.Context, arg CreateNotificationParams) (Notification, error) {
+	row := q.db.QueryRowContext(ctx, createNotification,
+		arg.UserID,
+		arg.Kind,
+		arg.Title,
+		arg.Body,
+		arg.ProductID,
+		arg.SendEmail,
+	)
+	var i Notification
+	err := row.Scan(
+		&i.ID,
+		&i.UserID,
+		&i.Kind,
+		&i.Title,
+		&i.Body,
+		&i.ProductID,
+		&i.ReadAt,
+		&i.CreatedAt,
+		&i.SendEmail,
+		&i.EmailedAt,
+		&i.EmailAttempts,
+	)
+	return i, err
+}
+
+const enqueueBackInStockNotifications = `-- name: EnqueueBackInStockNotifications :execrows
+INSERT INTO notifications (user_id, kind, title, body, product_id)
+SELECT w.user_id, 'back_in_stock', 'Back in stock', p.name || ' is available again', p.id
+FROM wishlist_items w
+JOIN products p ON p.id = w.product_id
+WHERE w.product_id = $1 AND p.status = 'published' AND p.deleted_at IS NULL
+`
+
+func (q *Queries) EnqueueBackInStockNotifications(ctx context.Context, productID uuid.UUID) (int64, error) {
+	result, err := q.db.ExecContext(ctx, enqueueBackInStockNotifications, productID)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected()
+}
+
+const listNotifications = `-- name: ListNotifications :many
+SELECT id, user_id, kind, title, body, product_id, read_at, created_at, send_email, emailed_at, email_attempts FROM notifications
+WHERE user_id = $1
+  AND (NOT $2::boolean OR read_at IS NULL)
+  AND ($3::timestamp IS NULL
+       OR (created_at, id) < ($3::timestamp, $4::uuid))
+ORDER BY created_at DESC, id DESC
+LIMIT $5
+`
+
+type ListNotificationsParams struct {
+	UserID         uuid.UUID     `db:"user_id" json:"user_id"`
+	UnreadOnly     bool          `db:"unread_only" json:"unread_only"`
+	AfterCreatedAt sql.NullTime  `db:"after_created_at" json:"after_created_at"`
+	AfterID        uuid.NullUUID `db:"after_id" json:"after_id"`
+	LimitCount     int32         `db:"limit_count" json:"limit_count"`
+}
+
+func (q *Queries) ListNotifications(ctx context.Context, arg ListNotificationsParams) ([]Notification, error) {
+	rows, err := q.db.QueryContext(ctx, listNotifications,
+		arg.UserID,
+		arg.UnreadOnly,
+		arg.AfterCreatedAt,
+		arg.AfterID,
+		arg.LimitCount,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []Notification{}
+	for rows.Next() {
+		var i Notification
+		if err := rows.Scan(
+			&i.ID,
+			&i.UserID,
+			&i.Kind,
+			&i.Title,
+			&i.Body,
+			&i.ProductID,
+			&i.ReadAt,
+			&i.CreatedAt,
+			&i.SendEmail,
+			&i.EmailedAt,
+			&i.EmailAttempts,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listPendingEmailNotifications = `-- name: ListPendingEmailNotifications :many
+SELECT n.id, n.title, n.body, u.email
+FROM notifications n
+JOIN users u ON u.id = n.user_id
+WHERE n.send_email AND n.emailed_at IS NULL
+  AND n.email_attempts < $1
+ORDER BY n.created_at
+LIMIT $2
+`
+
+type ListPendingEmailNotificationsParams struct {
+	MaxAttempts int32 `db:"max_attempts" json:"max_attempts"`
+	LimitCount  int32 `db:"limit_count" json:"limit_count"`
+}
+
+type ListPendingEmailNotificationsRow struct {
+	ID    uuid.UUID `db:"id" json:"id"`
+	Title string    `db:"title" json:"title"`
+	Body  string    `db:"body" json:"body"`
+	Email string    `db:"email" json:"email"`
+}
+
+func (q *Queries) ListPendingEmailNotifications(ctx context.Context, arg ListPendingEmailNotificationsParams) ([]ListPendingEmailNotificationsRow, error) {
+	rows, err := q.db.QueryContext(ctx, listPendingEmailNotifications, arg.MaxAttempts, arg.LimitCount)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []ListPendingEmailNotificationsRow{}
+	for rows.Next() {
+		var i ListPendingEmailNotificationsRow
+		if err := rows.Scan(
+			&i.ID,
+			&i.Title,
+			&i.Body,
+			&i.Email,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const markNotificationEmailed = `-- name: MarkNotificationEmailed :exec
+UPDATE notifications
+SET emailed_at = NOW()
+WHERE id = $1
+`
+
+func (q *Queries) MarkNotificationEmailed(ctx context.Context, id uuid.UUID) error {
+	_, err := q.db.ExecContext(ctx, markNotificationEmailed, id)
+	return err
+}
+
+const markNotificationRead = `-- name: MarkNotificationRead :one
+UPDATE notifications
+SET read_at = COALESCE(read_at, NOW())
+WHERE id = $1 AND user_id = $2
+RETURNING id, user_id, kind, title, body, product_id, read_at, created_at, send_email, emailed_at, email_attempts
+`
+
+type MarkNotificationReadParams struct {
+	ID     uuid.UUID `db:"id" json:"id"`
+	UserID uuid.UUID `db:"user_id" json:"user_id"`
+}
+
+func (q *Queries) MarkNotificationRead(ctx context.Context, arg MarkNotificationReadParams) (Notification, error) {
+	row := q.db.QueryRowContext(ctx, markNotificationRead, arg.ID, arg.UserID)
+	var i Notification
+	err := row.Scan(
+		&i.ID,
+		&i.UserID,
+		&i.Kind,
+		&i.Title,
+		&i.Body,
+		&i.ProductID,
+		&i.ReadAt,
+		&i.CreatedAt,
+		&i.SendEmail,
+		&i.EmailedAt,
+		&i.EmailAttempts,
+	)
+	return i, err
+}
+
+const recordNotificationEmailFailure = `-- name: RecordNotificationEmailFailure :exec
+UPDATE notifications
+SET email_attempts = email_attempts + 1
+WHERE id = $1
+`
+
+func (q *Queries) RecordNotificationEmailFailure(ctx context.Context, id uuid.UUID) error {
+	_, err := q.db.ExecContext(ctx, recordNotificationEmailFailure, id)
+	return err
+}
@@ -15,10 +15,11 @@ import (
 const cancelOrder = `-- name: CancelOrder :one
 UPDATE orders
 SET status = 'cancelled'
-WHERE id = $1
-RETURNING id, user_id, product_id, quantity, total_price, status, created_at
+WHERE id = $1 AND status = 'pending'
+RETURNING id, user_id, product_id, quantity, total_price, status, created_at, discount_total
 `
 
+// Only pending orders can be cancelled, completed ones were delivered
 func (q *Queries) CancelOrder(ctx context.Context, id uuid.UUID) (Order, error) {
 	row := q.db.QueryRowContext(ctx, cancelOrder, id)
 	var i Order
@@ -30,21 +31,23 @@ func (q *Queries) CancelOrder(ctx context.Context, id uuid.UUID) (Order, error)
 		&i.TotalPrice,
 		&i.Status,
 		&i.CreatedAt,
+		&i.DiscountTotal,
 	)
 	return i, err
 }
 
 const createOrder = `-- name: CreateOrder :one
-INSERT INTO orders (user_id, product_id, quantity, total_price, status)
-VALUES ($1, $2, $3, $4, 'pending')
-RETURNING id, user_id, product_id, quantity, total_price, status, created_at
+INSERT INTO orders (user_id, product_id, quantity, total_price, discount_total, status)
+VALUES ($1, $2, $3, $4, $5, 'pending')
+RETURNING id, user_id, product_id, quantity, total_price, status, created_at, discount_total
 `
 
 type CreateOrderParams struct {
-	UserID     uuid.NullUUID `db:"user_id" json:"user_id"`
-	ProductID  uuid.NullUUID `db:"product_id" json:"product_id"`
-	Quantity   int32         `db:"quantity" json:"quantity"`
-	TotalPrice string        `db:"total_price" json:"total_price"`
+	UserID        uuid.NullUUID `db:"user_id" json:"user_id"`
+	ProductID     uuid.NullUUID `db:"product_id" json:"product_id"`
+	Quantity      int32         `db:"quantity" json:"quantity"`
+	TotalPrice    string        `db:"total_price" json:"total_price"`
+	DiscountTotal string        `db:"discount_total" json:"discount_total"`
 }
 
 func (q *Queries) CreateOrder(ctx context.Context, arg CreateOrderParams) (Order, error) {
@@ -53,6 +56,7 @@ func (q *Queries) CreateOrder(ctx context.Context, arg CreateOrderParams) (Order
 		arg.ProductID,
 		arg.Quantity,
 		arg.TotalPrice,
+		arg.DiscountTotal,
 	)
 	var i Order
 	err := row.Scan(
@@ -63,12 +67,13 @@ func (q *Queries) CreateOrder(ctx context.Context, arg CreateOrderParams) (Order
 		&i.TotalPrice,
 		&i.Status,
 		&i.CreatedAt,
+		&i.DiscountTotal,
 	)
 	return i, err
 }
 
 const getOrderByID = `-- name: GetOrderByID :one
-SELECT id, user_id, product_id, quantity, total_price, status, created_at FROM orders WHERE id = $1
+SELECT id, user_id, product_id, quantity, total_price, status, created_at, discount_total FROM orders WHERE id = $1
 `
 
 func (q *Queries) GetOrderByID(ctx context.Context, id uuid.UUID) (Order, error) {
@@ -82,16 +87,34 @@ func (q *Queries) GetOrderByID(ctx context.Context, id uuid.UUID) (Order, error)
 		&i.TotalPrice,
 		&i.Status,
 		&i.CreatedAt,
+		&i.DiscountTotal,
 	)
 	return i, err
 }
 
 const getOrdersByUser = `-- name: GetOrdersByUser :many
-SELECT id, user_id, product_id, quantity, total_price, status, created_at FROM orders WHERE user_id = $1 ORDER BY created_at DESC
+SELECT id, user_id, product_id, quantity, total_price, status, created_at, discount_total FROM orders
+WHERE user_id = $1
+  AND ($2::timestamp IS NULL
+       OR (created_at, id) < ($2::timestamp, $3::uuid))
+ORDER BY created_at DESC, id DESC
+LIMIT $4
 `
 
-func (q *Queries) GetOrdersByUser(ctx context.Context, userID uuid.NullUUID) ([]Order, error) {
-	rows, err := q.db.QueryContext(ctx, getOrdersByUser, userID)
+type GetOrdersByUserParams struct {
+	UserID         uuid.NullUUID `db:"user_id" json:"user_id"`
+	AfterCreatedAt sql.NullTime  `db:"after_created_at" json:"after_created_at"`
+	AfterID        uuid.NullUUID `db:"after_id" json:"after_id"`
+	LimitCount     int32         `db:"limit_count" json:"limit_count"`
+}
+
+func (q *Queries) GetOrdersByUser(ctx context.Context, arg GetOrdersByUserParams) ([]Order, error) {
+	rows, err := q.db.QueryContext(ctx, getOrdersByUser,
+		arg.UserID,
+		arg.AfterCreatedAt,
+		arg.AfterID,
+		arg.LimitCount,
+	)
 	if err != nil {
 		return nil, err
 	}
@@ -107,6 +130,7 @@ func (q *Queries) GetOrdersByUser(ctx context.Context, userID uuid.NullUUID) ([]
 			&i.TotalPrice,
 			&i.Status,
 			&i.CreatedAt,
+			&i.DiscountTotal,
 		); err != nil {
 			return nil, err
 		}
@@ -124,8 +148,8 @@ func (q *Queries) GetOrdersByUser(ctx context.Context, userID uuid.NullUUID) ([]
 const updateOrderStatus = `-- name: UpdateOrderStatus :one
 UPDATE orders
 SET status = $2
-WHERE id = $1
-RETURNING id, user_id, product_id, quantity, total_price, status, created_at
+WHERE id = $1 AND status = 'pending'
+RETURNING id, user_id, product_id, quantity, total_price, status, created_at, discount_total
 `
 
 type UpdateOrderStatusParams struct {
@@ -133,6 +157,7 @@ type UpdateOrderStatusParams struct {
 	Status sql.NullString `db:"status" json:"status"`
 }
 
+// Orders only move on from pending, cancelling goes through CancelOrder
 func (q *Queries) UpdateOrderStatus(ctx context.Context, arg UpdateOrderStatusParams) (Order, error) {
 	row := q.db.QueryRowContext(ctx, updateOrderStatus, arg.ID, arg.Status)
 	var i Order
@@ -144,6 +169,7 @@ func (q *Queries) UpdateOrderStatus(ctx context.Context, arg UpdateOrderStatusPa
 		&i.TotalPrice,
 		&i.Status,
 		&i.CreatedAt,
+		&i.DiscountTotal,
 	)
 	return i, err
 }
@@ -0,0 +1,295 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.30.0
+// source: product_listings.sql
+
+package db
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/google/uuid"
+	"github.com/lib/pq"
+)
+
+const countQueryProducts = `-- name: CountQueryProducts :one
+SELECT COUNT(*)
+FROM products p
+JOIN product_listings l ON l.id = p.id
+LEFT JOIN product_search ps ON ps.product_id = p.id AND ps.language = $1::text
+CROSS JOIN (SELECT search_query($1::text::regconfig, $2::text[]) AS query) q
+WHERE p.status = 'published'
+  AND p.deleted_at IS NULL
+  AND (cardinality($2::text[]) = 0 OR ps.document @@ q.query)
+  AND (cardinality($3::text[]) = 0 OR p.category = ANY($3::text[]))
+  AND (cardinality($4::text[]) = 0 OR p.type = ANY($4::text[]))
+  AND ($5::numeric IS NULL OR l.effective_price >= $5::numeric)
+  AND ($6::numeric IS NULL OR l.effective_price <= $6::numeric)
+  AND (NOT $7::boolean OR l.in_stock)
+  AND ($8::uuid IS NULL OR p.created_by = $8::uuid)
+  AND ($9::text = '' OR lower(l.organization_name) = lower($9::text))
+  AND ($10::numeric IS NULL OR p.rating_average >= $10::numeric)
+`
+
+type CountQueryProductsParams struct {
+	Language     string         `db:"language" json:"language"`
+	Queries      []string       `db:"queries" json:"queries"`
+	Categories   []string       `db:"categories" json:"categories"`
+	Types        []string       `db:"types" json:"types"`
+	MinPrice     sql.NullString `db:"min_price" json:"min_price"`
+	MaxPrice     sql.NullString `db:"max_price" json:"max_price"`
+	InStockOnly  bool           `db:"in_stock_only" json:"in_stock_only"`
+	SellerID     uuid.NullUUID  `db:"seller_id" json:"seller_id"`
+	Organization string         `db:"organization" json:"organization"`
+	MinRating    sql.NullString `db:"min_rating" json:"min_rating"`
+}
+
+func (q *Queries) CountQueryProducts(ctx context.Context, arg CountQueryProductsParams) (int64, error) {
+	row := q.db.QueryRowContext(ctx, countQueryProducts,
+		arg.Language,
+		pq.Array(arg.Queries),
+		pq.Array(arg.Categories),
+		pq.Array(arg.Types),
+		arg.MinPrice,
+		arg.MaxPrice,
+		arg.InStockOnly,
+		arg.SellerID,
+		arg.Organization,
+		arg.MinRating,
+	)
+	var count int64
+	err := row.Scan(&count)
+	return count, err
+}
+
+const queryProductFacets = `-- name: QueryProductFacets :many
+SELECT 'category'::text AS facet, p.category::text AS value, COUNT(*) AS count
+FROM products p
+JOIN product_listings l ON l.id = p.id
+LEFT JOIN product_search ps ON ps.product_id = p.id AND ps.language = $1::text
+CROSS JOIN (SELECT search_query($1::text::regconfig, $2::text[]) AS query) q
+WHERE p.status = 'published'
+  AND p.deleted_at IS NULL
+  AND (cardinality($2::text[]) = 0 OR ps.document @@ q.query)
+  AND (cardinality($3::text[]) = 0 OR p.type = ANY($3::text[]))
+  AND ($4::numeric IS NULL OR l.effective_price >= $4::numeric)
+  AND ($5::numeric IS NULL OR l.effective_price <= $5::numeric)
+  AND (NOT $6::boolean OR l.in_stock)
+  AND ($7::uuid IS NULL OR p.created_by = $7::uuid)
+  AND ($8::text = '' OR lower(l.organization_name) = lower($8::text))
+  AND ($9::numeric IS NULL OR p.rating_average >= $9::numeric)
+GROUP BY p.category
+UNION ALL
+SELECT 'type'::text AS facet, p.type::text AS value, COUNT(*) AS count
+FROM products p
+JOIN product_listings l ON l.id = p.id
+LEFT JOIN product_search ps ON ps.product_id = p.id AND ps.language = $1::text
+CROSS JOIN (SELECT search_query($1::text::regconfig, $2::text[]) AS query) q
+WHERE p.status = 'published'
+  AND p.deleted_at IS NULL
+  AND (cardinality($2::text[]) = 0 OR ps.document @@ q.query)
+  AND (cardinality($10::text[]) = 0 OR p.category = ANY($10::text[]))
+  AND ($4::numeric IS NULL OR l.effective_price >= $4::numeric)
+  AND ($5::numeric IS NULL OR l.effective_price <= $5::numeric)
+  AND (NOT $6::boolean OR l.in_stock)
+  AND ($7::uuid IS NULL OR p.created_by = $7::uuid)
+  AND ($8::text = '' OR lower(l.organization_name) = lower($8::text))
+  AND ($9::numeric IS NULL OR p.rating_average >= $9::numeric)
+GROUP BY p.type
+UNION ALL
+SELECT 'price'::text AS facet, width_bucket(l.effective_price, $11::numeric[])::text AS value, COUNT(*) AS count
+FROM products p
+JOIN product_listings l ON l.id = p.id
+LEFT JOIN product_search ps ON ps.product_id = p.id AND ps.language = $1::text
+CROSS JOIN (SELECT search_query($1::text::regconfig, $2::text[]) AS query) q
+WHERE p.status = 'published'
+  AND p.deleted_at IS NULL
+  AND (cardinality($2::text[]) = 0 OR ps.document @@ q.query)
+  AND (cardinality($10::text[]) = 0 OR p.category = ANY($10::text[]))
+  AND (cardinality($3::text[]) = 0 OR p.type = ANY($3::text[]))
+  AND (NOT $6::boolean OR l.in_stock)
+  AND ($7::uuid IS NULL OR p.created_by = $7::uuid)
+  AND ($8::text = '' OR lower(l.organization_name) = lower($8::text))
+  AND ($9::numeric IS NULL OR p.rating_average >= $9::numeric)
+GROUP BY 2
+ORDER BY facet, count DESC, value
+`
+
+type QueryProductFacetsParams struct {
+	Language     string         `db:"language" json:"language"`
+	Queries      []string       `db:"queries" json:"queries"`
+	Types        []string       `db:"types" json:"types"`
+	MinPrice     sql.NullString `db:"min_price" json:"min_price"`
+	MaxPrice     sql.NullString `db:"max_price" json:"max_price"`
+	InStockOnly  bool           `db:"in_stock_only" json:"in_stock_only"`
+	SellerID     uuid.NullUUID  `db:"seller_id" json:"seller_id"`
+	Organization string         `db:"organization" json:"organization"`
+	MinRating    sql.NullString `db:"min_rating" json:"min_rating"`
+	Categories   []string       `db:"categories" json:"categories"`
+	PriceBounds  []string       `db:"price_bounds" json:"price_bounds"`
+}
+
+type QueryProductFacetsRow struct {
+	Facet string `db:"facet" json:"facet"`
+	Value string `db:"value" json:"value"`
+	Count int64  `db:"count" json:"count"`
+}
+
+// Each facet is counted without its own filter so the other values stay
+// selectable. Price buckets are numbered by width_bucket over price_bounds,
+// 0 is below the first bound and len(price_bounds) at or above the last one
+func (q *Queries) QueryProductFacets(ctx context.Context, arg QueryProductFacetsParams) ([]QueryProductFacetsRow, error) {
+	rows, err := q.db.QueryContext(ctx, queryProductFacets,
+		arg.Language,
+		pq.Array(arg.Queries),
+		pq.Array(arg.Types),
+		arg.MinPrice,
+		arg.MaxPrice,
+		arg.InStockOnly,
+		arg.SellerID,
+		arg.Organization,
+		arg.MinRating,
+		pq.Array(arg.Categories),
+		pq.Array(arg.PriceBounds),
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []QueryProductFacetsRow{}
+	for rows.Next() {
+		var i QueryProductFacetsRow
+		if err := rows.Scan(&i.Facet, &i.Value, &i.Count); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const queryProducts = `-- name: QueryProducts :many
+SELECT p.id, p.name, p.description, p.price, p.stock, p.product_url, p.category, p.type, p.created_by, p.created_at, p.status, p.deleted_at, p.category_id, p.rating_average, p.rating_count, p.version, p.low_stock_threshold, p.kind, k.sort_key
+FROM products p
+JOIN product_listings l ON l.id = p.id
+LEFT JOIN product_search ps ON ps.product_id = p.id AND ps.language = $1::text
+CROSS JOIN (SELECT search_query($1::text::regconfig, $2::text[]) AS query) q
+CROSS JOIN LATERAL (
+    SELECT (CASE $3::text
+        WHEN 'price_asc' THEN -round(l.effective_price * 100)
+        WHEN 'price_desc' THEN round(l.effective_price * 100)
+        WHEN 'popularity' THEN l.units_sold
+        WHEN 'rating' THEN round(p.rating_average * 100)
+        WHEN 'relevance' THEN round(COALESCE(ts_rank_cd('{0.1, 0.2, 0.4, 1.0}', ps.document, q.query), 0) * 1000000)
+        ELSE 0
+    END)::bigint AS sort_key
+) k
+WHERE p.status = 'published'
+  AND p.deleted_at IS NULL
+  AND (cardinality($2::text[]) = 0 OR ps.document @@ q.query)
+  AND (cardinality($4::text[]) = 0 OR p.category = ANY($4::text[]))
+  AND (cardinality($5::text[]) = 0 OR p.type = ANY($5::text[]))
+  AND ($6::numeric IS NULL OR l.effective_price >= $6::numeric)
+  AND ($7::numeric IS NULL OR l.effective_price <= $7::numeric)
+  AND (NOT $8::boolean OR l.in_stock)
+  AND ($9::uuid IS NULL OR p.created_by = $9::uuid)
+  AND ($10::text = '' OR lower(l.organization_name) = lower($10::text))
+  AND ($11::numeric IS NULL OR p.rating_average >= $11::numeric)
+  AND ($12::bigint IS NULL
+       OR (k.sort_key, p.created_at, p.id) < ($12::bigint, $13::timestamp, $14::uuid))
+ORDER BY k.sort_key DESC, p.created_at DESC, p.id DESC
+LIMIT $15
+`
+
+type QueryProductsParams struct {
+	Language       string         `db:"language" json:"language"`
+	Queries        []string       `db:"queries" json:"queries"`
+	Sort           string         `db:"sort" json:"sort"`
+	Categories     []string       `db:"categories" json:"categories"`
+	Types          []string       `db:"types" json:"types"`
+	MinPrice       sql.NullString `db:"min_price" json:"min_price"`
+	MaxPrice       sql.NullString `db:"max_price" json:"max_price"`
+	InStockOnly    bool           `db:"in_stock_only" json:"in_stock_only"`
+	SellerID       uuid.NullUUID  `db:"seller_id" json:"seller_id"`
+	Organization   string         `db:"organization" json:"organization"`
+	MinRating      sql.NullString `db:"min_rating" json:"min_rating"`
+	AfterKey       sql.NullInt64  `db:"after_key" json:"after_key"`
+	AfterCreatedAt sql.NullTime   `db:"after_created_at" json:"after_created_at"`
+	AfterID        uuid.NullUUID  `db:"after_id" json:"after_id"`
+	LimitCount     int32          `db:"limit_count" json:"limit_count"`
+}
+
+type QueryProductsRow struct {
+	Product Product `db:"product" json:"product"`
+	SortKey int64   `db:"sort_key" json:"sort_key"`
+}
+
+// The filters are repeated in the count and facet queries below. An empty
+// array or organization and a NULL bound leave that filter out. Every sort
+// orders by sort_key descending, then newest first, so one keyset condition
+// pages them all: prices in cents (negated for price_asc), the rating in
+// hundredths and the relevance in millionths
+func (q *Queries) QueryProducts(ctx context.Context, arg QueryProductsParams) ([]QueryProductsRow, error) {
+	rows, err := q.db.QueryContext(ctx, queryProducts,
+		arg.Language,
+		pq.Array(arg.Queries),
+		arg.Sort,
+		pq.Array(arg.Categories),
+		pq.Array(arg.Types),
+		arg.MinPrice,
+		arg.MaxPrice,
+		arg.InStockOnly,
+		arg.SellerID,
+		arg.Organization,
+		arg.MinRating,
+		arg.AfterKey,
+		arg.AfterCreatedAt,
+		arg.AfterID,
+		arg.LimitCount,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []QueryProductsRow{}
+	for rows.Next() {
+		var i QueryProductsRow
+		if err := rows.Scan(
+			&i.Product.ID,
+			&i.Product.Name,
+			&i.Product.Description,
+			&i.Product.Price,
+			&i.Product.Stock,
+			&i.Product.ProductUrl,
+			&i.Product.Category,
+			&i.Product.Type,
+			&i.Product.CreatedBy,
+			&i.Product.CreatedAt,
+			&i.Product.Status,
+			&i.Product.DeletedAt,
+			&i.Product.CategoryID,
+			&i.Product.RatingAverage,
+			&i.Product.RatingCount,
+			&i.Product.Version,
+			&i.Product.LowStockThreshold,
+			&i.Product.Kind,
+			&i.SortKey,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
@@ -0,0 +1,112 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.30.0
+// source: product_popularity.sql
+
+package db
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/lib/pq"
+)
+
+const deleteProductViewsBefore = `-- name: DeleteProductViewsBefore :execrows
+DELETE FROM product_views
+WHERE day < $1::date
+`
+
+func (q *Queries) DeleteProductViewsBefore(ctx context.Context, before time.Time) (int64, error) {
+	result, err := q.db.ExecContext(ctx, deleteProductViewsBefore, before)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected()
+}
+
+const enqueueSearchOutbox = `-- name: EnqueueSearchOutbox :exec
+INSERT INTO search_outbox (product_id)
+SELECT unnest($1::uuid[])
+`
+
+// Queues products for reindexing, e.g. after their ranking changed
+func (q *Queries) EnqueueSearchOutbox(ctx context.Context, productIds []uuid.UUID) error {
+	_, err := q.db.ExecContext(ctx, enqueueSearchOutbox, pq.Array(productIds))
+	return err
+}
+
+const listProductPopularity = `-- name: ListProductPopularity :many
+SELECT
+    p.id,
+    p.created_at,
+    COALESCE((
+        SELECT COUNT(*) FROM orders o
+        WHERE o.product_id = p.id AND o.status <> 'cancelled'
+          AND o.created_at >= $1::timestamp
+    ), 0)::bigint AS orders,
+    COALESCE((
+        SELECT SUM(v.views) FROM product_views v
+        WHERE v.product_id = p.id AND v.day >= $1::date
+    ), 0)::bigint AS views
+FROM products p
+WHERE p.status = 'published' AND p.deleted_at IS NULL
+  AND p.id > $2
+ORDER BY p.id
+LIMIT $3
+`
+
+type ListProductPopularityParams struct {
+	Since      time.Time `db:"since" json:"since"`
+	AfterID    uuid.UUID `db:"after_id" json:"after_id"`
+	LimitCount int32     `db:"limit_count" json:"limit_count"`
+}
+
+type ListProductPopularityRow struct {
+	ID        uuid.UUID    `db:"id" json:"id"`
+	CreatedAt sql.NullTime `db:"created_at" json:"created_at"`
+	Orders    int64        `db:"orders" json:"orders"`
+	Views     int64        `db:"views" json:"views"`
+}
+
+// Ranking signals of published products since a point in time, paged by id
+func (q *Queries) ListProductPopularity(ctx context.Context, arg ListProductPopularityParams) ([]ListProductPopularityRow, error) {
+	rows, err := q.db.QueryContext(ctx, listProductPopularity, arg.Since, arg.AfterID, arg.LimitCount)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []ListProductPopularityRow{}
+	for rows.Next() {
+		var i ListProductPopularityRow
+		if err := rows.Scan(
+			&i.ID,
+			&i.CreatedAt,
+			&i.Orders,
+			&i.Views,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const recordProductView = `-- name: RecordProductView :exec
+INSERT INTO product_views (product_id, day, views)
+VALUES ($1, CURRENT_DATE, 1)
+ON CONFLICT (product_id, day) DO UPDATE SET views = product_views.views + 1
+`
+
+func (q *Queries) RecordProductView(ctx context.Context, productID uuid.UUID) error {
+	_, err := q.db.ExecContext(ctx, recordProductView, productID)
+	return err
+}
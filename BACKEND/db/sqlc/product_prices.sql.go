@@ -0,0 +1,211 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.30.0
+// source: product_prices.sql
+
+package db
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/lib/pq"
+)
+
+const countOverlappingSales = `-- name: CountOverlappingSales :one
+SELECT COUNT(*) FROM product_prices
+WHERE product_id = $1 AND kind = 'sale'
+  AND valid_from < $2::timestamp AND valid_to > $3::timestamp
+`
+
+type CountOverlappingSalesParams struct {
+	ProductID uuid.UUID `db:"product_id" json:"product_id"`
+	EndsAt    time.Time `db:"ends_at" json:"ends_at"`
+	StartsAt  time.Time `db:"starts_at" json:"starts_at"`
+}
+
+func (q *Queries) CountOverlappingSales(ctx context.Context, arg CountOverlappingSalesParams) (int64, error) {
+	row := q.db.QueryRowContext(ctx, countOverlappingSales, arg.ProductID, arg.EndsAt, arg.StartsAt)
+	var count int64
+	err := row.Scan(&count)
+	return count, err
+}
+
+const createProductPrice = `-- name: CreateProductPrice :one
+INSERT INTO product_prices (product_id, price, kind, valid_from, valid_to, created_by)
+VALUES ($1, $2, $3, $4, $5, $6)
+RETURNING id, product_id, price, kind, valid_from, valid_to, created_by, created_at
+`
+
+type CreateProductPriceParams struct {
+	ProductID uuid.UUID     `db:"product_id" json:"product_id"`
+	Price     string        `db:"price" json:"price"`
+	Kind      string        `db:"kind" json:"kind"`
+	ValidFrom time.Time     `db:"valid_from" json:"valid_from"`
+	ValidTo   sql.NullTime  `db:"valid_to" json:"valid_to"`
+	CreatedBy uuid.NullUUID `db:"created_by" json:"created_by"`
+}
+
+func (q *Queries) CreateProductPrice(ctx context.Context, arg CreateProductPriceParams) (ProductPrice, error) {
+	row := q.db.QueryRowContext(ctx, createProductPrice,
+		arg.ProductID,
+		arg.Price,
+		arg.Kind,
+		arg.ValidFrom,
+		arg.ValidTo,
+		arg.CreatedBy,
+	)
+	var i ProductPrice
+	err := row.Scan(
+		&i.ID,
+		&i.ProductID,
+		&i.Price,
+		&i.Kind,
+		&i.ValidFrom,
+		&i.ValidTo,
+		&i.CreatedBy,
+		&i.CreatedAt,
+	)
+	return i, err
+}
+
+const getActiveSalePrice = `-- name: GetActiveSalePrice :one
+SELECT id, product_id, price, kind, valid_from, valid_to, created_by, created_at FROM product_prices
+WHERE product_id = $1 AND kind = 'sale'
+  AND valid_from <= (NOW() AT TIME ZONE 'UTC') AND valid_to > (NOW() AT TIME ZONE 'UTC')
+ORDER BY valid_from DESC
+LIMIT 1
+`
+
+func (q *Queries) GetActiveSalePrice(ctx context.Context, productID uuid.UUID) (ProductPrice, error) {
+	row := q.db.QueryRowContext(ctx, getActiveSalePrice, productID)
+	var i ProductPrice
+	err := row.Scan(
+		&i.ID,
+		&i.ProductID,
+		&i.Price,
+		&i.Kind,
+		&i.ValidFrom,
+		&i.ValidTo,
+		&i.CreatedBy,
+		&i.CreatedAt,
+	)
+	return i, err
+}
+
+const listActiveSalePrices = `-- name: ListActiveSalePrices :many
+SELECT DISTINCT ON (product_id) id, product_id, price, kind, valid_from, valid_to, created_by, created_at FROM product_prices
+WHERE product_id = ANY($1::uuid[]) AND kind = 'sale'
+  AND valid_from <= (NOW() AT TIME ZONE 'UTC') AND valid_to > (NOW() AT TIME ZONE 'UTC')
+ORDER BY product_id, valid_from DESC
+`
+
+func (q *Queries) ListActiveSalePrices(ctx context.Context, productIds []uuid.UUID) ([]ProductPrice, error) {
+	rows, err := q.db.QueryContext(ctx, listActiveSalePrices, pq.Array(productIds))
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []ProductPrice{}
+	for rows.Next() {
+		var i ProductPrice
+		if err := rows.Scan(
+			&i.ID,
+			&i.ProductID,
+			&i.Price,
+			&i.Kind,
+			&i.ValidFrom,
+			&i.ValidTo,
+			&i.CreatedBy,
+			&i.CreatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listPriceHistory = `-- name: ListPriceHistory :many
+SELECT id, product_id, price, kind, valid_from, valid_to, created_by, created_at FROM product_prices
+WHERE product_id = $1
+  AND ($2::timestamp IS NULL
+       OR (valid_from, created_at, id) < ($2::timestamp, $3::timestamp, $4::uuid))
+ORDER BY valid_from DESC, created_at DESC, id DESC
+LIMIT $5
+`
+
+type ListPriceHistoryParams struct {
+	ProductID      uuid.UUID     `db:"product_id" json:"product_id"`
+	AfterValidFrom sql.NullTime  `db:"after_valid_from" json:"after_valid_from"`
+	AfterCreatedAt sql.NullTime  `db:"after_created_at" json:"after_created_at"`
+	AfterID        uuid.NullUUID `db:"after_id" json:"after_id"`
+	LimitCount     int32         `db:"limit_count" json:"limit_count"`
+}
+
+func (q *Queries) ListPriceHistory(ctx context.Context, arg ListPriceHistoryParams) ([]ProductPrice, error) {
+	rows, err := q.db.QueryContext(ctx, listPriceHistory,
+		arg.ProductID,
+		arg.AfterValidFrom,
+		arg.AfterCreatedAt,
+		arg.AfterID,
+		arg.LimitCount,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []ProductPrice{}
+	for rows.Next() {
+		var i ProductPrice
+		if err := rows.Scan(
+			&i.ID,
+			&i.ProductID,
+			&i.Price,
+			&i.Kind,
+			&i.ValidFrom,
+			&i.ValidTo,
+			&i.CreatedBy,
+			&i.CreatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const recordListPrice = `-- name: RecordListPrice :exec
+WITH closed AS (
+    UPDATE product_prices
+    SET valid_to = (NOW() AT TIME ZONE 'UTC')
+    WHERE product_id = $1 AND kind = 'list' AND valid_to IS NULL
+)
+INSERT INTO product_prices (product_id, price, kind, valid_from, created_by)
+VALUES ($1, $2, 'list', (NOW() AT TIME ZONE 'UTC'), $3)
+`
+
+type RecordListPriceParams struct {
+	ProductID uuid.UUID     `db:"product_id" json:"product_id"`
+	Price     string        `db:"price" json:"price"`
+	CreatedBy uuid.NullUUID `db:"created_by" json:"created_by"`
+}
+
+func (q *Queries) RecordListPrice(ctx context.Context, arg RecordListPriceParams) error {
+	_, err := q.db.ExecContext(ctx, recordListPrice, arg.ProductID, arg.Price, arg.CreatedBy)
+	return err
+}
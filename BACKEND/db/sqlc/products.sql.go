@@ -7,25 +7,42 @@ package db
 
 import (
 	"context"
+	"database/sql"
 
 	"github.com/google/uuid"
 )
 
+const countPublishedProducts = `-- name: CountPublishedProducts :one
+SELECT COUNT(*) FROM products
+WHERE status = 'published' AND deleted_at IS NULL
+`
+
+func (q *Queries) CountPublishedProducts(ctx context.Context) (int64, error) {
+	row := q.db.QueryRowContext(ctx, countPublishedProducts)
+	var count int64
+	err := row.Scan(&count)
+	return count, err
+}
+
 const createProduct = `-- name: CreateProduct :one
-INSERT INTO products (name, description, price, stock, product_url, category, type, created_by)
-VALUES ($1, $2, $3, $4, $5, $6, $7, $8)
-RETURNING id, name, description, price, stock, product_url, category, type, created_by, created_at
+INSERT INTO products (name, description, price, stock, product_url, category, type, created_by, status, category_id, low_stock_threshold, kind)
+VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12)
+RETURNING id, name, description, price, stock, product_url, category, type, created_by, created_at, status, deleted_at, category_id, rating_average, rating_count, version, low_stock_threshold, kind
 `
 
 type CreateProductParams struct {
-	Name        string        `db:"name" json:"name"`
-	Description string        `db:"description" json:"description"`
-	Price       string        `db:"price" json:"price"`
-	Stock       int32         `db:"stock" json:"stock"`
-	ProductUrl  string        `db:"product_url" json:"product_url"`
-	Category    string        `db:"category" json:"category"`
-	Type        string        `db:"type" json:"type"`
-	CreatedBy   uuid.NullUUID `db:"created_by" json:"created_by"`
+	Name              string        `db:"name" json:"name"`
+	Description       string        `db:"description" json:"description"`
+	Price             string        `db:"price" json:"price"`
+	Stock             int32         `db:"stock" json:"stock"`
+	ProductUrl        string        `db:"product_url" json:"product_url"`
+	Category          string        `db:"category" json:"category"`
+	Type              string        `db:"type" json:"type"`
+	CreatedBy         uuid.NullUUID `db:"created_by" json:"created_by"`
+	Status            string        `db:"status" json:"status"`
+	CategoryID        uuid.UUID     `db:"category_id" json:"category_id"`
+	LowStockThreshold int32         `db:"low_stock_threshold" json:"low_stock_threshold"`
+	Kind              string        `db:"kind" json:"kind"`
 }
 
 func (q *Queries) CreateProduct(ctx context.Context, arg CreateProductParams) (Product, error) {
@@ -38,6 +55,10 @@ func (q *Queries) CreateProduct(ctx context.Context, arg CreateProductParams) (P
 		arg.Category,
 		arg.Type,
 		arg.CreatedBy,
+		arg.Status,
+		arg.CategoryID,
+		arg.LowStockThreshold,
+		arg.Kind,
 	)
 	var i Product
 	err := row.Scan(
@@ -51,32 +72,35 @@ func (q *Queries) CreateProduct(ctx context.Context, arg CreateProductParams) (P
 		&i.Type,
 		&i.CreatedBy,
 		&i.CreatedAt,
+		&i.Status,
+		&i.DeletedAt,
+		&i.CategoryID,
+		&i.RatingAverage,
+		&i.RatingCount,
+		&i.Version,
+		&i.LowStockThreshold,
+		&i.Kind,
 	)
 	return i, err
 }
 
-const deleteProduct = `-- name: DeleteProduct :exec
-DELETE FROM products WHERE id = $1
-`
-
-func (q *Queries) DeleteProduct(ctx context.Context, id uuid.UUID) error {
-	_, err := q.db.ExecContext(ctx, deleteProduct, id)
-	return err
-}
-
 const getAllProducts = `-- name: GetAllProducts :many
-SELECT id, name, description, price, stock, product_url, category, type, created_by, created_at FROM products
-ORDER BY created_at DESC
-LIMIT $1 OFFSET $2
+SELECT id, name, description, price, stock, product_url, category, type, created_by, created_at, status, deleted_at, category_id, rating_average, rating_count, version, low_stock_threshold, kind FROM products
+WHERE status = 'published' AND deleted_at IS NULL
+  AND ($1::timestamp IS NULL
+       OR (created_at, id) < ($1::timestamp, $2::uuid))
+ORDER BY created_at DESC, id DESC
+LIMIT $3
 `
 
 type GetAllProductsParams struct {
-	Limit  int32 `db:"limit" json:"limit"`
-	Offset int32 `db:"offset" json:"offset"`
+	AfterCreatedAt sql.NullTime  `db:"after_created_at" json:"after_created_at"`
+	AfterID        uuid.NullUUID `db:"after_id" json:"after_id"`
+	LimitCount     int32         `db:"limit_count" json:"limit_count"`
 }
 
 func (q *Queries) GetAllProducts(ctx context.Context, arg GetAllProductsParams) ([]Product, error) {
-	rows, err := q.db.QueryContext(ctx, getAllProducts, arg.Limit, arg.Offset)
+	rows, err := q.db.QueryContext(ctx, getAllProducts, arg.AfterCreatedAt, arg.AfterID, arg.LimitCount)
 	if err != nil {
 		return nil, err
 	}
@@ -95,6 +119,14 @@ func (q *Queries) GetAllProducts(ctx context.Context, arg GetAllProductsParams)
 			&i.Type,
 			&i.CreatedBy,
 			&i.CreatedAt,
+			&i.Status,
+			&i.DeletedAt,
+			&i.CategoryID,
+			&i.RatingAverage,
+			&i.RatingCount,
+			&i.Version,
+			&i.LowStockThreshold,
+			&i.Kind,
 		); err != nil {
 			return nil, err
 		}
@@ -110,7 +142,7 @@ func (q *Queries) GetAllProducts(ctx context.Context, arg GetAllProductsParams)
 }
 
 const getProductByID = `-- name: GetProductByID :one
-SELECT id, name, description, price, stock, product_url, category, type, created_by, created_at FROM products WHERE id = $1
+SELECT id, name, description, price, stock, product_url, category, type, created_by, created_at, status, deleted_at, category_id, rating_average, rating_count, version, low_stock_threshold, kind FROM products WHERE id = $1 AND deleted_at IS NULL
 `
 
 func (q *Queries) GetProductByID(ctx context.Context, id uuid.UUID) (Product, error) {
@@ -127,16 +159,56 @@ func (q *Queries) GetProductByID(ctx context.Context, id uuid.UUID) (Product, er
 		&i.Type,
 		&i.CreatedBy,
 		&i.CreatedAt,
+		&i.Status,
+		&i.DeletedAt,
+		&i.CategoryID,
+		&i.RatingAverage,
+		&i.RatingCount,
+		&i.Version,
+		&i.LowStockThreshold,
+		&i.Kind,
 	)
 	return i, err
 }
 
-const getProductByName = `-- name: GetProductByName :many
-SELECT id, name, description, price, stock, product_url, category, type, created_by, created_at FROM products WHERE name = $1
+const getProductByIDIncludingDeleted = `-- name: GetProductByIDIncludingDeleted :one
+SELECT id, name, description, price, stock, product_url, category, type, created_by, created_at, status, deleted_at, category_id, rating_average, rating_count, version, low_stock_threshold, kind FROM products WHERE id = $1
 `
 
-func (q *Queries) GetProductByName(ctx context.Context, name string) ([]Product, error) {
-	rows, err := q.db.QueryContext(ctx, getProductByName, name)
+func (q *Queries) GetProductByIDIncludingDeleted(ctx context.Context, id uuid.UUID) (Product, error) {
+	row := q.db.QueryRowContext(ctx, getProductByIDIncludingDeleted, id)
+	var i Product
+	err := row.Scan(
+		&i.ID,
+		&i.Name,
+		&i.Description,
+		&i.Price,
+		&i.Stock,
+		&i.ProductUrl,
+		&i.Category,
+		&i.Type,
+		&i.CreatedBy,
+		&i.CreatedAt,
+		&i.Status,
+		&i.DeletedAt,
+		&i.CategoryID,
+		&i.RatingAverage,
+		&i.RatingCount,
+		&i.Version,
+		&i.LowStockThreshold,
+		&i.Kind,
+	)
+	return i, err
+}
+
+const getProductByUserID = `-- name: GetProductByUserID :many
+SELECT id, name, description, price, stock, product_url, category, type, created_by, created_at, status, deleted_at, category_id, rating_average, rating_count, version, low_stock_threshold, kind FROM products
+WHERE created_by = $1 AND deleted_at IS NULL
+ORDER BY created_at DESC
+`
+
+func (q *Queries) GetProductByUserID(ctx context.Context, createdBy uuid.NullUUID) ([]Product, error) {
+	rows, err := q.db.QueryContext(ctx, getProductByUserID, createdBy)
 	if err != nil {
 		return nil, err
 	}
@@ -155,6 +227,14 @@ func (q *Queries) GetProductByName(ctx context.Context, name string) ([]Product,
 			&i.Type,
 			&i.CreatedBy,
 			&i.CreatedAt,
+			&i.Status,
+			&i.DeletedAt,
+			&i.CategoryID,
+			&i.RatingAverage,
+			&i.RatingCount,
+			&i.Version,
+			&i.LowStockThreshold,
+			&i.Kind,
 		); err != nil {
 			return nil, err
 		}
@@ -169,12 +249,98 @@ func (q *Queries) GetProductByName(ctx context.Context, name string) ([]Product,
 	return items, nil
 }
 
-const getProductByUserID = `-- name: GetProductByUserID :many
-SELECT id, name, description, price, stock, product_url, category, type, created_by, created_at FROM products WHERE created_by = $1
+const getProductForUpdate = `-- name: GetProductForUpdate :one
+SELECT id, name, description, price, stock, product_url, category, type, created_by, created_at, status, deleted_at, category_id, rating_average, rating_count, version, low_stock_threshold, kind FROM products WHERE id = $1 AND deleted_at IS NULL
+FOR UPDATE
 `
 
-func (q *Queries) GetProductByUserID(ctx context.Context, createdBy uuid.NullUUID) ([]Product, error) {
-	rows, err := q.db.QueryContext(ctx, getProductByUserID, createdBy)
+func (q *Queries) GetProductForUpdate(ctx context.Context, id uuid.UUID) (Product, error) {
+	row := q.db.QueryRowContext(ctx, getProductForUpdate, id)
+	var i Product
+	err := row.Scan(
+		&i.ID,
+		&i.Name,
+		&i.Description,
+		&i.Price,
+		&i.Stock,
+		&i.ProductUrl,
+		&i.Category,
+		&i.Type,
+		&i.CreatedBy,
+		&i.CreatedAt,
+		&i.Status,
+		&i.DeletedAt,
+		&i.CategoryID,
+		&i.RatingAverage,
+		&i.RatingCount,
+		&i.Version,
+		&i.LowStockThreshold,
+		&i.Kind,
+	)
+	return i, err
+}
+
+const getProductForUpdateIncludingDeleted = `-- name: GetProductForUpdateIncludingDeleted :one
+SELECT id, name, description, price, stock, product_url, category, type, created_by, created_at, status, deleted_at, category_id, rating_average, rating_count, version, low_stock_threshold, kind FROM products WHERE id = $1
+FOR UPDATE
+`
+
+func (q *Queries) GetProductForUpdateIncludingDeleted(ctx context.Context, id uuid.UUID) (Product, error) {
+	row := q.db.QueryRowContext(ctx, getProductForUpdateIncludingDeleted, id)
+	var i Product
+	err := row.Scan(
+		&i.ID,
+		&i.Name,
+		&i.Description,
+		&i.Price,
+		&i.Stock,
+		&i.ProductUrl,
+		&i.Category,
+		&i.Type,
+		&i.CreatedBy,
+		&i.CreatedAt,
+		&i.Status,
+		&i.DeletedAt,
+		&i.CategoryID,
+		&i.RatingAverage,
+		&i.RatingCount,
+		&i.Version,
+		&i.LowStockThreshold,
+		&i.Kind,
+	)
+	return i, err
+}
+
+const listLowStockProducts = `-- name: ListLowStockProducts :many
+SELECT id, name, description, price, stock, product_url, category, type, created_by, created_at, status, deleted_at, category_id, rating_average, rating_count, version, low_stock_threshold, kind FROM products
+WHERE created_by = $1
+  AND deleted_at IS NULL
+  AND status <> 'archived'
+  AND kind = 'physical'
+  AND stock <= low_stock_threshold
+  AND ($2::bigint IS NULL
+       OR (-stock::bigint, created_at, id) < ($2::bigint, $3::timestamp, $4::uuid))
+ORDER BY stock ASC, created_at DESC, id DESC
+LIMIT $5
+`
+
+type ListLowStockProductsParams struct {
+	CreatedBy      uuid.NullUUID `db:"created_by" json:"created_by"`
+	AfterKey       sql.NullInt64 `db:"after_key" json:"after_key"`
+	AfterCreatedAt sql.NullTime  `db:"after_created_at" json:"after_created_at"`
+	AfterID        uuid.NullUUID `db:"after_id" json:"after_id"`
+	LimitCount     int32         `db:"limit_count" json:"limit_count"`
+}
+
+// Emptiest first, the sort key is the negated stock
+func (q *Queries) ListLowStockProducts(ctx context.Context, arg ListLowStockProductsParams) ([]Product, error) {
+	rows, err := q.db.QueryContext(ctx, listLowStockProducts,
+		arg.CreatedBy,
+		arg.AfterKey,
+		arg.AfterCreatedAt,
+		arg.AfterID,
+		arg.LimitCount,
+	)
 	if err != nil {
 		return nil, err
 	}
@@ -193,6 +359,14 @@ func (q *Queries) GetProductByUserID(ctx context.Context, createdBy uuid.NullUUI
 			&i.Type,
 			&i.CreatedBy,
 			&i.CreatedAt,
+			&i.Status,
+			&i.DeletedAt,
+			&i.CategoryID,
+			&i.RatingAverage,
+			&i.RatingCount,
+			&i.Version,
+			&i.LowStockThreshold,
+			&i.Kind,
 		); err != nil {
 			return nil, err
 		}
@@ -208,12 +382,34 @@ func (q *Queries) GetProductByUserID(ctx context.Context, createdBy uuid.NullUUI
 }
 
 const listProductsByCategory = `-- name: ListProductsByCategory :many
-SELECT id, name, description, price, stock, product_url, category, type, created_by, created_at FROM products WHERE category = $1
-ORDER BY created_at DESC
+WITH RECURSIVE subtree AS (
+    SELECT c.id FROM categories c WHERE c.id = $4::uuid
+    UNION ALL
+    SELECT c.id FROM categories c JOIN subtree s ON c.parent_id = s.id
+)
+SELECT p.id, p.name, p.description, p.price, p.stock, p.product_url, p.category, p.type, p.created_by, p.created_at, p.status, p.deleted_at, p.category_id, p.rating_average, p.rating_count, p.version, p.low_stock_threshold, p.kind FROM products p
+WHERE p.category_id IN (SELECT id FROM subtree)
+  AND p.status = 'published' AND p.deleted_at IS NULL
+  AND ($1::timestamp IS NULL
+       OR (p.created_at, p.id) < ($1::timestamp, $2::uuid))
+ORDER BY p.created_at DESC, p.id DESC
+LIMIT $3
 `
 
-func (q *Queries) ListProductsByCategory(ctx context.Context, category string) ([]Product, error) {
-	rows, err := q.db.QueryContext(ctx, listProductsByCategory, category)
+type ListProductsByCategoryParams struct {
+	AfterCreatedAt sql.NullTime  `db:"after_created_at" json:"after_created_at"`
+	AfterID        uuid.NullUUID `db:"after_id" json:"after_id"`
+	LimitCount     int32         `db:"limit_count" json:"limit_count"`
+	CategoryID     uuid.UUID     `db:"category_id" json:"category_id"`
+}
+
+func (q *Queries) ListProductsByCategory(ctx context.Context, arg ListProductsByCategoryParams) ([]Product, error) {
+	rows, err := q.db.QueryContext(ctx, listProductsByCategory,
+		arg.AfterCreatedAt,
+		arg.AfterID,
+		arg.LimitCount,
+		arg.CategoryID,
+	)
 	if err != nil {
 		return nil, err
 	}
@@ -232,6 +428,14 @@ func (q *Queries) ListProductsByCategory(ctx context.Context, category string) (
 			&i.Type,
 			&i.CreatedBy,
 			&i.CreatedAt,
+			&i.Status,
+			&i.DeletedAt,
+			&i.CategoryID,
+			&i.RatingAverage,
+			&i.RatingCount,
+			&i.Version,
+			&i.LowStockThreshold,
+			&i.Kind,
 		); err != nil {
 			return nil, err
 		}
@@ -246,18 +450,29 @@ func (q *Queries) ListProductsByCategory(ctx context.Context, category string) (
 	return items, nil
 }
 
-const listProductsByType = `-- name: ListProductsByType :many
-SELECT id, name, description, price, stock, product_url, category, type, created_by, created_at FROM products WHERE type = $1 AND category = $2
-ORDER BY created_at DESC
+const listProductsByCreator = `-- name: ListProductsByCreator :many
+SELECT id, name, description, price, stock, product_url, category, type, created_by, created_at, status, deleted_at, category_id, rating_average, rating_count, version, low_stock_threshold, kind FROM products
+WHERE created_by = $1 AND deleted_at IS NULL
+  AND ($2::timestamp IS NULL
+       OR (created_at, id) < ($2::timestamp, $3::uuid))
+ORDER BY created_at DESC, id DESC
+LIMIT $4
 `
 
-type ListProductsByTypeParams struct {
-	Type     string `db:"type" json:"type"`
-	Category string `db:"category" json:"category"`
+type ListProductsByCreatorParams struct {
+	CreatedBy      uuid.NullUUID `db:"created_by" json:"created_by"`
+	AfterCreatedAt sql.NullTime  `db:"after_created_at" json:"after_created_at"`
+	AfterID        uuid.NullUUID `db:"after_id" json:"after_id"`
+	LimitCount     int32         `db:"limit_count" json:"limit_count"`
 }
 
-func (q *Queries) ListProductsByType(ctx context.Context, arg ListProductsByTypeParams) ([]Product, error) {
-	rows, err := q.db.QueryContext(ctx, listProductsByType, arg.Type, arg.Category)
+func (q *Queries) ListProductsByCreator(ctx context.Context, arg ListProductsByCreatorParams) ([]Product, error) {
+	rows, err := q.db.QueryContext(ctx, listProductsByCreator,
+		arg.CreatedBy,
+		arg.AfterCreatedAt,
+		arg.AfterID,
+		arg.LimitCount,
+	)
 	if err != nil {
 		return nil, err
 	}
@@ -276,6 +491,14 @@ func (q *Queries) ListProductsByType(ctx context.Context, arg ListProductsByType
 			&i.Type,
 			&i.CreatedBy,
 			&i.CreatedAt,
+			&i.Status,
+			&i.DeletedAt,
+			&i.CategoryID,
+			&i.RatingAverage,
+			&i.RatingCount,
+			&i.Version,
+			&i.LowStockThreshold,
+			&i.Kind,
 		); err != nil {
 			return nil, err
 		}
@@ -290,18 +513,179 @@ func (q *Queries) ListProductsByType(ctx context.Context, arg ListProductsByType
 	return items, nil
 }
 
+const listProductsByName = `-- name: ListProductsByName :many
+SELECT p.id, p.name, p.description, p.price, p.stock, p.product_url, p.category, p.type, p.created_by, p.created_at, p.status, p.deleted_at, p.category_id, p.rating_average, p.rating_count, p.version, p.low_stock_threshold, p.kind, k.sort_key
+FROM products p
+CROSS JOIN LATERAL (
+    SELECT (CASE WHEN lower(p.name) = lower($1::text) THEN 1 ELSE 0 END)::bigint AS sort_key
+) k
+WHERE p.name ILIKE '%' || $1::text || '%'
+  AND p.status = 'published' AND p.deleted_at IS NULL
+  AND ($2::bigint IS NULL
+       OR (k.sort_key, p.created_at, p.id) < ($2::bigint, $3::timestamp, $4::uuid))
+ORDER BY k.sort_key DESC, p.created_at DESC, p.id DESC
+LIMIT $5
+`
+
+type ListProductsByNameParams struct {
+	Name           string        `db:"name" json:"name"`
+	AfterKey       sql.NullInt64 `db:"after_key" json:"after_key"`
+	AfterCreatedAt sql.NullTime  `db:"after_created_at" json:"after_created_at"`
+	AfterID        uuid.NullUUID `db:"after_id" json:"after_id"`
+	LimitCount     int32         `db:"limit_count" json:"limit_count"`
+}
+
+type ListProductsByNameRow struct {
+	Product Product `db:"product" json:"product"`
+	SortKey int64   `db:"sort_key" json:"sort_key"`
+}
+
+// Names containing the query, exact matches (sort key 1) first
+func (q *Queries) ListProductsByName(ctx context.Context, arg ListProductsByNameParams) ([]ListProductsByNameRow, error) {
+	rows, err := q.db.QueryContext(ctx, listProductsByName,
+		arg.Name,
+		arg.AfterKey,
+		arg.AfterCreatedAt,
+		arg.AfterID,
+		arg.LimitCount,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []ListProductsByNameRow{}
+	for rows.Next() {
+		var i ListProductsByNameRow
+		if err := rows.Scan(
+			&i.Product.ID,
+			&i.Product.Name,
+			&i.Product.Description,
+			&i.Product.Price,
+			&i.Product.Stock,
+			&i.Product.ProductUrl,
+			&i.Product.Category,
+			&i.Product.Type,
+			&i.Product.CreatedBy,
+			&i.Product.CreatedAt,
+			&i.Product.Status,
+			&i.Product.DeletedAt,
+			&i.Product.CategoryID,
+			&i.Product.RatingAverage,
+			&i.Product.RatingCount,
+			&i.Product.Version,
+			&i.Product.LowStockThreshold,
+			&i.Product.Kind,
+			&i.SortKey,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const restoreProduct = `-- name: RestoreProduct :one
+UPDATE products
+SET deleted_at = NULL
+WHERE id = $1 AND deleted_at IS NOT NULL
+RETURNING id, name, description, price, stock, product_url, category, type, created_by, created_at, status, deleted_at, category_id, rating_average, rating_count, version, low_stock_threshold, kind
+`
+
+func (q *Queries) RestoreProduct(ctx context.Context, id uuid.UUID) (Product, error) {
+	row := q.db.QueryRowContext(ctx, restoreProduct, id)
+	var i Product
+	err := row.Scan(
+		&i.ID,
+		&i.Name,
+		&i.Description,
+		&i.Price,
+		&i.Stock,
+		&i.ProductUrl,
+		&i.Category,
+		&i.Type,
+		&i.CreatedBy,
+		&i.CreatedAt,
+		&i.Status,
+		&i.DeletedAt,
+		&i.CategoryID,
+		&i.RatingAverage,
+		&i.RatingCount,
+		&i.Version,
+		&i.LowStockThreshold,
+		&i.Kind,
+	)
+	return i, err
+}
+
+const setLowStockThreshold = `-- name: SetLowStockThreshold :one
+UPDATE products
+SET low_stock_threshold = $2
+WHERE id = $1 AND deleted_at IS NULL
+RETURNING id, name, description, price, stock, product_url, category, type, created_by, created_at, status, deleted_at, category_id, rating_average, rating_count, version, low_stock_threshold, kind
+`
+
+type SetLowStockThresholdParams struct {
+	ID                uuid.UUID `db:"id" json:"id"`
+	LowStockThreshold int32     `db:"low_stock_threshold" json:"low_stock_threshold"`
+}
+
+func (q *Queries) SetLowStockThreshold(ctx context.Context, arg SetLowStockThresholdParams) (Product, error) {
+	row := q.db.QueryRowContext(ctx, setLowStockThreshold, arg.ID, arg.LowStockThreshold)
+	var i Product
+	err := row.Scan(
+		&i.ID,
+		&i.Name,
+		&i.Description,
+		&i.Price,
+		&i.Stock,
+		&i.ProductUrl,
+		&i.Category,
+		&i.Type,
+		&i.CreatedBy,
+		&i.CreatedAt,
+		&i.Status,
+		&i.DeletedAt,
+		&i.CategoryID,
+		&i.RatingAverage,
+		&i.RatingCount,
+		&i.Version,
+		&i.LowStockThreshold,
+		&i.Kind,
+	)
+	return i, err
+}
+
+const softDeleteProduct = `-- name: SoftDeleteProduct :exec
+UPDATE products
+SET deleted_at = NOW()
+WHERE id = $1 AND deleted_at IS NULL
+`
+
+func (q *Queries) SoftDeleteProduct(ctx context.Context, id uuid.UUID) error {
+	_, err := q.db.ExecContext(ctx, softDeleteProduct, id)
+	return err
+}
+
 const updateProduct = `-- name: UpdateProduct :one
 UPDATE products
-SET 
+SET
     name = $2,
     description = $3,
     price = $4,
     stock = $5,
     product_url = $6,
     category = $7,
-    type = $8
-WHERE id = $1
-RETURNING id, name, description, price, stock, product_url, category, type, created_by, created_at
+    type = $8,
+    category_id = $9,
+    version = version + 1
+WHERE id = $1 AND version = $10 AND deleted_at IS NULL
+RETURNING id, name, description, price, stock, product_url, category, type, created_by, created_at, status, deleted_at, category_id, rating_average, rating_count, version, low_stock_threshold, kind
 `
 
 type UpdateProductParams struct {
@@ -313,6 +697,8 @@ type UpdateProductParams struct {
 	ProductUrl  string    `db:"product_url" json:"product_url"`
 	Category    string    `db:"category" json:"category"`
 	Type        string    `db:"type" json:"type"`
+	CategoryID  uuid.UUID `db:"category_id" json:"category_id"`
+	Version     int32     `db:"version" json:"version"`
 }
 
 func (q *Queries) UpdateProduct(ctx context.Context, arg UpdateProductParams) (Product, error) {
@@ -325,7 +711,47 @@ func (q *Queries) UpdateProduct(ctx context.Context, arg UpdateProductParams) (P
 		arg.ProductUrl,
 		arg.Category,
 		arg.Type,
+		arg.CategoryID,
+		arg.Version,
+	)
+	var i Product
+	err := row.Scan(
+		&i.ID,
+		&i.Name,
+		&i.Description,
+		&i.Price,
+		&i.Stock,
+		&i.ProductUrl,
+		&i.Category,
+		&i.Type,
+		&i.CreatedBy,
+		&i.CreatedAt,
+		&i.Status,
+		&i.DeletedAt,
+		&i.CategoryID,
+		&i.RatingAverage,
+		&i.RatingCount,
+		&i.Version,
+		&i.LowStockThreshold,
+		&i.Kind,
 	)
+	return i, err
+}
+
+const updateProductStatus = `-- name: UpdateProductStatus :one
+UPDATE products
+SET status = $2, version = version + 1
+WHERE id = $1 AND deleted_at IS NULL
+RETURNING id, name, description, price, stock, product_url, category, type, created_by, created_at, status, deleted_at, category_id, rating_average, rating_count, version, low_stock_threshold, kind
+`
+
+type UpdateProductStatusParams struct {
+	ID     uuid.UUID `db:"id" json:"id"`
+	Status string    `db:"status" json:"status"`
+}
+
+func (q *Queries) UpdateProductStatus(ctx context.Context, arg UpdateProductStatusParams) (Product, error) {
+	row := q.db.QueryRowContext(ctx, updateProductStatus, arg.ID, arg.Status)
 	var i Product
 	err := row.Scan(
 		&i.ID,
@@ -338,13 +764,21 @@ func (q *Queries) UpdateProduct(ctx context.Context, arg UpdateProductParams) (P
 		&i.Type,
 		&i.CreatedBy,
 		&i.CreatedAt,
+		&i.Status,
+		&i.DeletedAt,
+		&i.CategoryID,
+		&i.RatingAverage,
+		&i.RatingCount,
+		&i.Version,
+		&i.LowStockThreshold,
+		&i.Kind,
 	)
 	return i, err
 }
 
 const updateProductStock = `-- name: UpdateProductStock :exec
 UPDATE products
-SET stock = $2
+SET stock = $2, version = version + 1
 WHERE id = $1
 `
 
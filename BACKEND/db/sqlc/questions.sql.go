@@ -0,0 +1,460 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.30.0
+// source: questions.sql
+
+package db
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/google/uuid"
+	"github.com/lib/pq"
+)
+
+const countProductQuestions = `-- name: CountProductQuestions :one
+SELECT COUNT(*) FROM product_questions WHERE product_id = $1 AND NOT hidden
+`
+
+func (q *Queries) CountProductQuestions(ctx context.Context, productID uuid.UUID) (int64, error) {
+	row := q.db.QueryRowContext(ctx, countProductQuestions, productID)
+	var count int64
+	err := row.Scan(&count)
+	return count, err
+}
+
+const createAnswer = `-- name: CreateAnswer :one
+INSERT INTO product_answers (question_id, user_id, body)
+VALUES ($1, $2, $3)
+RETURNING id, question_id, user_id, body, upvote_count, flag_count, hidden, created_at
+`
+
+type CreateAnswerParams struct {
+	QuestionID uuid.UUID `db:"question_id" json:"question_id"`
+	UserID     uuid.UUID `db:"user_id" json:"user_id"`
+	Body       string    `db:"body" json:"body"`
+}
+
+func (q *Queries) CreateAnswer(ctx context.Context, arg CreateAnswerParams) (ProductAnswer, error) {
+	row := q.db.QueryRowContext(ctx, createAnswer, arg.QuestionID, arg.UserID, arg.Body)
+	var i ProductAnswer
+	err := row.Scan(
+		&i.ID,
+		&i.QuestionID,
+		&i.UserID,
+		&i.Body,
+		&i.UpvoteCount,
+		&i.FlagCount,
+		&i.Hidden,
+		&i.CreatedAt,
+	)
+	return i, err
+}
+
+const createAnswerFlag = `-- name: CreateAnswerFlag :execrows
+INSERT INTO answer_flags (answer_id, user_id, reason)
+VALUES ($1, $2, $3)
+ON CONFLICT DO NOTHING
+`
+
+type CreateAnswerFlagParams struct {
+	AnswerID uuid.UUID `db:"answer_id" json:"answer_id"`
+	UserID   uuid.UUID `db:"user_id" json:"user_id"`
+	Reason   string    `db:"reason" json:"reason"`
+}
+
+func (q *Queries) CreateAnswerFlag(ctx context.Context, arg CreateAnswerFlagParams) (int64, error) {
+	result, err := q.db.ExecContext(ctx, createAnswerFlag, arg.AnswerID, arg.UserID, arg.Reason)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected()
+}
+
+const createAnswerVote = `-- name: CreateAnswerVote :execrows
+INSERT INTO answer_votes (answer_id, user_id)
+VALUES ($1, $2)
+ON CONFLICT DO NOTHING
+`
+
+type CreateAnswerVoteParams struct {
+	AnswerID uuid.UUID `db:"answer_id" json:"answer_id"`
+	UserID   uuid.UUID `db:"user_id" json:"user_id"`
+}
+
+func (q *Queries) CreateAnswerVote(ctx context.Context, arg CreateAnswerVoteParams) (int64, error) {
+	result, err := q.db.ExecContext(ctx, createAnswerVote, arg.AnswerID, arg.UserID)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected()
+}
+
+const createQuestion = `-- name: CreateQuestion :one
+INSERT INTO product_questions (product_id, user_id, body)
+VALUES ($1, $2, $3)
+RETURNING id, product_id, user_id, body, upvote_count, flag_count, hidden, created_at
+`
+
+type CreateQuestionParams struct {
+	ProductID uuid.UUID `db:"product_id" json:"product_id"`
+	UserID    uuid.UUID `db:"user_id" json:"user_id"`
+	Body      string    `db:"body" json:"body"`
+}
+
+func (q *Queries) CreateQuestion(ctx context.Context, arg CreateQuestionParams) (ProductQuestion, error) {
+	row := q.db.QueryRowContext(ctx, createQuestion, arg.ProductID, arg.UserID, arg.Body)
+	var i ProductQuestion
+	err := row.Scan(
+		&i.ID,
+		&i.ProductID,
+		&i.UserID,
+		&i.Body,
+		&i.UpvoteCount,
+		&i.FlagCount,
+		&i.Hidden,
+		&i.CreatedAt,
+	)
+	return i, err
+}
+
+const createQuestionFlag = `-- name: CreateQuestionFlag :execrows
+INSERT INTO question_flags (question_id, user_id, reason)
+VALUES ($1, $2, $3)
+ON CONFLICT DO NOTHING
+`
+
+type CreateQuestionFlagParams struct {
+	QuestionID uuid.UUID `db:"question_id" json:"question_id"`
+	UserID     uuid.UUID `db:"user_id" json:"user_id"`
+	Reason     string    `db:"reason" json:"reason"`
+}
+
+func (q *Queries) CreateQuestionFlag(ctx context.Context, arg CreateQuestionFlagParams) (int64, error) {
+	result, err := q.db.ExecContext(ctx, createQuestionFlag, arg.QuestionID, arg.UserID, arg.Reason)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected()
+}
+
+const createQuestionVote = `-- name: CreateQuestionVote :execrows
+INSERT INTO question_votes (question_id, user_id)
+VALUES ($1, $2)
+ON CONFLICT DO NOTHING
+`
+
+type CreateQuestionVoteParams struct {
+	QuestionID uuid.UUID `db:"question_id" json:"question_id"`
+	UserID     uuid.UUID `db:"user_id" json:"user_id"`
+}
+
+func (q *Queries) CreateQuestionVote(ctx context.Context, arg CreateQuestionVoteParams) (int64, error) {
+	result, err := q.db.ExecContext(ctx, createQuestionVote, arg.QuestionID, arg.UserID)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected()
+}
+
+const getAnswerByID = `-- name: GetAnswerByID :one
+SELECT id, question_id, user_id, body, upvote_count, flag_count, hidden, created_at FROM product_answers WHERE id = $1
+`
+
+func (q *Queries) GetAnswerByID(ctx context.Context, id uuid.UUID) (ProductAnswer, error) {
+	row := q.db.QueryRowContext(ctx, getAnswerByID, id)
+	var i ProductAnswer
+	err := row.Scan(
+		&i.ID,
+		&i.QuestionID,
+		&i.UserID,
+		&i.Body,
+		&i.UpvoteCount,
+		&i.FlagCount,
+		&i.Hidden,
+		&i.CreatedAt,
+	)
+	return i, err
+}
+
+const getQuestionByID = `-- name: GetQuestionByID :one
+SELECT id, product_id, user_id, body, upvote_count, flag_count, hidden, created_at FROM product_questions WHERE id = $1
+`
+
+func (q *Queries) GetQuestionByID(ctx context.Context, id uuid.UUID) (ProductQuestion, error) {
+	row := q.db.QueryRowContext(ctx, getQuestionByID, id)
+	var i ProductQuestion
+	err := row.Scan(
+		&i.ID,
+		&i.ProductID,
+		&i.UserID,
+		&i.Body,
+		&i.UpvoteCount,
+		&i.FlagCount,
+		&i.Hidden,
+		&i.CreatedAt,
+	)
+	return i, err
+}
+
+const incrementAnswerFlags = `-- name: IncrementAnswerFlags :one
+UPDATE product_answers
+SET flag_count = flag_count + 1,
+    hidden = hidden OR flag_count + 1 >= $1::int
+WHERE id = $2
+RETURNING id, question_id, user_id, body, upvote_count, flag_count, hidden, created_at
+`
+
+type IncrementAnswerFlagsParams struct {
+	HideThreshold int32     `db:"hide_threshold" json:"hide_threshold"`
+	ID            uuid.UUID `db:"id" json:"id"`
+}
+
+func (q *Queries) IncrementAnswerFlags(ctx context.Context, arg IncrementAnswerFlagsParams) (ProductAnswer, error) {
+	row := q.db.QueryRowContext(ctx, incrementAnswerFlags, arg.HideThreshold, arg.ID)
+	var i ProductAnswer
+	err := row.Scan(
+		&i.ID,
+		&i.QuestionID,
+		&i.UserID,
+		&i.Body,
+		&i.UpvoteCount,
+		&i.FlagCount,
+		&i.Hidden,
+		&i.CreatedAt,
+	)
+	return i, err
+}
+
+const incrementAnswerUpvotes = `-- name: IncrementAnswerUpvotes :one
+UPDATE product_answers
+SET upvote_count = upvote_count + 1
+WHERE id = $1
+RETURNING id, question_id, user_id, body, upvote_count, flag_count, hidden, created_at
+`
+
+func (q *Queries) IncrementAnswerUpvotes(ctx context.Context, id uuid.UUID) (ProductAnswer, error) {
+	row := q.db.QueryRowContext(ctx, incrementAnswerUpvotes, id)
+	var i ProductAnswer
+	err := row.Scan(
+		&i.ID,
+		&i.QuestionID,
+		&i.UserID,
+		&i.Body,
+		&i.UpvoteCount,
+		&i.FlagCount,
+		&i.Hidden,
+		&i.CreatedAt,
+	)
+	return i, err
+}
+
+const incrementQuestionFlags = `-- name: IncrementQuestionFlags :one
+UPDATE product_questions
+SET flag_count = flag_count + 1,
+    hidden = hidden OR flag_count + 1 >= $1::int
+WHERE id = $2
+RETURNING id, product_id, user_id, body, upvote_count, flag_count, hidden, created_at
+`
+
+type IncrementQuestionFlagsParams struct {
+	HideThreshold int32     `db:"hide_threshold" json:"hide_threshold"`
+	ID            uuid.UUID `db:"id" json:"id"`
+}
+
+func (q *Queries) IncrementQuestionFlags(ctx context.Context, arg IncrementQuestionFlagsParams) (ProductQuestion, error) {
+	row := q.db.QueryRowContext(ctx, incrementQuestionFlags, arg.HideThreshold, arg.ID)
+	var i ProductQuestion
+	err := row.Scan(
+		&i.ID,
+		&i.ProductID,
+		&i.UserID,
+		&i.Body,
+		&i.UpvoteCount,
+		&i.FlagCount,
+		&i.Hidden,
+		&i.CreatedAt,
+	)
+	return i, err
+}
+
+const incrementQuestionUpvotes = `-- name: IncrementQuestionUpvotes :one
+UPDATE product_questions
+SET upvote_count = upvote_count + 1
+WHERE id = $1
+RETURNING id, product_id, user_id, body, upvote_count, flag_count, hidden, created_at
+`
+
+func (q *Queries) IncrementQuestionUpvotes(ctx context.Context, id uuid.UUID) (ProductQuestion, error) {
+	row := q.db.QueryRowContext(ctx, incrementQuestionUpvotes, id)
+	var i ProductQuestion
+	err := row.Scan(
+		&i.ID,
+		&i.ProductID,
+		&i.UserID,
+		&i.Body,
+		&i.UpvoteCount,
+		&i.FlagCount,
+		&i.Hidden,
+		&i.CreatedAt,
+	)
+	return i, err
+}
+
+const listAnswersByQuestionIDs = `-- name: ListAnswersByQuestionIDs :many
+SELECT id, question_id, user_id, body, upvote_count, flag_count, hidden, created_at FROM product_answers
+WHERE question_id = ANY($1::uuid[]) AND NOT hidden
+ORDER BY upvote_count DESC, created_at ASC
+`
+
+func (q *Queries) ListAnswersByQuestionIDs(ctx context.Context, questionIds []uuid.UUID) ([]ProductAnswer, error) {
+	rows, err := q.db.QueryContext(ctx, listAnswersByQuestionIDs, pq.Array(questionIds))
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []ProductAnswer{}
+	for rows.Next() {
+		var i ProductAnswer
+		if err := rows.Scan(
+			&i.ID,
+			&i.QuestionID,
+			&i.UserID,
+			&i.Body,
+			&i.UpvoteCount,
+			&i.FlagCount,
+			&i.Hidden,
+			&i.CreatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listProductQuestions = `-- name: ListProductQuestions :many
+SELECT id, product_id, user_id, body, upvote_count, flag_count, hidden, created_at FROM product_questions
+WHERE product_id = $1 AND NOT hidden
+  AND ($2::bigint IS NULL
+       OR (CASE WHEN $3::text = 'top' THEN upvote_count ELSE 0 END::bigint, created_at, id)
+          < ($2::bigint, $4::timestamp, $5::uuid))
+ORDER BY
+    CASE WHEN $3::text = 'top' THEN upvote_count ELSE 0 END DESC,
+    created_at DESC,
+    id DESC
+LIMIT $6
+`
+
+type ListProductQuestionsParams struct {
+	ProductID      uuid.UUID     `db:"product_id" json:"product_id"`
+	AfterKey       sql.NullInt64 `db:"after_key" json:"after_key"`
+	Sort           string        `db:"sort" json:"sort"`
+	AfterCreatedAt sql.NullTime  `db:"after_created_at" json:"after_created_at"`
+	AfterID        uuid.NullUUID `db:"after_id" json:"after_id"`
+	LimitCount     int32         `db:"limit_count" json:"limit_count"`
+}
+
+// Ordered by a sort key descending, then newest first, so one keyset
+// condition pages both sorts. questionSortKey in gapi computes the same key.
+func (q *Queries) ListProductQuestions(ctx context.Context, arg ListProductQuestionsParams) ([]ProductQuestion, error) {
+	rows, err := q.db.QueryContext(ctx, listProductQuestions,
+		arg.ProductID,
+		arg.AfterKey,
+		arg.Sort,
+		arg.AfterCreatedAt,
+		arg.AfterID,
+		arg.LimitCount,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []ProductQuestion{}
+	for rows.Next() {
+		var i ProductQuestion
+		if err := rows.Scan(
+			&i.ID,
+			&i.ProductID,
+			&i.UserID,
+			&i.Body,
+			&i.UpvoteCount,
+			&i.FlagCount,
+			&i.Hidden,
+			&i.CreatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const setAnswerHidden = `-- name: SetAnswerHidden :one
+UPDATE product_answers
+SET hidden = $2
+WHERE id = $1
+RETURNING id, question_id, user_id, body, upvote_count, flag_count, hidden, created_at
+`
+
+type SetAnswerHiddenParams struct {
+	ID     uuid.UUID `db:"id" json:"id"`
+	Hidden bool      `db:"hidden" json:"hidden"`
+}
+
+func (q *Queries) SetAnswerHidden(ctx context.Context, arg SetAnswerHiddenParams) (ProductAnswer, error) {
+	row := q.db.QueryRowContext(ctx, setAnswerHidden, arg.ID, arg.Hidden)
+	var i ProductAnswer
+	err := row.Scan(
+		&i.ID,
+		&i.QuestionID,
+		&i.UserID,
+		&i.Body,
+		&i.UpvoteCount,
+		&i.FlagCount,
+		&i.Hidden,
+		&i.CreatedAt,
+	)
+	return i, err
+}
+
+const setQuestionHidden = `-- name: SetQuestionHidden :one
+UPDATE product_questions
+SET hidden = $2
+WHERE id = $1
+RETURNING id, product_id, user_id, body, upvote_count, flag_count, hidden, created_at
+`
+
+type SetQuestionHiddenParams struct {
+	ID     uuid.UUID `db:"id" json:"id"`
+	Hidden bool      `db:"hidden" json:"hidden"`
+}
+
+func (q *Queries) SetQuestionHidden(ctx context.Context, arg SetQuestionHiddenParams) (ProductQuestion, error) {
+	row := q.db.QueryRowContext(ctx, setQuestionHidden, arg.ID, arg.Hidden)
+	var i ProductQuestion
+	err := row.Scan(
+		&i.ID,
+		&i.ProductID,
+		&i.UserID,
+		&i.Body,
+		&i.UpvoteCount,
+		&i.FlagCount,
+		&i.Hidden,
+		&i.CreatedAt,
+	)
+	return i, err
+}
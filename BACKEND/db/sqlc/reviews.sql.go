@@ -0,0 +1,280 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.30.0
+// source: reviews.sql
+
+package db
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/google/uuid"
+	"github.com/lib/pq"
+)
+
+const countProductReviews = `-- name: CountProductReviews :one
+SELECT COUNT(*) FROM reviews WHERE product_id = $1
+`
+
+func (q *Queries) CountProductReviews(ctx context.Context, productID uuid.UUID) (int64, error) {
+	row := q.db.QueryRowContext(ctx, countProductReviews, productID)
+	var count int64
+	err := row.Scan(&count)
+	return count, err
+}
+
+const createReview = `-- name: CreateReview :one
+INSERT INTO reviews (product_id, user_id, rating, title, body, images)
+VALUES ($1, $2, $3, $4, $5, $6)
+RETURNING id, product_id, user_id, rating, title, body, images, helpful_count, seller_reply, seller_replied_at, created_at
+`
+
+type CreateReviewParams struct {
+	ProductID uuid.UUID `db:"product_id" json:"product_id"`
+	UserID    uuid.UUID `db:"user_id" json:"user_id"`
+	Rating    int32     `db:"rating" json:"rating"`
+	Title     string    `db:"title" json:"title"`
+	Body      string    `db:"body" json:"body"`
+	Images    []string  `db:"images" json:"images"`
+}
+
+func (q *Queries) CreateReview(ctx context.Context, arg CreateReviewParams) (Review, error) {
+	row := q.db.QueryRowContext(ctx, createReview,
+		arg.ProductID,
+		arg.UserID,
+		arg.Rating,
+		arg.Title,
+		arg.Body,
+		pq.Array(arg.Images),
+	)
+	var i Review
+	err := row.Scan(
+		&i.ID,
+		&i.ProductID,
+		&i.UserID,
+		&i.Rating,
+		&i.Title,
+		&i.Body,
+		pq.Array(&i.Images),
+		&i.HelpfulCount,
+		&i.SellerReply,
+		&i.SellerRepliedAt,
+		&i.CreatedAt,
+	)
+	return i, err
+}
+
+const createReviewVote = `-- name: CreateReviewVote :execrows
+INSERT INTO review_votes (review_id, user_id)
+VALUES ($1, $2)
+ON CONFLICT DO NOTHING
+`
+
+type CreateReviewVoteParams struct {
+	ReviewID uuid.UUID `db:"review_id" json:"review_id"`
+	UserID   uuid.UUID `db:"user_id" json:"user_id"`
+}
+
+func (q *Queries) CreateReviewVote(ctx context.Context, arg CreateReviewVoteParams) (int64, error) {
+	result, err := q.db.ExecContext(ctx, createReviewVote, arg.ReviewID, arg.UserID)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected()
+}
+
+const getReviewByID = `-- name: GetReviewByID :one
+SELECT id, product_id, user_id, rating, title, body, images, helpful_count, seller_reply, seller_replied_at, created_at FROM reviews WHERE id = $1
+`
+
+func (q *Queries) GetReviewByID(ctx context.Context, id uuid.UUID) (Review, error) {
+	row := q.db.QueryRowContext(ctx, getReviewByID, id)
+	var i Review
+	err := row.Scan(
+		&i.ID,
+		&i.ProductID,
+		&i.UserID,
+		&i.Rating,
+		&i.Title,
+		&i.Body,
+		pq.Array(&i.Images),
+		&i.HelpfulCount,
+		&i.SellerReply,
+		&i.SellerRepliedAt,
+		&i.CreatedAt,
+	)
+	return i, err
+}
+
+const hasCompletedOrder = `-- name: HasCompletedOrder :one
+SELECT EXISTS (
+    SELECT 1 FROM orders
+    WHERE user_id = $1 AND product_id = $2 AND status = 'completed'
+)
+`
+
+type HasCompletedOrderParams struct {
+	UserID    uuid.NullUUID `db:"user_id" json:"user_id"`
+	ProductID uuid.NullUUID `db:"product_id" json:"product_id"`
+}
+
+func (q *Queries) HasCompletedOrder(ctx context.Context, arg HasCompletedOrderParams) (bool, error) {
+	row := q.db.QueryRowContext(ctx, hasCompletedOrder, arg.UserID, arg.ProductID)
+	var exists bool
+	err := row.Scan(&exists)
+	return exists, err
+}
+
+const incrementReviewHelpful = `-- name: IncrementReviewHelpful :one
+UPDATE reviews
+SET helpful_count = helpful_count + 1
+WHERE id = $1
+RETURNING id, product_id, user_id, rating, title, body, images, helpful_count, seller_reply, seller_replied_at, created_at
+`
+
+func (q *Queries) IncrementReviewHelpful(ctx context.Context, id uuid.UUID) (Review, error) {
+	row := q.db.QueryRowContext(ctx, incrementReviewHelpful, id)
+	var i Review
+	err := row.Scan(
+		&i.ID,
+		&i.ProductID,
+		&i.UserID,
+		&i.Rating,
+		&i.Title,
+		&i.Body,
+		pq.Array(&i.Images),
+		&i.HelpfulCount,
+		&i.SellerReply,
+		&i.SellerRepliedAt,
+		&i.CreatedAt,
+	)
+	return i, err
+}
+
+const listProductReviews = `-- name: ListProductReviews :many
+SELECT id, product_id, user_id, rating, title, body, images, helpful_count, seller_reply, seller_replied_at, created_at FROM reviews
+WHERE product_id = $1
+  AND ($2::bigint IS NULL
+       OR (CASE $3::text
+               WHEN 'highest' THEN rating
+               WHEN 'lowest' THEN -rating
+               WHEN 'helpful' THEN helpful_count
+               ELSE 0
+           END::bigint, created_at, id)
+          < ($2::bigint, $4::timestamp, $5::uuid))
+ORDER BY
+    CASE $3::text
+        WHEN 'highest' THEN rating
+        WHEN 'lowest' THEN -rating
+        WHEN 'helpful' THEN helpful_count
+        ELSE 0
+    END DESC,
+    created_at DESC,
+    id DESC
+LIMIT $6
+`
+
+type ListProductReviewsParams struct {
+	ProductID      uuid.UUID     `db:"product_id" json:"product_id"`
+	AfterKey       sql.NullInt64 `db:"after_key" json:"after_key"`
+	Sort           string        `db:"sort" json:"sort"`
+	AfterCreatedAt sql.NullTime  `db:"after_created_at" json:"after_created_at"`
+	AfterID        uuid.NullUUID `db:"after_id" json:"after_id"`
+	LimitCount     int32         `db:"limit_count" json:"limit_count"`
+}
+
+// Every sort orders by a sort key descending, then newest first, so one
+// keyset condition pages them all. reviewSortKey in gapi computes the same key.
+func (q *Queries) ListProductReviews(ctx context.Context, arg ListProductReviewsParams) ([]Review, error) {
+	rows, err := q.db.QueryContext(ctx, listProductReviews,
+		arg.ProductID,
+		arg.AfterKey,
+		arg.Sort,
+		arg.AfterCreatedAt,
+		arg.AfterID,
+		arg.LimitCount,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []Review{}
+	for rows.Next() {
+		var i Review
+		if err := rows.Scan(
+			&i.ID,
+			&i.ProductID,
+			&i.UserID,
+			&i.Rating,
+			&i.Title,
+			&i.Body,
+			pq.Array(&i.Images),
+			&i.HelpfulCount,
+			&i.SellerReply,
+			&i.SellerRepliedAt,
+			&i.CreatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const refreshProductRating = `-- name: RefreshProductRating :exec
+UPDATE products p
+SET
+    rating_average = stats.rating_average,
+    rating_count = stats.rating_count
+FROM (
+    SELECT
+        COALESCE(ROUND(AVG(r.rating), 2), 0)::decimal AS rating_average,
+        COUNT(*)::int AS rating_count
+    FROM reviews r
+    WHERE r.product_id = $1
+) stats
+WHERE p.id = $1
+`
+
+func (q *Queries) RefreshProductRating(ctx context.Context, productID uuid.UUID) error {
+	_, err := q.db.ExecContext(ctx, refreshProductRating, productID)
+	return err
+}
+
+const setSellerReply = `-- name: SetSellerReply :one
+UPDATE reviews
+SET seller_reply = $2, seller_replied_at = NOW()
+WHERE id = $1
+RETURNING id, product_id, user_id, rating, title, body, images, helpful_count, seller_reply, seller_replied_at, created_at
+`
+
+type SetSellerReplyParams struct {
+	ID          uuid.UUID      `db:"id" json:"id"`
+	SellerReply sql.NullString `db:"seller_reply" json:"seller_reply"`
+}
+
+func (q *Queries) SetSellerReply(ctx context.Context, arg SetSellerReplyParams) (Review, error) {
+	row := q.db.QueryRowContext(ctx, setSellerReply, arg.ID, arg.SellerReply)
+	var i Review
+	err := row.Scan(
+		&i.ID,
+		&i.ProductID,
+		&i.UserID,
+		&i.Rating,
+		&i.Title,
+		&i.Body,
+		pq.Array(&i.Images),
+		&i.HelpfulCount,
+		&i.SellerReply,
+		&i.SellerRepliedAt,
+		&i.CreatedAt,
+	)
+	return i, err
+}
@@ -0,0 +1,145 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.30.0
+// source: search.sql
+
+package db
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/google/uuid"
+	"github.com/lib/pq"
+)
+
+const countSearchProducts = `-- name: CountSearchProducts :one
+SELECT COUNT(*)
+FROM product_search ps
+JOIN products p ON p.id = ps.product_id
+WHERE ps.language = $1::text
+  AND ps.document @@ search_query($1::text::regconfig, $2::text[])
+  AND p.status = 'published'
+  AND p.deleted_at IS NULL
+`
+
+type CountSearchProductsParams struct {
+	Language string   `db:"language" json:"language"`
+	Queries  []string `db:"queries" json:"queries"`
+}
+
+func (q *Queries) CountSearchProducts(ctx context.Context, arg CountSearchProductsParams) (int64, error) {
+	row := q.db.QueryRowContext(ctx, countSearchProducts, arg.Language, pq.Array(arg.Queries))
+	var count int64
+	err := row.Scan(&count)
+	return count, err
+}
+
+const searchLanguageExists = `-- name: SearchLanguageExists :one
+SELECT EXISTS(SELECT 1 FROM search_languages WHERE name = $1)
+`
+
+func (q *Queries) SearchLanguageExists(ctx context.Context, name string) (bool, error) {
+	row := q.db.QueryRowContext(ctx, searchLanguageExists, name)
+	var exists bool
+	err := row.Scan(&exists)
+	return exists, err
+}
+
+const searchProducts = `-- name: SearchProducts :many
+SELECT p.id, p.name, p.description, p.price, p.stock, p.product_url, p.category, p.type, p.created_by, p.created_at, p.status, p.deleted_at, p.category_id, p.rating_average, p.rating_count, p.version, p.low_stock_threshold, p.kind,
+       r.rank,
+       r.rank_key,
+       ts_headline($1::text::regconfig, p.name, q.query,
+           'HighlightAll=true, StartSel=<mark>, StopSel=</mark>')::text AS name_highlight,
+       ts_headline($1::text::regconfig, p.description, q.query,
+           'MaxFragments=2, MaxWords=20, MinWords=5, FragmentDelimiter=" … ", StartSel=<mark>, StopSel=</mark>')::text AS snippet
+FROM product_search ps
+JOIN products p ON p.id = ps.product_id
+CROSS JOIN (SELECT search_query($1::text::regconfig, $2::text[]) AS query) q
+CROSS JOIN LATERAL (
+    SELECT ts_rank_cd('{0.1, 0.2, 0.4, 1.0}', ps.document, q.query)::real AS rank,
+           round(ts_rank_cd('{0.1, 0.2, 0.4, 1.0}', ps.document, q.query) * 1000000)::bigint AS rank_key
+) r
+WHERE ps.language = $1::text
+  AND ps.document @@ q.query
+  AND p.status = 'published'
+  AND p.deleted_at IS NULL
+  AND ($3::bigint IS NULL
+       OR (r.rank_key, p.created_at, p.id) < ($3::bigint, $4::timestamp, $5::uuid))
+ORDER BY r.rank_key DESC, p.created_at DESC, p.id DESC
+LIMIT $6
+`
+
+type SearchProductsParams struct {
+	Language       string        `db:"language" json:"language"`
+	Queries        []string      `db:"queries" json:"queries"`
+	AfterKey       sql.NullInt64 `db:"after_key" json:"after_key"`
+	AfterCreatedAt sql.NullTime  `db:"after_created_at" json:"after_created_at"`
+	AfterID        uuid.NullUUID `db:"after_id" json:"after_id"`
+	LimitCount     int32         `db:"limit_count" json:"limit_count"`
+}
+
+type SearchProductsRow struct {
+	Product       Product `db:"product" json:"product"`
+	Rank          float32 `db:"rank" json:"rank"`
+	RankKey       int64   `db:"rank_key" json:"rank_key"`
+	NameHighlight string  `db:"name_highlight" json:"name_highlight"`
+	Snippet       string  `db:"snippet" json:"snippet"`
+}
+
+// Headlines are only computed for the rows of the requested page, ts_headline
+// is costly enough for the planner to evaluate it after the limit. Pages are
+// keyed on the rank in millionths, rounded so the key compares exactly
+func (q *Queries) SearchProducts(ctx context.Context, arg SearchProductsParams) ([]SearchProductsRow, error) {
+	rows, err := q.db.QueryContext(ctx, searchProducts,
+		arg.Language,
+		pq.Array(arg.Queries),
+		arg.AfterKey,
+		arg.AfterCreatedAt,
+		arg.AfterID,
+		arg.LimitCount,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []SearchProductsRow{}
+	for rows.Next() {
+		var i SearchProductsRow
+		if err := rows.Scan(
+			&i.Product.ID,
+			&i.Product.Name,
+			&i.Product.Description,
+			&i.Product.Price,
+			&i.Product.Stock,
+			&i.Product.ProductUrl,
+			&i.Product.Category,
+			&i.Product.Type,
+			&i.Product.CreatedBy,
+			&i.Product.CreatedAt,
+			&i.Product.Status,
+			&i.Product.DeletedAt,
+			&i.Product.CategoryID,
+			&i.Product.RatingAverage,
+			&i.Product.RatingCount,
+			&i.Product.Version,
+			&i.Product.LowStockThreshold,
+			&i.Product.Kind,
+			&i.Rank,
+			&i.RankKey,
+			&i.NameHighlight,
+			&i.Snippet,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
@@ -0,0 +1,204 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.30.0
+// source: search_analytics.sql
+
+package db
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+const logSearchClick = `-- name: LogSearchClick :exec
+INSERT INTO search_clicks (search_query_id, product_id, position)
+VALUES ($1, $2, $3)
+`
+
+type LogSearchClickParams struct {
+	SearchQueryID uuid.UUID `db:"search_query_id" json:"search_query_id"`
+	ProductID     uuid.UUID `db:"product_id" json:"product_id"`
+	Position      int32     `db:"position" json:"position"`
+}
+
+func (q *Queries) LogSearchClick(ctx context.Context, arg LogSearchClickParams) error {
+	_, err := q.db.ExecContext(ctx, logSearchClick, arg.SearchQueryID, arg.ProductID, arg.Position)
+	return err
+}
+
+const logSearchQuery = `-- name: LogSearchQuery :one
+INSERT INTO search_queries (query, source, result_count)
+VALUES ($1, $2, $3)
+RETURNING id
+`
+
+type LogSearchQueryParams struct {
+	Query       string `db:"query" json:"query"`
+	Source      string `db:"source" json:"source"`
+	ResultCount int32  `db:"result_count" json:"result_count"`
+}
+
+func (q *Queries) LogSearchQuery(ctx context.Context, arg LogSearchQueryParams) (uuid.UUID, error) {
+	row := q.db.QueryRowContext(ctx, logSearchQuery, arg.Query, arg.Source, arg.ResultCount)
+	var id uuid.UUID
+	err := row.Scan(&id)
+	return id, err
+}
+
+const searchClickThrough = `-- name: SearchClickThrough :one
+SELECT
+    COUNT(*)::bigint AS searches,
+    COUNT(c.search_query_id)::bigint AS clicked_searches,
+    COALESCE(SUM(c.clicks), 0)::bigint AS clicks
+FROM search_queries q
+LEFT JOIN (
+    SELECT search_query_id, COUNT(*) AS clicks FROM search_clicks GROUP BY search_query_id
+) c ON c.search_query_id = q.id
+WHERE q.created_at >= $1::timestamp
+  AND q.created_at < $2::timestamp
+  AND ($3::text = '' OR q.source = $3::text)
+`
+
+type SearchClickThroughParams struct {
+	FromTime time.Time `db:"from_time" json:"from_time"`
+	ToTime   time.Time `db:"to_time" json:"to_time"`
+	Source   string    `db:"source" json:"source"`
+}
+
+type SearchClickThroughRow struct {
+	Searches        int64 `db:"searches" json:"searches"`
+	ClickedSearches int64 `db:"clicked_searches" json:"clicked_searches"`
+	Clicks          int64 `db:"clicks" json:"clicks"`
+}
+
+func (q *Queries) SearchClickThrough(ctx context.Context, arg SearchClickThroughParams) (SearchClickThroughRow, error) {
+	row := q.db.QueryRowContext(ctx, searchClickThrough, arg.FromTime, arg.ToTime, arg.Source)
+	var i SearchClickThroughRow
+	err := row.Scan(&i.Searches, &i.ClickedSearches, &i.Clicks)
+	return i, err
+}
+
+const topSearchQueries = `-- name: TopSearchQueries :many
+SELECT
+    q.query,
+    COUNT(*)::bigint AS searches,
+    AVG(q.result_count)::float8 AS avg_results,
+    COUNT(c.search_query_id)::bigint AS clicked_searches
+FROM search_queries q
+LEFT JOIN (
+    SELECT DISTINCT search_query_id FROM search_clicks
+) c ON c.search_query_id = q.id
+WHERE q.created_at >= $1::timestamp
+  AND q.created_at < $2::timestamp
+  AND ($3::text = '' OR q.source = $3::text)
+GROUP BY q.query
+ORDER BY searches DESC, q.query
+LIMIT $4
+`
+
+type TopSearchQueriesParams struct {
+	FromTime   time.Time `db:"from_time" json:"from_time"`
+	ToTime     time.Time `db:"to_time" json:"to_time"`
+	Source     string    `db:"source" json:"source"`
+	LimitCount int32     `db:"limit_count" json:"limit_count"`
+}
+
+type TopSearchQueriesRow struct {
+	Query           string  `db:"query" json:"query"`
+	Searches        int64   `db:"searches" json:"searches"`
+	AvgResults      float64 `db:"avg_results" json:"avg_results"`
+	ClickedSearches int64   `db:"clicked_searches" json:"clicked_searches"`
+}
+
+// Most frequent queries of a period, an empty source counts both
+func (q *Queries) TopSearchQueries(ctx context.Context, arg TopSearchQueriesParams) ([]TopSearchQueriesRow, error) {
+	rows, err := q.db.QueryContext(ctx, topSearchQueries,
+		arg.FromTime,
+		arg.ToTime,
+		arg.Source,
+		arg.LimitCount,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []TopSearchQueriesRow{}
+	for rows.Next() {
+		var i TopSearchQueriesRow
+		if err := rows.Scan(
+			&i.Query,
+			&i.Searches,
+			&i.AvgResults,
+			&i.ClickedSearches,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const zeroResultSearchQueries = `-- name: ZeroResultSearchQueries :many
+SELECT
+    q.query,
+    COUNT(*)::bigint AS searches,
+    MAX(q.created_at)::timestamp AS last_searched_at
+FROM search_queries q
+WHERE q.result_count = 0
+  AND q.created_at >= $1::timestamp
+  AND q.created_at < $2::timestamp
+  AND ($3::text = '' OR q.source = $3::text)
+GROUP BY q.query
+ORDER BY searches DESC, last_searched_at DESC
+LIMIT $4
+`
+
+type ZeroResultSearchQueriesParams struct {
+	FromTime   time.Time `db:"from_time" json:"from_time"`
+	ToTime     time.Time `db:"to_time" json:"to_time"`
+	Source     string    `db:"source" json:"source"`
+	LimitCount int32     `db:"limit_count" json:"limit_count"`
+}
+
+type ZeroResultSearchQueriesRow struct {
+	Query          string    `db:"query" json:"query"`
+	Searches       int64     `db:"searches" json:"searches"`
+	LastSearchedAt time.Time `db:"last_searched_at" json:"last_searched_at"`
+}
+
+// Queries that found nothing, what students look for and can't get
+func (q *Queries) ZeroResultSearchQueries(ctx context.Context, arg ZeroResultSearchQueriesParams) ([]ZeroResultSearchQueriesRow, error) {
+	rows, err := q.db.QueryContext(ctx, zeroResultSearchQueries,
+		arg.FromTime,
+		arg.ToTime,
+		arg.Source,
+		arg.LimitCount,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []ZeroResultSearchQueriesRow{}
+	for rows.Next() {
+		var i ZeroResultSearchQueriesRow
+		if err := rows.Scan(&i.Query, &i.Searches, &i.LastSearchedAt); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
@@ -0,0 +1,135 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.30.0
+// source: search_dictionary.sql
+
+package db
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/lib/pq"
+)
+
+const addStopWord = `-- name: AddStopWord :execrows
+INSERT INTO search_stopwords (word)
+VALUES ($1)
+ON CONFLICT (word) DO NOTHING
+`
+
+func (q *Queries) AddStopWord(ctx context.Context, word string) (int64, error) {
+	result, err := q.db.ExecContext(ctx, addStopWord, word)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected()
+}
+
+const createSynonymGroup = `-- name: CreateSynonymGroup :one
+INSERT INTO search_synonym_groups (terms, created_by)
+VALUES ($1, $2)
+RETURNING id, terms, created_by, created_at
+`
+
+type CreateSynonymGroupParams struct {
+	Terms     []string      `db:"terms" json:"terms"`
+	CreatedBy uuid.NullUUID `db:"created_by" json:"created_by"`
+}
+
+func (q *Queries) CreateSynonymGroup(ctx context.Context, arg CreateSynonymGroupParams) (SearchSynonymGroup, error) {
+	row := q.db.QueryRowContext(ctx, createSynonymGroup, pq.Array(arg.Terms), arg.CreatedBy)
+	var i SearchSynonymGroup
+	err := row.Scan(
+		&i.ID,
+		pq.Array(&i.Terms),
+		&i.CreatedBy,
+		&i.CreatedAt,
+	)
+	return i, err
+}
+
+const deleteSynonymGroup = `-- name: DeleteSynonymGroup :execrows
+DELETE FROM search_synonym_groups WHERE id = $1
+`
+
+func (q *Queries) DeleteSynonymGroup(ctx context.Context, id uuid.UUID) (int64, error) {
+	result, err := q.db.ExecContext(ctx, deleteSynonymGroup, id)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected()
+}
+
+const listStopWords = `-- name: ListStopWords :many
+SELECT word FROM search_stopwords
+ORDER BY word
+`
+
+func (q *Queries) ListStopWords(ctx context.Context) ([]string, error) {
+	rows, err := q.db.QueryContext(ctx, listStopWords)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []string{}
+	for rows.Next() {
+		var word string
+		if err := rows.Scan(&word); err != nil {
+			return nil, err
+		}
+		items = append(items, word)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listSynonymGroups = `-- name: ListSynonymGroups :many
+SELECT id, terms, created_by, created_at FROM search_synonym_groups
+ORDER BY created_at, id
+`
+
+func (q *Queries) ListSynonymGroups(ctx context.Context) ([]SearchSynonymGroup, error) {
+	rows, err := q.db.QueryContext(ctx, listSynonymGroups)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []SearchSynonymGroup{}
+	for rows.Next() {
+		var i SearchSynonymGroup
+		if err := rows.Scan(
+			&i.ID,
+			pq.Array(&i.Terms),
+			&i.CreatedBy,
+			&i.CreatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const removeStopWord = `-- name: RemoveStopWord :execrows
+DELETE FROM search_stopwords WHERE word = $1
+`
+
+func (q *Queries) RemoveStopWord(ctx context.Context, word string) (int64, error) {
+	result, err := q.db.ExecContext(ctx, removeStopWord, word)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected()
+}
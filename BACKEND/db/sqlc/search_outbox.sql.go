@@ -0,0 +1,136 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.30.0
+// source: search_outbox.sql
+
+package db
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+const deleteProcessedSearchOutbox = `-- name: DeleteProcessedSearchOutbox :execrows
+DELETE FROM search_outbox
+WHERE processed_at < $1::timestamp
+`
+
+func (q *Queries) DeleteProcessedSearchOutbox(ctx context.Context, processedBefore time.Time) (int64, error) {
+	result, err := q.db.ExecContext(ctx, deleteProcessedSearchOutbox, processedBefore)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected()
+}
+
+const listPendingSearchOutbox = `-- name: ListPendingSearchOutbox :many
+SELECT
+    product_id,
+    MAX(id)::bigint AS last_id,
+    MAX(attempts)::int AS attempts
+FROM search_outbox
+WHERE processed_at IS NULL AND next_attempt_at <= CURRENT_TIMESTAMP
+GROUP BY product_id
+ORDER BY MIN(id)
+LIMIT $1
+`
+
+type ListPendingSearchOutboxRow struct {
+	ProductID uuid.UUID `db:"product_id" json:"product_id"`
+	LastID    int64     `db:"last_id" json:"last_id"`
+	Attempts  int32     `db:"attempts" json:"attempts"`
+}
+
+// Pending entries grouped per product, a product changed several times is
+// only indexed once, in its latest state
+func (q *Queries) ListPendingSearchOutbox(ctx context.Context, limitCount int32) ([]ListPendingSearchOutboxRow, error) {
+	rows, err := q.db.QueryContext(ctx, listPendingSearchOutbox, limitCount)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []ListPendingSearchOutboxRow{}
+	for rows.Next() {
+		var i ListPendingSearchOutboxRow
+		if err := rows.Scan(&i.ProductID, &i.LastID, &i.Attempts); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const markSearchOutboxProcessed = `-- name: MarkSearchOutboxProcessed :exec
+UPDATE search_outbox
+SET processed_at = CURRENT_TIMESTAMP
+WHERE product_id = $1
+  AND id <= $2
+  AND processed_at IS NULL
+`
+
+type MarkSearchOutboxProcessedParams struct {
+	ProductID uuid.UUID `db:"product_id" json:"product_id"`
+	LastID    int64     `db:"last_id" json:"last_id"`
+}
+
+func (q *Queries) MarkSearchOutboxProcessed(ctx context.Context, arg MarkSearchOutboxProcessedParams) error {
+	_, err := q.db.ExecContext(ctx, markSearchOutboxProcessed, arg.ProductID, arg.LastID)
+	return err
+}
+
+const recordSearchOutboxFailure = `-- name: RecordSearchOutboxFailure :exec
+UPDATE search_outbox
+SET attempts = attempts + 1,
+    last_error = $1,
+    next_attempt_at = CURRENT_TIMESTAMP + make_interval(secs => power(2, LEAST(attempts + 1, 10)))
+WHERE product_id = $2
+  AND id <= $3
+  AND processed_at IS NULL
+`
+
+type RecordSearchOutboxFailureParams struct {
+	LastError string    `db:"last_error" json:"last_error"`
+	ProductID uuid.UUID `db:"product_id" json:"product_id"`
+	LastID    int64     `db:"last_id" json:"last_id"`
+}
+
+// Retries back off exponentially, capped at about 17 minutes
+func (q *Queries) RecordSearchOutboxFailure(ctx context.Context, arg RecordSearchOutboxFailureParams) error {
+	_, err := q.db.ExecContext(ctx, recordSearchOutboxFailure, arg.LastError, arg.ProductID, arg.LastID)
+	return err
+}
+
+const requeueSearchOutboxAfter = `-- name: RequeueSearchOutboxAfter :execrows
+INSERT INTO search_outbox (product_id)
+SELECT DISTINCT o.product_id FROM search_outbox o
+WHERE o.id > $1
+`
+
+func (q *Queries) RequeueSearchOutboxAfter(ctx context.Context, afterID int64) (int64, error) {
+	result, err := q.db.ExecContext(ctx, requeueSearchOutboxAfter, afterID)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected()
+}
+
+const searchOutboxHighWater = `-- name: SearchOutboxHighWater :one
+SELECT COALESCE(MAX(id), 0)::bigint FROM search_outbox
+`
+
+// The newest outbox entry, changes queued after it are requeued once a
+// reindex swapped its shadow index in
+func (q *Queries) SearchOutboxHighWater(ctx context.Context) (int64, error) {
+	row := q.db.QueryRowContext(ctx, searchOutboxHighWater)
+	var column_1 int64
+	err := row.Scan(&column_1)
+	return column_1, err
+}
@@ -0,0 +1,108 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.30.0
+// source: stock_movements.sql
+
+package db
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/google/uuid"
+)
+
+const createStockMovement = `-- name: CreateStockMovement :one
+INSERT INTO stock_movements (product_id, delta, stock_after, reason, actor_id, reference_id, note)
+VALUES ($1, $2, $3, $4, $5, $6, $7)
+RETURNING id, product_id, delta, stock_after, reason, actor_id, reference_id, note, created_at
+`
+
+type CreateStockMovementParams struct {
+	ProductID   uuid.UUID     `db:"product_id" json:"product_id"`
+	Delta       int32         `db:"delta" json:"delta"`
+	StockAfter  int32         `db:"stock_after" json:"stock_after"`
+	Reason      string        `db:"reason" json:"reason"`
+	ActorID     uuid.NullUUID `db:"actor_id" json:"actor_id"`
+	ReferenceID uuid.NullUUID `db:"reference_id" json:"reference_id"`
+	Note        string        `db:"note" json:"note"`
+}
+
+func (q *Queries) CreateStockMovement(ctx context.Context, arg CreateStockMovementParams) (StockMovement, error) {
+	row := q.db.QueryRowContext(ctx, createStockMovement,
+		arg.ProductID,
+		arg.Delta,
+		arg.StockAfter,
+		arg.Reason,
+		arg.ActorID,
+		arg.ReferenceID,
+		arg.Note,
+	)
+	var i StockMovement
+	err := row.Scan(
+		&i.ID,
+		&i.ProductID,
+		&i.Delta,
+		&i.StockAfter,
+		&i.Reason,
+		&i.ActorID,
+		&i.ReferenceID,
+		&i.Note,
+		&i.CreatedAt,
+	)
+	return i, err
+}
+
+const listStockMovements = `-- name: ListStockMovements :many
+SELECT id, product_id, delta, stock_after, reason, actor_id, reference_id, note, created_at FROM stock_movements
+WHERE product_id = $1
+  AND ($2::timestamp IS NULL
+       OR (created_at, id) < ($2::timestamp, $3::uuid))
+ORDER BY created_at DESC, id DESC
+LIMIT $4
+`
+
+type ListStockMovementsParams struct {
+	ProductID      uuid.UUID     `db:"product_id" json:"product_id"`
+	AfterCreatedAt sql.NullTime  `db:"after_created_at" json:"after_created_at"`
+	AfterID        uuid.NullUUID `db:"after_id" json:"after_id"`
+	LimitCount     int32         `db:"limit_count" json:"limit_count"`
+}
+
+func (q *Queries) ListStockMovements(ctx context.Context, arg ListStockMovementsParams) ([]StockMovement, error) {
+	rows, err := q.db.QueryContext(ctx, listStockMovements,
+		arg.ProductID,
+		arg.AfterCreatedAt,
+		arg.AfterID,
+		arg.LimitCount,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []StockMovement{}
+	for rows.Next() {
+		var i StockMovement
+		if err := rows.Scan(
+			&i.ID,
+			&i.ProductID,
+			&i.Delta,
+			&i.StockAfter,
+			&i.Reason,
+			&i.ActorID,
+			&i.ReferenceID,
+			&i.Note,
+			&i.CreatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
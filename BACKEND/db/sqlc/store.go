@@ -3,8 +3,11 @@ package db
 import (
 	"context"
 	"database/sql"
+	"errors"
 	"fmt"
+	"math"
 	"strconv"
+	"time"
 
 	"github.com/google/uuid"
 	"github.com/siddheshRajendraNimbalkar/collage-prject-backend/pb"
@@ -52,7 +55,7 @@ func (store *SQLStore) OrderTx(ctx context.Context, arg *pb.CreateOrderRequest)
 		fmt.Println(txName, "Start Order Process")
 
 		// Validate input
-		if arg.GetUserId() == "" || arg.GetProductId() == "" {
+		if arg.GetUserId() == "" || arg.GetProductId() == "" || arg.GetQuantity() <= 0 {
 			return fmt.Errorf("invalid order details")
 		}
 
@@ -71,30 +74,84 @@ func (store *SQLStore) OrderTx(ctx context.Context, arg *pb.CreateOrderRequest)
 			return fmt.Errorf("invalid product ID format: %v", err)
 		}
 
-		product, err := q.GetProductByID(ctx, productID)
+		product, err := q.GetProductForUpdate(ctx, productID)
 		if err != nil {
 			return fmt.Errorf("product not found: %v", err)
 		}
 
-		if product.Stock < arg.GetQuantity() {
+		if product.Status != "published" {
+			return fmt.Errorf("product is not available")
+		}
+
+		// Bundles are checked component by component when stock is taken
+		if product.Kind == ProductKindPhysical && product.Stock < arg.GetQuantity() {
 			return fmt.Errorf("insufficient stock")
 		}
 
+		// Digital products hold no stock but cannot be sold before their file exists
+		if product.Kind == ProductKindDigital {
+			if _, err := q.GetDigitalAssetByProductID(ctx, product.ID); err != nil {
+				if err == sql.ErrNoRows {
+					return fmt.Errorf("product is not available")
+				}
+				return fmt.Errorf("failed to fetch digital asset: %v", err)
+			}
+		}
+
 		// Convert product.Price (string) to float
-		productPrice, err := strconv.ParseFloat(product.Price, 32)
+		productPrice, err := strconv.ParseFloat(product.Price, 64)
 		if err != nil {
 			return fmt.Errorf("invalid price format: %v", err)
 		}
 
-		// Calculate total price
-		totalPrice := float32(productPrice) * float32(arg.GetQuantity())
-		totalPriceStr := fmt.Sprintf("%.2f", totalPrice)
+		// A running sale lowers the price, never raises it
+		sale, err := q.GetActiveSalePrice(ctx, product.ID)
+		if err != nil && err != sql.ErrNoRows {
+			return fmt.Errorf("failed to fetch sale price: %v", err)
+		}
+		if err == nil {
+			salePrice, err := strconv.ParseFloat(sale.Price, 64)
+			if err != nil {
+				return fmt.Errorf("invalid sale price format: %v", err)
+			}
+			if salePrice < productPrice {
+				productPrice = salePrice
+			}
+		}
+
+		subtotalCents := SubtotalCents(productPrice, arg.GetQuantity())
+
+		// Apply the coupon, locked so its usage limits hold under concurrency
+		var coupon Coupon
+		discountCents := int64(0)
+		if arg.GetCouponCode() != "" {
+			coupon, err = q.GetCouponByCodeForUpdate(ctx, arg.GetCouponCode())
+			if err != nil {
+				if err == sql.ErrNoRows {
+					return fmt.Errorf("%w: unknown code", ErrInvalidCoupon)
+				}
+				return fmt.Errorf("failed to fetch coupon: %v", err)
+			}
+
+			discountCents, err = couponDiscount(ctx, q, coupon, CouponCheck{
+				UserID:        user.ID,
+				Product:       product,
+				SubtotalCents: subtotalCents,
+			})
+			if err != nil {
+				return err
+			}
+		}
+		totalCents := subtotalCents - discountCents
+
+		totalPriceStr := formatCents(totalCents)
 		// Create Order
 		orderParams := CreateOrderParams{
-			UserID:     uuid.NullUUID{UUID: user.ID, Valid: true},
-			ProductID:  uuid.NullUUID{UUID: product.ID, Valid: true},
-			Quantity:   arg.GetQuantity(),
-			TotalPrice: totalPriceStr, // Fixed
+			UserID:        uuid.NullUUID{UUID: user.ID, Valid: true},
+			ProductID:     uuid.NullUUID{UUID: product.ID, Valid: true},
+			Quantity:      arg.GetQuantity(),
+			TotalPrice:    totalPriceStr, // Fixed
+			DiscountTotal: formatCents(discountCents),
 		}
 
 		order, err := q.CreateOrder(ctx, orderParams)
@@ -102,25 +159,48 @@ func (store *SQLStore) OrderTx(ctx context.Context, arg *pb.CreateOrderRequest)
 			return fmt.Errorf("error creating order: %v", err)
 		}
 
+		if discountCents > 0 {
+			_, err = q.CreateOrderDiscount(ctx, CreateOrderDiscountParams{
+				OrderID:  order.ID,
+				CouponID: coupon.ID,
+				Code:     coupon.Code,
+				Amount:   order.DiscountTotal,
+			})
+			if err != nil {
+				return fmt.Errorf("failed to record discount: %v", err)
+			}
+
+			if err := q.IncrementCouponUse(ctx, coupon.ID); err != nil {
+				return fmt.Errorf("failed to count coupon use: %v", err)
+			}
+		}
+
 		// Update Stock
-		err = q.UpdateProductStock(ctx, UpdateProductStockParams{
-			ID:    product.ID,
-			Stock: product.Stock - order.Quantity,
+		err = moveProductStock(ctx, q, product, StockMovementParams{
+			ProductID:   product.ID,
+			Delta:       -order.Quantity,
+			Reason:      StockReasonSale,
+			ActorID:     uuid.NullUUID{UUID: user.ID, Valid: true},
+			ReferenceID: uuid.NullUUID{UUID: order.ID, Valid: true},
 		})
 		if err != nil {
+			if err.Error() == "insufficient stock" {
+				return err
+			}
 			return fmt.Errorf("failed to update stock: %v", err)
 		}
 
 		// Build Response
 		resp := &pb.OrderResponse{
 			Order: &pb.Order{
-				Id:         order.ID.String(),
-				UserId:     order.UserID.UUID.String(),
-				ProductId:  order.ProductID.UUID.String(),
-				TotalPrice: float64(totalPrice),
-				Status:     order.Status.String,
-				CreatedAt:  order.CreatedAt.Time.Format("2006-01-02 15:04:05"),
-				Quantity:   order.Quantity,
+				Id:            order.ID.String(),
+				UserId:        order.UserID.UUID.String(),
+				ProductId:     order.ProductID.UUID.String(),
+				TotalPrice:    float64(totalCents) / 100,
+				Status:        order.Status.String,
+				CreatedAt:     order.CreatedAt.Time.Format("2006-01-02 15:04:05"),
+				Quantity:      order.Quantity,
+				DiscountTotal: float64(discountCents) / 100,
 			},
 		}
 
@@ -135,6 +215,23 @@ func (store *SQLStore) OrderTx(ctx context.Context, arg *pb.CreateOrderRequest)
 	return result, nil
 }
 
+// SubtotalCents is what quantity items at unitPrice cost, in cents. Order
+// totals are worked out in cents so they don't pick up float rounding, and
+// coupon previews use the same figure as checkout.
+func SubtotalCents(unitPrice float64, quantity int32) int64 {
+	return toCents(unitPrice) * int64(quantity)
+}
+
+// toCents rounds an amount to whole cents.
+func toCents(amount float64) int64 {
+	return int64(math.Round(amount * 100))
+}
+
+// formatCents is the DECIMAL(10,2) text of an amount in cents.
+func formatCents(cents int64) string {
+	return fmt.Sprintf("%d.%02d", cents/100, cents%100)
+}
+
 func (store *SQLStore) DeleteOrderTx(ctx context.Context, arg *pb.DeleteOrderRequest) (*pb.DeleteOrderResponse, error) {
 	var result = &pb.DeleteOrderResponse{}
 
@@ -151,7 +248,7 @@ func (store *SQLStore) DeleteOrderTx(ctx context.Context, arg *pb.DeleteOrderReq
 		orderData, err := q.CancelOrder(ctx, orderID)
 		if err != nil {
 			if err == sql.ErrNoRows {
-				return fmt.Errorf("order not found")
+				return fmt.Errorf("order not found or no longer pending")
 			}
 			return fmt.Errorf("failed to delete order: %v", err)
 		}
@@ -160,17 +257,24 @@ func (store *SQLStore) DeleteOrderTx(ctx context.Context, arg *pb.DeleteOrderReq
 			return fmt.Errorf("invalid product ID for order")
 		}
 
-		getProduct, err := q.GetProductByID(ctx, orderData.ProductID.UUID)
-		if err != nil {
-			return fmt.Errorf("failed to get product: %v", err)
+		// Cancelled orders give their coupon uses back
+		if err := q.ReleaseOrderCoupons(ctx, orderData.ID); err != nil {
+			return fmt.Errorf("failed to release coupon: %v", err)
 		}
 
-		changeProd := UpdateProductStockParams{
-			ID:    orderData.ProductID.UUID,
-			Stock: orderData.Quantity + getProduct.Stock,
+		product, err := q.GetProductByIDIncludingDeleted(ctx, orderData.ProductID.UUID)
+		if err != nil {
+			return fmt.Errorf("failed to get product: %v", err)
 		}
 
-		if err := q.UpdateProductStock(ctx, changeProd); err != nil {
+		err = moveProductStock(ctx, q, product, StockMovementParams{
+			ProductID:   orderData.ProductID.UUID,
+			Delta:       orderData.Quantity,
+			Reason:      StockReasonCancel,
+			ActorID:     orderData.UserID,
+			ReferenceID: uuid.NullUUID{UUID: orderData.ID, Valid: true},
+		})
+		if err != nil {
 			return fmt.Errorf("failed to update product stock: %v", err)
 		}
 
@@ -184,3 +288,885 @@ func (store *SQLStore) DeleteOrderTx(ctx context.Context, arg *pb.DeleteOrderReq
 	result.Message = "order deleted successfully"
 	return result, nil
 }
+
+func (store *SQLStore) UpdateCategoryTx(ctx context.Context, arg UpdateCategoryParams) (Category, error) {
+	var result Category
+
+	err := store.execTx(ctx, func(q *Queries) error {
+		category, err := q.UpdateCategory(ctx, arg)
+		if err != nil {
+			return err
+		}
+
+		// Products keep category/type slugs for search, refresh the whole subtree
+		if err := q.RefreshProductCategoryLabels(ctx, category.ID); err != nil {
+			return fmt.Errorf("failed to refresh product categories: %v", err)
+		}
+
+		result = category
+		return nil
+	})
+
+	return result, err
+}
+
+func (store *SQLStore) CreateReviewTx(ctx context.Context, arg CreateReviewParams) (Review, error) {
+	var result Review
+
+	err := store.execTx(ctx, func(q *Queries) error {
+		review, err := q.CreateReview(ctx, arg)
+		if err != nil {
+			return err
+		}
+
+		if err := q.RefreshProductRating(ctx, review.ProductID); err != nil {
+			return fmt.Errorf("failed to refresh product rating: %v", err)
+		}
+
+		result = review
+		return nil
+	})
+
+	return result, err
+}
+
+// MarkReviewHelpfulTx records one helpful vote per user and review.
+// Repeated votes leave the count untouched.
+func (store *SQLStore) MarkReviewHelpfulTx(ctx context.Context, arg CreateReviewVoteParams) (Review, error) {
+	var result Review
+
+	err := store.execTx(ctx, func(q *Queries) error {
+		inserted, err := q.CreateReviewVote(ctx, arg)
+		if err != nil {
+			return err
+		}
+
+		if inserted == 0 {
+			result, err = q.GetReviewByID(ctx, arg.ReviewID)
+			return err
+		}
+
+		result, err = q.IncrementReviewHelpful(ctx, arg.ReviewID)
+		return err
+	})
+
+	return result, err
+}
+
+// QuestionHideThreshold is how many flags hide a question or answer until an
+// admin looks at it.
+const QuestionHideThreshold = 3
+
+// AskQuestionTx stores a buyer's question and notifies the seller.
+func (store *SQLStore) AskQuestionTx(ctx context.Context, arg CreateQuestionParams, product Product) (ProductQuestion, error) {
+	var result ProductQuestion
+
+	err := store.execTx(ctx, func(q *Queries) error {
+		question, err := q.CreateQuestion(ctx, arg)
+		if err != nil {
+			return err
+		}
+
+		_, err = q.CreateNotification(ctx, CreateNotificationParams{
+			UserID:    product.CreatedBy.UUID,
+			Kind:      "question",
+			Title:     "New question",
+			Body:      fmt.Sprintf("Someone asked about %s: %s", product.Name, question.Body),
+			ProductID: uuid.NullUUID{UUID: product.ID, Valid: true},
+			SendEmail: true,
+		})
+		if err != nil {
+			return fmt.Errorf("failed to notify seller: %v", err)
+		}
+
+		result = question
+		return nil
+	})
+
+	return result, err
+}
+
+// UpvoteQuestionTx records one upvote per user and question.
+func (store *SQLStore) UpvoteQuestionTx(ctx context.Context, arg CreateQuestionVoteParams) (ProductQuestion, error) {
+	var result ProductQuestion
+
+	err := store.execTx(ctx, func(q *Queries) error {
+		inserted, err := q.CreateQuestionVote(ctx, arg)
+		if err != nil {
+			return err
+		}
+
+		if inserted == 0 {
+			result, err = q.GetQuestionByID(ctx, arg.QuestionID)
+			return err
+		}
+
+		result, err = q.IncrementQuestionUpvotes(ctx, arg.QuestionID)
+		return err
+	})
+
+	return result, err
+}
+
+// UpvoteAnswerTx records one upvote per user and answer.
+func (store *SQLStore) UpvoteAnswerTx(ctx context.Context, arg CreateAnswerVoteParams) (ProductAnswer, error) {
+	var result ProductAnswer
+
+	err := store.execTx(ctx, func(q *Queries) error {
+		inserted, err := q.CreateAnswerVote(ctx, arg)
+		if err != nil {
+			return err
+		}
+
+		if inserted == 0 {
+			result, err = q.GetAnswerByID(ctx, arg.AnswerID)
+			return err
+		}
+
+		result, err = q.IncrementAnswerUpvotes(ctx, arg.AnswerID)
+		return err
+	})
+
+	return result, err
+}
+
+// FlagQuestionTx records one flag per user and question and hides the
+// question once it reaches QuestionHideThreshold flags.
+func (store *SQLStore) FlagQuestionTx(ctx context.Context, arg CreateQuestionFlagParams) (ProductQuestion, error) {
+	var result ProductQuestion
+
+	err := store.execTx(ctx, func(q *Queries) error {
+		inserted, err := q.CreateQuestionFlag(ctx, arg)
+		if err != nil {
+			return err
+		}
+
+		if inserted == 0 {
+			result, err = q.GetQuestionByID(ctx, arg.QuestionID)
+			return err
+		}
+
+		result, err = q.IncrementQuestionFlags(ctx, IncrementQuestionFlagsParams{
+			HideThreshold: QuestionHideThreshold,
+			ID:            arg.QuestionID,
+		})
+		return err
+	})
+
+	return result, err
+}
+
+// FlagAnswerTx records one flag per user and answer and hides the answer
+// once it reaches QuestionHideThreshold flags.
+func (store *SQLStore) FlagAnswerTx(ctx context.Context, arg CreateAnswerFlagParams) (ProductAnswer, error) {
+	var result ProductAnswer
+
+	err := store.execTx(ctx, func(q *Queries) error {
+		inserted, err := q.CreateAnswerFlag(ctx, arg)
+		if err != nil {
+			return err
+		}
+
+		if inserted == 0 {
+			result, err = q.GetAnswerByID(ctx, arg.AnswerID)
+			return err
+		}
+
+		result, err = q.IncrementAnswerFlags(ctx, IncrementAnswerFlagsParams{
+			HideThreshold: QuestionHideThreshold,
+			ID:            arg.AnswerID,
+		})
+		return err
+	})
+
+	return result, err
+}
+
+// UpdateProductTx updates a product. A stock change is recorded as a manual
+// adjustment by actorID, and tells everyone who wishlisted the product when
+// it brings it back in stock.
+func (store *SQLStore) UpdateProductTx(ctx context.Context, arg UpdateProductParams, actorID uuid.UUID) (Product, error) {
+	var result Product
+
+	err := store.execTx(ctx, func(q *Queries) error {
+		current, err := q.GetProductForUpdate(ctx, arg.ID)
+		if err != nil {
+			return err
+		}
+
+		product, err := q.UpdateProduct(ctx, arg)
+		if err != nil {
+			return err
+		}
+
+		if product.Price != current.Price {
+			err = q.RecordListPrice(ctx, RecordListPriceParams{
+				ProductID: product.ID,
+				Price:     product.Price,
+				CreatedBy: uuid.NullUUID{UUID: actorID, Valid: true},
+			})
+			if err != nil {
+				return fmt.Errorf("failed to record price: %v", err)
+			}
+		}
+
+		if product.Stock != current.Stock {
+			_, err = q.CreateStockMovement(ctx, CreateStockMovementParams{
+				ProductID:  product.ID,
+				Delta:      product.Stock - current.Stock,
+				StockAfter: product.Stock,
+				Reason:     StockReasonManualAdjustment,
+				ActorID:    uuid.NullUUID{UUID: actorID, Valid: true},
+			})
+			if err != nil {
+				return fmt.Errorf("failed to record stock movement: %v", err)
+			}
+		}
+
+		if err := notifyBackInStock(ctx, q, product.ID, current.Stock, product.Stock); err != nil {
+			return fmt.Errorf("failed to queue back in stock notifications: %v", err)
+		}
+
+		if err := notifyLowStock(ctx, q, product, current.Stock); err != nil {
+			return fmt.Errorf("failed to queue low stock alert: %v", err)
+		}
+
+		result = product
+		return nil
+	})
+
+	return result, err
+}
+
+// notifyBackInStock queues a notification for every wishlist entry of the
+// product when its stock goes from zero to a positive amount. Bundles have no
+// stock of their own, their wishlists are notified when restocking one of
+// their components lets the bundle be put together again.
+func notifyBackInStock(ctx context.Context, q *Queries, productID uuid.UUID, oldStock, newStock int32) error {
+	if newStock <= oldStock {
+		return nil
+	}
+
+	if oldStock <= 0 {
+		if _, err := q.EnqueueBackInStockNotifications(ctx, productID); err != nil {
+			return err
+		}
+	}
+
+	bundleIDs, err := q.ListBundlesBackInStock(ctx, ListBundlesBackInStockParams{
+		ComponentID: productID,
+		OldStock:    oldStock,
+	})
+	if err != nil {
+		return err
+	}
+	for _, bundleID := range bundleIDs {
+		if _, err := q.EnqueueBackInStockNotifications(ctx, bundleID); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// notifyLowStock alerts the seller, in-app and by email, when the stock
+// drops to or below the product's low stock threshold. With the default
+// threshold of 0 the alert fires when the product sells out.
+func notifyLowStock(ctx context.Context, q *Queries, product Product, oldStock int32) error {
+	threshold := product.LowStockThreshold
+	if oldStock <= threshold || product.Stock > threshold || !product.CreatedBy.Valid {
+		return nil
+	}
+
+	arg := CreateNotificationParams{
+		UserID:    product.CreatedBy.UUID,
+		Kind:      "low_stock",
+		Title:     "Low stock",
+		Body:      fmt.Sprintf("%s is down to %d left (alert threshold %d)", product.Name, product.Stock, threshold),
+		ProductID: uuid.NullUUID{UUID: product.ID, Valid: true},
+		SendEmail: true,
+	}
+	if product.Stock == 0 {
+		arg.Kind = "out_of_stock"
+		arg.Title = "Sold out"
+		arg.Body = fmt.Sprintf("%s is out of stock", product.Name)
+	}
+
+	_, err := q.CreateNotification(ctx, arg)
+	return err
+}
+
+// ImportProduct is one product of a bulk import, BundleItems lists the
+// components when it is a bundle.
+type ImportProduct struct {
+	Product     CreateProductParams
+	BundleItems []BundleItemParams
+}
+
+// ImportProductsTx creates every product of a bulk import or none of them.
+func (store *SQLStore) ImportProductsTx(ctx context.Context, args []ImportProduct) ([]Product, error) {
+	var result []Product
+
+	err := store.execTx(ctx, func(q *Queries) error {
+		for i, arg := range args {
+			var product Product
+			var err error
+			if arg.Product.Kind == ProductKindBundle {
+				product, err = createBundle(ctx, q, arg.Product, arg.BundleItems)
+			} else {
+				product, err = createProductWithHistory(ctx, q, arg.Product, StockReasonImport)
+			}
+			if err != nil {
+				return fmt.Errorf("failed to create product %d (%s): %w", i+1, arg.Product.Name, err)
+			}
+			result = append(result, product)
+		}
+		return nil
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
+
+const (
+	StockReasonInitial          = "initial"
+	StockReasonSale             = "sale"
+	StockReasonCancel           = "cancel"
+	StockReasonReturn           = "return"
+	StockReasonManualAdjustment = "manual_adjustment"
+	StockReasonImport           = "import"
+)
+
+// StockMovementParams describes one change to a product's stock.
+type StockMovementParams struct {
+	ProductID   uuid.UUID
+	Delta       int32
+	Reason      string
+	ActorID     uuid.NullUUID
+	ReferenceID uuid.NullUUID
+	Note        string
+}
+
+// CreateProductTx creates a product, records its starting stock in the
+// ledger under the given reason and opens its list price history.
+func (store *SQLStore) CreateProductTx(ctx context.Context, arg CreateProductParams, reason string) (Product, error) {
+	var result Product
+
+	err := store.execTx(ctx, func(q *Queries) error {
+		var err error
+		result, err = createProductWithHistory(ctx, q, arg, reason)
+		return err
+	})
+
+	return result, err
+}
+
+// AdjustStockTx changes a product's stock by arg.Delta and records why.
+func (store *SQLStore) AdjustStockTx(ctx context.Context, arg StockMovementParams) (Product, StockMovement, error) {
+	var product Product
+	var movement StockMovement
+
+	err := store.execTx(ctx, func(q *Queries) error {
+		var err error
+		product, movement, err = applyStockMovement(ctx, q, arg)
+		return err
+	})
+
+	return product, movement, err
+}
+
+func createProductWithHistory(ctx context.Context, q *Queries, arg CreateProductParams, reason string) (Product, error) {
+	if arg.Kind == "" {
+		arg.Kind = ProductKindPhysical
+	}
+
+	product, err := q.CreateProduct(ctx, arg)
+	if err != nil {
+		return Product{}, err
+	}
+
+	err = q.RecordListPrice(ctx, RecordListPriceParams{
+		ProductID: product.ID,
+		Price:     product.Price,
+		CreatedBy: product.CreatedBy,
+	})
+	if err != nil {
+		return Product{}, fmt.Errorf("failed to record price: %v", err)
+	}
+
+	if product.Stock == 0 {
+		return product, nil
+	}
+
+	_, err = q.CreateStockMovement(ctx, CreateStockMovementParams{
+		ProductID:  product.ID,
+		Delta:      product.Stock,
+		StockAfter: product.Stock,
+		Reason:     reason,
+		ActorID:    product.CreatedBy,
+	})
+	if err != nil {
+		return Product{}, fmt.Errorf("failed to record stock movement: %v", err)
+	}
+
+	return product, nil
+}
+
+// applyStockMovement is the only way stock changes after creation. It locks
+// the product row, applies the delta, appends the movement to the ledger and
+// queues back in stock notifications, all inside the caller's transaction.
+// Cancelled and returned orders restock products deleted since they were
+// placed, every other movement needs a live product.
+func applyStockMovement(ctx context.Context, q *Queries, arg StockMovementParams) (Product, StockMovement, error) {
+	lockProduct := q.GetProductForUpdate
+	if arg.Reason == StockReasonCancel || arg.Reason == StockReasonReturn {
+		lockProduct = q.GetProductForUpdateIncludingDeleted
+	}
+
+	product, err := lockProduct(ctx, arg.ProductID)
+	if err != nil {
+		return Product{}, StockMovement{}, err
+	}
+
+	if arg.Delta == 0 {
+		return Product{}, StockMovement{}, fmt.Errorf("stock change cannot be zero")
+	}
+
+	newStock := product.Stock + arg.Delta
+	if newStock < 0 {
+		return Product{}, StockMovement{}, fmt.Errorf("insufficient stock")
+	}
+
+	if err := q.UpdateProductStock(ctx, UpdateProductStockParams{ID: product.ID, Stock: newStock}); err != nil {
+		return Product{}, StockMovement{}, err
+	}
+
+	movement, err := q.CreateStockMovement(ctx, CreateStockMovementParams{
+		ProductID:   product.ID,
+		Delta:       arg.Delta,
+		StockAfter:  newStock,
+		Reason:      arg.Reason,
+		ActorID:     arg.ActorID,
+		ReferenceID: arg.ReferenceID,
+		Note:        arg.Note,
+	})
+	if err != nil {
+		return Product{}, StockMovement{}, fmt.Errorf("failed to record stock movement: %v", err)
+	}
+
+	oldStock := product.Stock
+	product.Stock = newStock
+	product.Version++
+
+	// Nobody can buy a deleted product, restocking it alerts no one
+	if product.DeletedAt.Valid {
+		return product, movement, nil
+	}
+
+	if err := notifyBackInStock(ctx, q, product.ID, oldStock, newStock); err != nil {
+		return Product{}, StockMovement{}, fmt.Errorf("failed to queue back in stock notifications: %v", err)
+	}
+
+	if err := notifyLowStock(ctx, q, product, oldStock); err != nil {
+		return Product{}, StockMovement{}, fmt.Errorf("failed to queue low stock alert: %v", err)
+	}
+
+	return product, movement, nil
+}
+
+// ScheduleSaleTx adds a sale price window to a product. Sales of the same
+// product may not overlap.
+func (store *SQLStore) ScheduleSaleTx(ctx context.Context, arg CreateProductPriceParams) (ProductPrice, error) {
+	var result ProductPrice
+
+	err := store.execTx(ctx, func(q *Queries) error {
+		// Lock the product so concurrent schedules see each other
+		if _, err := q.GetProductForUpdate(ctx, arg.ProductID); err != nil {
+			return err
+		}
+
+		overlapping, err := q.CountOverlappingSales(ctx, CountOverlappingSalesParams{
+			ProductID: arg.ProductID,
+			StartsAt:  arg.ValidFrom,
+			EndsAt:    arg.ValidTo.Time,
+		})
+		if err != nil {
+			return err
+		}
+		if overlapping > 0 {
+			return fmt.Errorf("sale overlaps an existing sale")
+		}
+
+		arg.Kind = "sale"
+		result, err = q.CreateProductPrice(ctx, arg)
+		return err
+	})
+
+	return result, err
+}
+
+// ErrInvalidCoupon wraps every reason a coupon cannot be used. The wrapped
+// message is meant for the buyer.
+var ErrInvalidCoupon = errors.New("coupon cannot be applied")
+
+// CouponCheck is the order a coupon is checked against.
+type CouponCheck struct {
+	UserID        uuid.UUID
+	Product       Product
+	SubtotalCents int64 // see SubtotalCents
+}
+
+// CheckCoupon returns the coupon behind code and the discount in cents it
+// would give the order, without using it up.
+func (store *SQLStore) CheckCoupon(ctx context.Context, code string, arg CouponCheck) (Coupon, int64, error) {
+	coupon, err := store.GetCouponByCode(ctx, code)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return Coupon{}, 0, fmt.Errorf("%w: unknown code", ErrInvalidCoupon)
+		}
+		return Coupon{}, 0, err
+	}
+
+	discount, err := couponDiscount(ctx, store.Queries, coupon, arg)
+	if err != nil {
+		return Coupon{}, 0, err
+	}
+
+	return coupon, discount, nil
+}
+
+// couponDiscount checks the validity window, usage limits, scope and minimum
+// order value of a coupon and returns the discount in cents.
+func couponDiscount(ctx context.Context, q *Queries, coupon Coupon, arg CouponCheck) (int64, error) {
+	now := time.Now().UTC()
+	if now.Before(coupon.ValidFrom) {
+		return 0, fmt.Errorf("%w: coupon is not active yet", ErrInvalidCoupon)
+	}
+	if coupon.ValidTo.Valid && !now.Before(coupon.ValidTo.Time) {
+		return 0, fmt.Errorf("%w: coupon has expired", ErrInvalidCoupon)
+	}
+	if coupon.MaxUses.Valid && coupon.UsedCount >= coupon.MaxUses.Int32 {
+		return 0, fmt.Errorf("%w: coupon usage limit reached", ErrInvalidCoupon)
+	}
+
+	applies := false
+	switch coupon.Scope {
+	case "seller":
+		applies = arg.Product.CreatedBy.Valid && arg.Product.CreatedBy.UUID == coupon.ScopeID
+	case "product":
+		applies = arg.Product.ID == coupon.ScopeID
+	case "category":
+		inCategory, err := q.IsCategoryDescendant(ctx, IsCategoryDescendantParams{
+			AncestorID: coupon.ScopeID,
+			CategoryID: arg.Product.CategoryID,
+		})
+		if err != nil {
+			return 0, fmt.Errorf("failed to check coupon category: %v", err)
+		}
+		applies = inCategory
+	}
+	if !applies {
+		return 0, fmt.Errorf("%w: coupon does not apply to this product", ErrInvalidCoupon)
+	}
+
+	minOrderValue, err := strconv.ParseFloat(coupon.MinOrderValue, 64)
+	if err != nil {
+		return 0, fmt.Errorf("invalid minimum order value: %v", err)
+	}
+	if arg.SubtotalCents < toCents(minOrderValue) {
+		return 0, fmt.Errorf("%w: order must be at least %.2f", ErrInvalidCoupon, minOrderValue)
+	}
+
+	if coupon.MaxUsesPerUser.Valid {
+		uses, err := q.CountUserCouponUses(ctx, CountUserCouponUsesParams{
+			CouponID: coupon.ID,
+			UserID:   uuid.NullUUID{UUID: arg.UserID, Valid: true},
+		})
+		if err != nil {
+			return 0, fmt.Errorf("failed to count coupon uses: %v", err)
+		}
+		if uses >= int64(coupon.MaxUsesPerUser.Int32) {
+			return 0, fmt.Errorf("%w: you have already used this coupon", ErrInvalidCoupon)
+		}
+	}
+
+	amount, err := strconv.ParseFloat(coupon.Amount, 64)
+	if err != nil {
+		return 0, fmt.Errorf("invalid coupon amount: %v", err)
+	}
+
+	discount := toCents(amount)
+	if coupon.DiscountType == "percentage" {
+		discount = int64(math.Round(float64(arg.SubtotalCents) * amount / 100))
+	}
+	discount = min(discount, arg.SubtotalCents)
+	if discount <= 0 {
+		return 0, fmt.Errorf("%w: coupon gives no discount on this order", ErrInvalidCoupon)
+	}
+
+	return discount, nil
+}
+
+const (
+	ProductKindPhysical = "physical"
+	ProductKindBundle   = "bundle"
+	ProductKindDigital  = "digital"
+)
+
+// ErrInvalidBundle wraps the reasons a bundle's components are rejected.
+var ErrInvalidBundle = errors.New("invalid bundle")
+
+// BundleItemParams is one component of a new bundle.
+type BundleItemParams struct {
+	ComponentID uuid.UUID
+	Quantity    int32
+}
+
+// CreateBundleTx creates a bundle product made of items. Components must be
+// physical products of the same seller.
+func (store *SQLStore) CreateBundleTx(ctx context.Context, arg CreateProductParams, items []BundleItemParams) (Product, error) {
+	var result Product
+
+	err := store.execTx(ctx, func(q *Queries) error {
+		var err error
+		result, err = createBundle(ctx, q, arg, items)
+		return err
+	})
+
+	return result, err
+}
+
+func createBundle(ctx context.Context, q *Queries, arg CreateProductParams, items []BundleItemParams) (Product, error) {
+	arg.Kind = ProductKindBundle
+	arg.Stock = 0
+
+	product, err := createProductWithHistory(ctx, q, arg, StockReasonInitial)
+	if err != nil {
+		return Product{}, err
+	}
+
+	for _, item := range items {
+		component, err := q.GetProductByID(ctx, item.ComponentID)
+		if err != nil {
+			if err == sql.ErrNoRows {
+				return Product{}, fmt.Errorf("%w: component %s not found", ErrInvalidBundle, item.ComponentID)
+			}
+			return Product{}, err
+		}
+		if component.Kind != ProductKindPhysical {
+			return Product{}, fmt.Errorf("%w: bundles cannot contain other bundles", ErrInvalidBundle)
+		}
+		if component.CreatedBy != arg.CreatedBy {
+			return Product{}, fmt.Errorf("%w: components must be your own products", ErrInvalidBundle)
+		}
+
+		err = q.CreateBundleItem(ctx, CreateBundleItemParams{
+			BundleID:    product.ID,
+			ComponentID: item.ComponentID,
+			Quantity:    item.Quantity,
+		})
+		if err != nil {
+			return Product{}, fmt.Errorf("failed to add bundle component: %v", err)
+		}
+	}
+
+	return product, nil
+}
+
+// moveProductStock applies arg to a product. For a bundle arg.Delta counts
+// bundles and every component moves by its quantity per bundle instead.
+// Components are handled in id order so concurrent orders lock rows in the
+// same sequence. Digital products have no stock and are left untouched.
+// Cancelling a bundle order restocks components deleted since, like a
+// single product, so one deleted component doesn't block the cancellation.
+func moveProductStock(ctx context.Context, q *Queries, product Product, arg StockMovementParams) error {
+	if product.Kind == ProductKindDigital {
+		return nil
+	}
+	if product.Kind != ProductKindBundle {
+		_, _, err := applyStockMovement(ctx, q, arg)
+		return err
+	}
+
+	items, err := q.ListBundleItems(ctx, product.ID)
+	if err != nil {
+		return err
+	}
+	if len(items) == 0 {
+		return fmt.Errorf("bundle has no components")
+	}
+
+	for _, item := range items {
+		movement := arg
+		movement.ProductID = item.ComponentID
+		movement.Delta = arg.Delta * item.Quantity
+		if _, _, err := applyStockMovement(ctx, q, movement); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+const (
+	ModerationActionSubmitted = "submitted"
+	ModerationActionApproved  = "approved"
+	ModerationActionRejected  = "rejected"
+)
+
+// ModerationParams is one moderation decision. ModeratorID is empty for the
+// automated pre-screen.
+type ModerationParams struct {
+	ProductID   uuid.UUID
+	Status      string
+	ModeratorID uuid.NullUUID
+	Action      string
+	Reason      string
+}
+
+// ModerateProductTx moves a product to arg.Status and records the decision in
+// the moderation log. Approvals and rejections are sent to the seller.
+func (store *SQLStore) ModerateProductTx(ctx context.Context, arg ModerationParams) (Product, error) {
+	var result Product
+
+	err := store.execTx(ctx, func(q *Queries) error {
+		product, err := q.GetProductForUpdate(ctx, arg.ProductID)
+		if err != nil {
+			return err
+		}
+
+		if product.Status != arg.Status {
+			product, err = q.UpdateProductStatus(ctx, UpdateProductStatusParams{
+				ID:     product.ID,
+				Status: arg.Status,
+			})
+			if err != nil {
+				return err
+			}
+		}
+
+		_, err = q.CreateModerationLog(ctx, CreateModerationLogParams{
+			ProductID:   product.ID,
+			ModeratorID: arg.ModeratorID,
+			Action:      arg.Action,
+			Reason:      arg.Reason,
+		})
+		if err != nil {
+			return fmt.Errorf("failed to record moderation decision: %v", err)
+		}
+
+		if arg.Action != ModerationActionSubmitted {
+			notification := CreateNotificationParams{
+				UserID:    product.CreatedBy.UUID,
+				Kind:      "moderation",
+				Title:     "Listing approved",
+				Body:      fmt.Sprintf("%s is now live", product.Name),
+				ProductID: uuid.NullUUID{UUID: product.ID, Valid: true},
+				SendEmail: true,
+			}
+			if arg.Action == ModerationActionRejected {
+				notification.Title = "Listing rejected"
+				notification.Body = fmt.Sprintf("%s was not published: %s", product.Name, arg.Reason)
+			}
+			if _, err := q.CreateNotification(ctx, notification); err != nil {
+				return fmt.Errorf("failed to notify seller: %v", err)
+			}
+		}
+
+		result = product
+		return nil
+	})
+
+	return result, err
+}
+
+// FuzzySearchResult is one page of trigram matches and their total count.
+type FuzzySearchResult struct {
+	Rows  []FuzzySearchProductsRow
+	Total int64
+}
+
+// FuzzySearchTx finds products whose name is similar to the query by at
+// least threshold (0 to 1) and that full-text search missed.
+func (store *SQLStore) FuzzySearchTx(ctx context.Context, threshold float64, arg FuzzySearchProductsParams) (FuzzySearchResult, error) {
+	var result FuzzySearchResult
+
+	err := store.execTx(ctx, func(q *Queries) error {
+		err := q.SetSimilarityThreshold(ctx, strconv.FormatFloat(threshold, 'f', -1, 64))
+		if err != nil {
+			return err
+		}
+
+		result.Total, err = q.CountFuzzySearchProducts(ctx, CountFuzzySearchProductsParams{
+			Language: arg.Language,
+			Query:    arg.Query,
+		})
+		if err != nil || result.Total == 0 {
+			return err
+		}
+
+		result.Rows, err = q.FuzzySearchProducts(ctx, arg)
+		return err
+	})
+
+	return result, err
+}
+
+// FuzzyMatchProductsTx returns the products whose name is closest to query,
+// used to complete a misspelled prefix.
+func (store *SQLStore) FuzzyMatchProductsTx(ctx context.Context, threshold float64, arg FuzzyMatchProductsParams) ([]Product, error) {
+	var result []Product
+
+	err := store.execTx(ctx, func(q *Queries) error {
+		err := q.SetSimilarityThreshold(ctx, strconv.FormatFloat(threshold, 'f', -1, 64))
+		if err != nil {
+			return err
+		}
+
+		result, err = q.FuzzyMatchProducts(ctx, arg)
+		return err
+	})
+
+	return result, err
+}
+
+// SpellingSuggestionsTx maps every term that is not in the product vocabulary
+// to the closest word that is. Terms without a close enough word are left out.
+func (store *SQLStore) SpellingSuggestionsTx(ctx context.Context, threshold float64, terms []string) (map[string]string, error) {
+	result := map[string]string{}
+
+	err := store.execTx(ctx, func(q *Queries) error {
+		err := q.SetSimilarityThreshold(ctx, strconv.FormatFloat(threshold, 'f', -1, 64))
+		if err != nil {
+			return err
+		}
+
+		for _, term := range terms {
+			if _, ok := result[term]; ok {
+				continue
+			}
+
+			known, err := q.SearchTermExists(ctx, term)
+			if err != nil {
+				return err
+			}
+			if known {
+				continue
+			}
+
+			word, err := q.SimilarSearchTerm(ctx, term)
+			if errors.Is(err, sql.ErrNoRows) {
+				continue
+			}
+			if err != nil {
+				return err
+			}
+			result[term] = word
+		}
+		return nil
+	})
+
+	return result, err
+}
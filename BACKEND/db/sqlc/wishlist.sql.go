@@ -0,0 +1,128 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.30.0
+// source: wishlist.sql
+
+package db
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/google/uuid"
+)
+
+const addToWishlist = `-- name: AddToWishlist :one
+INSERT INTO wishlist_items (user_id, product_id)
+VALUES ($1, $2)
+ON CONFLICT (user_id, product_id) DO UPDATE SET user_id = EXCLUDED.user_id
+RETURNING id, user_id, product_id, created_at
+`
+
+type AddToWishlistParams struct {
+	UserID    uuid.UUID `db:"user_id" json:"user_id"`
+	ProductID uuid.UUID `db:"product_id" json:"product_id"`
+}
+
+func (q *Queries) AddToWishlist(ctx context.Context, arg AddToWishlistParams) (WishlistItem, error) {
+	row := q.db.QueryRowContext(ctx, addToWishlist, arg.UserID, arg.ProductID)
+	var i WishlistItem
+	err := row.Scan(
+		&i.ID,
+		&i.UserID,
+		&i.ProductID,
+		&i.CreatedAt,
+	)
+	return i, err
+}
+
+const listWishlist = `-- name: ListWishlist :many
+SELECT w.id, w.user_id, w.product_id, w.created_at, p.id, p.name, p.description, p.price, p.stock, p.product_url, p.category, p.type, p.created_by, p.created_at, p.status, p.deleted_at, p.category_id, p.rating_average, p.rating_count, p.version, p.low_stock_threshold, p.kind
+FROM wishlist_items w
+JOIN products p ON p.id = w.product_id
+WHERE w.user_id = $1 AND p.deleted_at IS NULL
+  AND ($2::timestamp IS NULL
+       OR (w.created_at, w.id) < ($2::timestamp, $3::uuid))
+ORDER BY w.created_at DESC, w.id DESC
+LIMIT $4
+`
+
+type ListWishlistParams struct {
+	UserID         uuid.UUID     `db:"user_id" json:"user_id"`
+	AfterCreatedAt sql.NullTime  `db:"after_created_at" json:"after_created_at"`
+	AfterID        uuid.NullUUID `db:"after_id" json:"after_id"`
+	LimitCount     int32         `db:"limit_count" json:"limit_count"`
+}
+
+type ListWishlistRow struct {
+	WishlistItem WishlistItem `db:"wishlist_item" json:"wishlist_item"`
+	Product      Product      `db:"product" json:"product"`
+}
+
+func (q *Queries) ListWishlist(ctx context.Context, arg ListWishlistParams) ([]ListWishlistRow, error) {
+	rows, err := q.db.QueryContext(ctx, listWishlist,
+		arg.UserID,
+		arg.AfterCreatedAt,
+		arg.AfterID,
+		arg.LimitCount,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []ListWishlistRow{}
+	for rows.Next() {
+		var i ListWishlistRow
+		if err := rows.Scan(
+			&i.WishlistItem.ID,
+			&i.WishlistItem.UserID,
+			&i.WishlistItem.ProductID,
+			&i.WishlistItem.CreatedAt,
+			&i.Product.ID,
+			&i.Product.Name,
+			&i.Product.Description,
+			&i.Product.Price,
+			&i.Product.Stock,
+			&i.Product.ProductUrl,
+			&i.Product.Category,
+			&i.Product.Type,
+			&i.Product.CreatedBy,
+			&i.Product.CreatedAt,
+			&i.Product.Status,
+			&i.Product.DeletedAt,
+			&i.Product.CategoryID,
+			&i.Product.RatingAverage,
+			&i.Product.RatingCount,
+			&i.Product.Version,
+			&i.Product.LowStockThreshold,
+			&i.Product.Kind,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const removeFromWishlist = `-- name: RemoveFromWishlist :execrows
+DELETE FROM wishlist_items WHERE user_id = $1 AND product_id = $2
+`
+
+type RemoveFromWishlistParams struct {
+	UserID    uuid.UUID `db:"user_id" json:"user_id"`
+	ProductID uuid.UUID `db:"product_id" json:"product_id"`
+}
+
+func (q *Queries) RemoveFromWishlist(ctx context.Context, arg RemoveFromWishlistParams) (int64, error) {
+	result, err := q.db.ExecContext(ctx, removeFromWishlist, arg.UserID, arg.ProductID)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected()
+}
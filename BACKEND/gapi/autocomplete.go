@@ -3,36 +3,36 @@ package gapi
 import (
 	"context"
 
+	"github.com/siddheshRajendraNimbalkar/collage-prject-backend/internal/search"
 	"github.com/siddheshRajendraNimbalkar/collage-prject-backend/pb"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 )
 
 func (server *Server) AutocompleteSearch(ctx context.Context, req *pb.AutocompleteRequest) (*pb.AutocompleteResponse, error) {
-	query := req.GetQuery()
-	if len(query) < 1 {
-		return &pb.AutocompleteResponse{Items: []*pb.ProductSuggestion{}}, nil
+	limit := int(req.GetLimit())
+	if limit <= 0 || limit > 50 {
+		limit = 8
 	}
 
-	// Get products from database that match the query
-	products, err := server.store.GetProductByName(ctx, query)
+	suggestions, err := server.searcher.Autocomplete(ctx, req.GetQuery(), limit, 0)
 	if err != nil {
-		// Return empty results if error
-		return &pb.AutocompleteResponse{Items: []*pb.ProductSuggestion{}}, nil
+		return nil, status.Errorf(codes.Unavailable, "autocomplete is unavailable: %v", err)
 	}
 
-	// Convert to suggestions with images
-	pbResults := make([]*pb.ProductSuggestion, 0, len(products))
-	for _, product := range products {
-		if len(pbResults) >= 8 { // Limit to 8 results
-			break
-		}
+	pbResults := make([]*pb.ProductSuggestion, 0, len(suggestions))
+	for _, suggestion := range suggestions {
 		pbResults = append(pbResults, &pb.ProductSuggestion{
-			Id:       product.ID.String(),
-			Name:     product.Name,
-			ImageUrl: product.ProductUrl,
-			Category: product.Category,
-			Type:     product.Type,
+			Id:       suggestion.ID,
+			Name:     suggestion.Name,
+			ImageUrl: suggestion.Image,
+			Category: suggestion.Category,
+			Type:     suggestion.Type,
 		})
 	}
 
-	return &pb.AutocompleteResponse{Items: pbResults}, nil
-}
\ No newline at end of file
+	return &pb.AutocompleteResponse{
+		Items:    pbResults,
+		SearchId: server.analytics.LogQuery(ctx, search.SourceAutocomplete, req.GetQuery(), len(suggestions)),
+	}, nil
+}
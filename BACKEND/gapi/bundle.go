@@ -0,0 +1,108 @@
+package gapi
+
+import (
+	"context"
+	"log"
+	"math"
+
+	"github.com/google/uuid"
+	db "github.com/siddheshRajendraNimbalkar/collage-prject-backend/db/sqlc"
+	"github.com/siddheshRajendraNimbalkar/collage-prject-backend/pb"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// enrichProducts adds what convertProduct can't know from the products row
+// alone: running sales and bundle components.
+func (server *Server) enrichProducts(ctx context.Context, products ...*pb.Product) {
+	server.withSalePrices(ctx, products...)
+	server.withBundleComponents(ctx, products...)
+}
+
+// productResponse converts and enriches a single product.
+func (server *Server) productResponse(ctx context.Context, product db.Product) *pb.ProductResponse {
+	resp := &pb.ProductResponse{Product: convertProduct(product)}
+	server.enrichProducts(ctx, resp.Product)
+	return resp
+}
+
+// withBundleComponents lists the components of bundles and sets their stock
+// to the number of complete bundles the component stock allows.
+func (server *Server) withBundleComponents(ctx context.Context, products ...*pb.Product) {
+	ids := []uuid.UUID{}
+	for _, product := range products {
+		if product.GetKind() != db.ProductKindBundle {
+			continue
+		}
+		if id, err := uuid.Parse(product.GetId()); err == nil {
+			ids = append(ids, id)
+		}
+	}
+	if len(ids) == 0 {
+		return
+	}
+
+	components, err := server.store.ListBundleComponents(ctx, ids)
+	if err != nil {
+		log.Printf("failed to load bundle components: %v", err)
+		return
+	}
+
+	byBundle := make(map[string][]db.ListBundleComponentsRow, len(ids))
+	for _, component := range components {
+		bundleID := component.BundleID.String()
+		byBundle[bundleID] = append(byBundle[bundleID], component)
+	}
+
+	for _, product := range products {
+		if product.GetKind() != db.ProductKindBundle {
+			continue
+		}
+
+		available := int32(-1)
+		product.BundleItems = []*pb.BundleItem{}
+		for _, component := range byBundle[product.GetId()] {
+			product.BundleItems = append(product.BundleItems, &pb.BundleItem{
+				ProductId: component.ComponentID.String(),
+				Quantity:  component.Quantity,
+				Name:      component.Name,
+			})
+			if bundles := component.Stock / component.Quantity; available < 0 || bundles < available {
+				available = bundles
+			}
+		}
+		if available < 0 {
+			available = 0
+		}
+		product.Stock = available
+	}
+}
+
+func parseBundleItems(items []*pb.BundleItem) ([]db.BundleItemParams, error) {
+	params := []db.BundleItemParams{}
+	for _, item := range items {
+		componentID, err := uuid.Parse(item.GetProductId())
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid bundle component ID format")
+		}
+		params = append(params, db.BundleItemParams{
+			ComponentID: componentID,
+			Quantity:    item.GetQuantity(),
+		})
+	}
+	return params, nil
+}
+
+// availableStock is how many units of product a buyer can order right now.
+// Digital products never run out.
+func (server *Server) availableStock(ctx context.Context, product db.Product) int32 {
+	if product.Kind == db.ProductKindDigital {
+		return math.MaxInt32
+	}
+	if product.Kind != db.ProductKindBundle {
+		return product.Stock
+	}
+	pbProduct := convertProduct(product)
+	server.withBundleComponents(ctx, pbProduct)
+	return pbProduct.GetStock()
+}
@@ -0,0 +1,265 @@
+package gapi
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+
+	"github.com/google/uuid"
+	"github.com/lib/pq"
+	db "github.com/siddheshRajendraNimbalkar/collage-prject-backend/db/sqlc"
+	"github.com/siddheshRajendraNimbalkar/collage-prject-backend/pb"
+	"github.com/siddheshRajendraNimbalkar/collage-prject-backend/util"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func convertCategory(category db.Category) *pb.Category {
+	parentID := ""
+	if category.ParentID.Valid {
+		parentID = category.ParentID.UUID.String()
+	}
+	return &pb.Category{
+		Id:        category.ID.String(),
+		ParentId:  parentID,
+		Slug:      category.Slug,
+		Name:      category.Name,
+		Icon:      category.Icon,
+		CreatedAt: category.CreatedAt.Time.Format("2006-01-02 15:04:05"),
+	}
+}
+
+func isUniqueViolation(err error) bool {
+	var pqErr *pq.Error
+	return errors.As(err, &pqErr) && pqErr.Code.Name() == "unique_violation"
+}
+
+func isForeignKeyViolation(err error) bool {
+	var pqErr *pq.Error
+	return errors.As(err, &pqErr) && pqErr.Code.Name() == "foreign_key_violation"
+}
+
+func parseOptionalUUID(id string) (uuid.NullUUID, error) {
+	if id == "" {
+		return uuid.NullUUID{}, nil
+	}
+	parsed, err := uuid.Parse(id)
+	if err != nil {
+		return uuid.NullUUID{}, err
+	}
+	return uuid.NullUUID{UUID: parsed, Valid: true}, nil
+}
+
+// resolveProductCategory finds the category a product belongs to, either by
+// id or by the legacy category/type slugs, and returns the root and leaf of
+// its branch. Unknown categories are rejected instead of being created.
+func (server *Server) resolveProductCategory(ctx context.Context, categoryID, category, productType string) (db.Category, db.Category, error) {
+	if categoryID != "" {
+		id, err := uuid.Parse(categoryID)
+		if err != nil {
+			return db.Category{}, db.Category{}, status.Errorf(codes.InvalidArgument, "invalid category ID format")
+		}
+
+		leaf, err := server.store.GetCategoryByID(ctx, id)
+		if err != nil {
+			if err == sql.ErrNoRows {
+				return db.Category{}, db.Category{}, status.Errorf(codes.InvalidArgument, "unknown category")
+			}
+			return db.Category{}, db.Category{}, status.Errorf(codes.Internal, "failed to fetch category: %v", err)
+		}
+
+		root := leaf
+		for root.ParentID.Valid {
+			root, err = server.store.GetCategoryByID(ctx, root.ParentID.UUID)
+			if err != nil {
+				return db.Category{}, db.Category{}, status.Errorf(codes.Internal, "failed to fetch parent category: %v", err)
+			}
+		}
+
+		return root, leaf, nil
+	}
+
+	root, err := server.store.GetRootCategoryBySlug(ctx, util.Slugify(category))
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return db.Category{}, db.Category{}, status.Errorf(codes.InvalidArgument, "unknown category %q", category)
+		}
+		return db.Category{}, db.Category{}, status.Errorf(codes.Internal, "failed to fetch category: %v", err)
+	}
+
+	leaf, err := server.store.GetChildCategoryBySlug(ctx, db.GetChildCategoryBySlugParams{
+		ParentID: uuid.NullUUID{UUID: root.ID, Valid: true},
+		Slug:     util.Slugify(productType),
+	})
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return db.Category{}, db.Category{}, status.Errorf(codes.InvalidArgument, "unknown type %q in category %q", productType, category)
+		}
+		return db.Category{}, db.Category{}, status.Errorf(codes.Internal, "failed to fetch category: %v", err)
+	}
+
+	return root, leaf, nil
+}
+
+func (server *Server) CreateCategory(ctx context.Context, req *pb.CreateCategoryRequest) (*pb.CategoryResponse, error) {
+	if _, err := server.AdminInterceptor(ctx); err != nil {
+		return nil, status.Errorf(codes.PermissionDenied, "%v", err)
+	}
+
+	if err := util.ValidateCreateCategoryInput(req); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid category details: %v", err)
+	}
+
+	parentID, err := parseOptionalUUID(req.GetParentId())
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid parent ID format")
+	}
+
+	if parentID.Valid {
+		if _, err := server.store.GetCategoryByID(ctx, parentID.UUID); err != nil {
+			if err == sql.ErrNoRows {
+				return nil, status.Errorf(codes.NotFound, "parent category not found")
+			}
+			return nil, status.Errorf(codes.Internal, "failed to fetch parent category: %v", err)
+		}
+	}
+
+	slug := req.GetSlug()
+	if slug == "" {
+		slug = util.Slugify(req.GetName())
+	}
+
+	category, err := server.store.CreateCategory(ctx, db.CreateCategoryParams{
+		ParentID: parentID,
+		Slug:     slug,
+		Name:     req.GetName(),
+		Icon:     req.GetIcon(),
+	})
+	if err != nil {
+		if isUniqueViolation(err) {
+			return nil, status.Errorf(codes.AlreadyExists, "category slug already used at this level")
+		}
+		return nil, status.Errorf(codes.Internal, "failed to create category: %v", err)
+	}
+
+	return &pb.CategoryResponse{Category: convertCategory(category)}, nil
+}
+
+func (server *Server) UpdateCategory(ctx context.Context, req *pb.UpdateCategoryRequest) (*pb.CategoryResponse, error) {
+	if _, err := server.AdminInterceptor(ctx); err != nil {
+		return nil, status.Errorf(codes.PermissionDenied, "%v", err)
+	}
+
+	if err := util.ValidateUpdateCategoryInput(req); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid category details: %v", err)
+	}
+
+	categoryID, err := uuid.Parse(req.GetId())
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid category ID format")
+	}
+
+	parentID, err := parseOptionalUUID(req.GetParentId())
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid parent ID format")
+	}
+
+	if _, err := server.store.GetCategoryByID(ctx, categoryID); err != nil {
+		if err == sql.ErrNoRows {
+			return nil, status.Errorf(codes.NotFound, "category not found")
+		}
+		return nil, status.Errorf(codes.Internal, "failed to fetch category: %v", err)
+	}
+
+	if parentID.Valid {
+		cycle, err := server.store.IsCategoryDescendant(ctx, db.IsCategoryDescendantParams{
+			AncestorID: categoryID,
+			CategoryID: parentID.UUID,
+		})
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to check category hierarchy: %v", err)
+		}
+		if cycle {
+			return nil, status.Errorf(codes.InvalidArgument, "category cannot be moved below one of its descendants")
+		}
+	}
+
+	slug := req.GetSlug()
+	if slug == "" {
+		slug = util.Slugify(req.GetName())
+	}
+
+	category, err := server.store.UpdateCategoryTx(ctx, db.UpdateCategoryParams{
+		ID:       categoryID,
+		ParentID: parentID,
+		Slug:     slug,
+		Name:     req.GetName(),
+		Icon:     req.GetIcon(),
+	})
+	if err != nil {
+		if isUniqueViolation(err) {
+			return nil, status.Errorf(codes.AlreadyExists, "category slug already used at this level")
+		}
+		return nil, status.Errorf(codes.Internal, "failed to update category: %v", err)
+	}
+
+	return &pb.CategoryResponse{Category: convertCategory(category)}, nil
+}
+
+func (server *Server) DeleteCategory(ctx context.Context, req *pb.DeleteCategoryRequest) (*pb.DeleteCategoryResponse, error) {
+	if _, err := server.AdminInterceptor(ctx); err != nil {
+		return nil, status.Errorf(codes.PermissionDenied, "%v", err)
+	}
+
+	categoryID, err := uuid.Parse(req.GetId())
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid category ID format")
+	}
+
+	children, err := server.store.CountCategoryChildren(ctx, uuid.NullUUID{UUID: categoryID, Valid: true})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to check subcategories: %v", err)
+	}
+	if children > 0 {
+		return nil, status.Errorf(codes.FailedPrecondition, "category still has subcategories")
+	}
+
+	products, err := server.store.CountProductsInCategory(ctx, categoryID)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to check products: %v", err)
+	}
+	if products > 0 {
+		return nil, status.Errorf(codes.FailedPrecondition, "category still has products")
+	}
+
+	if err := server.store.DeleteCategory(ctx, categoryID); err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to delete category: %v", err)
+	}
+
+	return &pb.DeleteCategoryResponse{Message: "Category deleted successfully"}, nil
+}
+
+func (server *Server) GetCategoryTree(ctx context.Context, req *pb.GetCategoryTreeRequest) (*pb.GetCategoryTreeResponse, error) {
+	categories, err := server.store.ListCategories(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to list categories: %v", err)
+	}
+
+	nodes := make(map[uuid.UUID]*pb.CategoryNode, len(categories))
+	for _, category := range categories {
+		nodes[category.ID] = &pb.CategoryNode{Category: convertCategory(category)}
+	}
+
+	roots := []*pb.CategoryNode{}
+	for _, category := range categories {
+		node := nodes[category.ID]
+		parent, ok := nodes[category.ParentID.UUID]
+		if !category.ParentID.Valid || !ok {
+			roots = append(roots, node)
+			continue
+		}
+		parent.Children = append(parent.Children, node)
+	}
+
+	return &pb.GetCategoryTreeResponse{Roots: roots}, nil
+}
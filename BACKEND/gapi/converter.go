@@ -0,0 +1,37 @@
+package gapi
+
+import (
+	db "github.com/siddheshRajendraNimbalkar/collage-prject-backend/db/sqlc"
+	"github.com/siddheshRajendraNimbalkar/collage-prject-backend/pb"
+)
+
+func convertProduct(product db.Product) *pb.Product {
+	return &pb.Product{
+		Id:                product.ID.String(),
+		Name:              product.Name,
+		Description:       product.Description,
+		Price:             parseFloat(product.Price),
+		Stock:             product.Stock,
+		CreatedBy:         product.CreatedBy.UUID.String(),
+		CreatedAt:         product.CreatedAt.Time.Format("2006-01-02 15:04:05"),
+		ProductUrl:        product.ProductUrl,
+		Category:          product.Category,
+		Type:              product.Type,
+		Status:            product.Status,
+		CategoryId:        product.CategoryID.String(),
+		RatingAverage:     parseFloat(product.RatingAverage),
+		RatingCount:       product.RatingCount,
+		Version:           product.Version,
+		LowStockThreshold: product.LowStockThreshold,
+		EffectivePrice:    parseFloat(product.Price),
+		Kind:              product.Kind,
+	}
+}
+
+func convertProducts(products []db.Product) []*pb.Product {
+	productResponses := []*pb.Product{}
+	for _, product := range products {
+		productResponses = append(productResponses, convertProduct(product))
+	}
+	return productResponses
+}
@@ -0,0 +1,195 @@
+package gapi
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	db "github.com/siddheshRajendraNimbalkar/collage-prject-backend/db/sqlc"
+	"github.com/siddheshRajendraNimbalkar/collage-prject-backend/pb"
+	"github.com/siddheshRajendraNimbalkar/collage-prject-backend/util"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func convertCoupon(coupon db.Coupon) *pb.Coupon {
+	endsAt := ""
+	if coupon.ValidTo.Valid {
+		endsAt = coupon.ValidTo.Time.Format("2006-01-02 15:04:05")
+	}
+	return &pb.Coupon{
+		Id:             coupon.ID.String(),
+		Code:           coupon.Code,
+		DiscountType:   coupon.DiscountType,
+		Amount:         parseFloat(coupon.Amount),
+		MinOrderValue:  parseFloat(coupon.MinOrderValue),
+		MaxUses:        coupon.MaxUses.Int32,
+		MaxUsesPerUser: coupon.MaxUsesPerUser.Int32,
+		UsedCount:      coupon.UsedCount,
+		StartsAt:       coupon.ValidFrom.Format("2006-01-02 15:04:05"),
+		EndsAt:         endsAt,
+		Scope:          coupon.Scope,
+		ScopeId:        coupon.ScopeID.String(),
+		CreatedAt:      coupon.CreatedAt.Time.Format("2006-01-02 15:04:05"),
+	}
+}
+
+func optionalLimit(limit int32) sql.NullInt32 {
+	return sql.NullInt32{Int32: limit, Valid: limit > 0}
+}
+
+// CreateCoupon lets sellers create coupons for themselves or one of their
+// products. Category coupons span sellers, so only admins may create them.
+func (server *Server) CreateCoupon(ctx context.Context, req *pb.CreateCouponRequest) (*pb.CouponResponse, error) {
+	if err := util.ValidateCreateCouponInput(req); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid coupon: %v", err)
+	}
+
+	token, err := server.AuthInterceptor(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "Error in Auth Token: %v", err)
+	}
+
+	user, err := server.store.GetUserByID(ctx, token.ID)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, status.Errorf(codes.NotFound, "user not found")
+		}
+		return nil, status.Errorf(codes.Internal, "failed to verify user: %v", err)
+	}
+	isAdmin := user.Role == "admin"
+
+	scopeID := token.ID
+	if req.GetScopeId() != "" {
+		scopeID, err = uuid.Parse(req.GetScopeId())
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid scope ID format")
+		}
+	}
+
+	switch req.GetScope() {
+	case "seller":
+		if scopeID != token.ID && !isAdmin {
+			return nil, status.Errorf(codes.PermissionDenied, "you can only create coupons for your own products")
+		}
+	case "product":
+		product, err := server.store.GetProductByID(ctx, scopeID)
+		if err != nil {
+			if err == sql.ErrNoRows {
+				return nil, status.Errorf(codes.NotFound, "product not found")
+			}
+			return nil, status.Errorf(codes.Internal, "failed to fetch product: %v", err)
+		}
+		if product.CreatedBy.UUID != token.ID && !isAdmin {
+			return nil, status.Errorf(codes.PermissionDenied, "you can only create coupons for your own products")
+		}
+	case "category":
+		if !isAdmin {
+			return nil, status.Errorf(codes.PermissionDenied, "admin role required for category coupons")
+		}
+		if _, err := server.store.GetCategoryByID(ctx, scopeID); err != nil {
+			if err == sql.ErrNoRows {
+				return nil, status.Errorf(codes.NotFound, "category not found")
+			}
+			return nil, status.Errorf(codes.Internal, "failed to fetch category: %v", err)
+		}
+	}
+
+	startsAt := time.Now().UTC().Truncate(time.Second)
+	if req.GetStartsAt() != "" {
+		startsAt, err = parseTimestamp(req.GetStartsAt())
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid start time: %v", err)
+		}
+	}
+
+	endsAt := sql.NullTime{}
+	if req.GetEndsAt() != "" {
+		end, err := parseTimestamp(req.GetEndsAt())
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid end time: %v", err)
+		}
+		if !end.After(startsAt) {
+			return nil, status.Errorf(codes.InvalidArgument, "coupon must end after it starts")
+		}
+		endsAt = sql.NullTime{Time: end, Valid: true}
+	}
+
+	coupon, err := server.store.CreateCoupon(ctx, db.CreateCouponParams{
+		Code:           util.NormalizeCouponCode(req.GetCode()),
+		DiscountType:   req.GetDiscountType(),
+		Amount:         fmt.Sprintf("%.2f", req.GetAmount()),
+		MinOrderValue:  fmt.Sprintf("%.2f", req.GetMinOrderValue()),
+		MaxUses:        optionalLimit(req.GetMaxUses()),
+		MaxUsesPerUser: optionalLimit(req.GetMaxUsesPerUser()),
+		ValidFrom:      startsAt,
+		ValidTo:        endsAt,
+		Scope:          req.GetScope(),
+		ScopeID:        scopeID,
+		CreatedBy:      uuid.NullUUID{UUID: token.ID, Valid: true},
+	})
+	if err != nil {
+		if isUniqueViolation(err) {
+			return nil, status.Errorf(codes.AlreadyExists, "coupon code already exists")
+		}
+		return nil, status.Errorf(codes.Internal, "failed to create coupon: %v", err)
+	}
+
+	return &pb.CouponResponse{Coupon: convertCoupon(coupon)}, nil
+}
+
+// ValidateCoupon previews the discount a code gives on an order without
+// using it. CreateOrder checks the coupon again when it is redeemed.
+func (server *Server) ValidateCoupon(ctx context.Context, req *pb.ValidateCouponRequest) (*pb.ValidateCouponResponse, error) {
+	if err := util.ValidateValidateCouponInput(req); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid request: %v", err)
+	}
+
+	token, err := server.AuthInterceptor(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "Error in Auth Token: %v", err)
+	}
+
+	productID, err := uuid.Parse(req.GetProductId())
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid product ID format")
+	}
+
+	product, err := server.store.GetProductByID(ctx, productID)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, status.Errorf(codes.NotFound, "product not found")
+		}
+		return nil, status.Errorf(codes.Internal, "failed to fetch product: %v", err)
+	}
+	if product.Status != productStatusPublished {
+		return nil, status.Errorf(codes.NotFound, "product not found")
+	}
+
+	// Price the order the same way CreateOrder does, sale included
+	pbProduct := convertProduct(product)
+	server.withSalePrices(ctx, pbProduct)
+	subtotalCents := db.SubtotalCents(pbProduct.GetEffectivePrice(), req.GetQuantity())
+
+	coupon, discountCents, err := server.store.CheckCoupon(ctx, util.NormalizeCouponCode(req.GetCode()), db.CouponCheck{
+		UserID:        token.ID,
+		Product:       product,
+		SubtotalCents: subtotalCents,
+	})
+	if err != nil {
+		if errors.Is(err, db.ErrInvalidCoupon) {
+			return nil, status.Errorf(codes.FailedPrecondition, "%v", err)
+		}
+		return nil, status.Errorf(codes.Internal, "failed to check coupon: %v", err)
+	}
+
+	return &pb.ValidateCouponResponse{
+		Coupon:   convertCoupon(coupon),
+		Subtotal: float64(subtotalCents) / 100,
+		Discount: float64(discountCents) / 100,
+		Total:    float64(subtotalCents-discountCents) / 100,
+	}, nil
+}
@@ -6,6 +6,7 @@ import (
 
 	"github.com/google/uuid"
 	db "github.com/siddheshRajendraNimbalkar/collage-prject-backend/db/sqlc"
+	"github.com/siddheshRajendraNimbalkar/collage-prject-backend/internal/pagination"
 	"github.com/siddheshRajendraNimbalkar/collage-prject-backend/pb"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
@@ -33,12 +34,12 @@ func (server *Server) AddToCart(ctx context.Context, req *pb.AddToCartRequest) (
 
 	// Fetch product details
 	product, err := server.store.GetProductByID(ctx, productID)
-	if err != nil {
+	if err != nil || product.Status != productStatusPublished {
 		return nil, status.Errorf(codes.NotFound, "product not found")
 	}
 
 	// Check stock availability
-	if product.Stock < req.GetQuantity() {
+	if server.availableStock(ctx, product) < req.GetQuantity() {
 		return nil, status.Errorf(codes.FailedPrecondition, "insufficient stock")
 	}
 
@@ -63,10 +64,25 @@ func (server *Server) GetCartByUser(ctx context.Context, req *pb.GetCartRequest)
 		return nil, status.Errorf(codes.InvalidArgument, "Error in Auth Token: %v", err)
 	}
 
-	cartItems, err := server.store.GetCartByUserID(ctx, uuid.NullUUID{UUID: token.ID, Valid: true})
+	const scope = "cart"
+	limit := pagination.PageSize(req.GetLimit())
+	after, err := pagination.Decode(scope, req.GetPageToken())
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "%v", err)
+	}
+
+	cartItems, err := server.store.GetCartByUserID(ctx, db.GetCartByUserIDParams{
+		UserID:         uuid.NullUUID{UUID: token.ID, Valid: true},
+		AfterCreatedAt: after.CreatedAt,
+		AfterID:        after.ID,
+		LimitCount:     limit + 1,
+	})
 	if err != nil {
 		return nil, status.Errorf(codes.Internal, "failed to fetch cart items")
 	}
+	cartItems, nextPageToken := pagination.Page(scope, cartItems, limit, func(item db.Cart) (sql.NullTime, uuid.UUID) {
+		return item.CreatedAt, item.ID
+	})
 
 	var pbCartItems []*pb.CartItem
 	for _, item := range cartItems {
@@ -79,7 +95,7 @@ func (server *Server) GetCartByUser(ctx context.Context, req *pb.GetCartRequest)
 		})
 	}
 
-	return &pb.CartListResponse{Items: pbCartItems}, nil
+	return &pb.CartListResponse{Items: pbCartItems, NextPageToken: nextPageToken}, nil
 }
 
 func (server *Server) UpdateCartQuantity(ctx context.Context, req *pb.UpdateCartQuantityRequest) (*pb.CartResponse, error) {
@@ -120,7 +136,7 @@ func (server *Server) UpdateCartQuantity(ctx context.Context, req *pb.UpdateCart
 	}
 
 	// Check stock availability
-	if product.Stock < req.GetQuantity() {
+	if server.availableStock(ctx, product) < req.GetQuantity() {
 		return nil, status.Errorf(codes.FailedPrecondition, "insufficient stock")
 	}
 
@@ -3,10 +3,13 @@ package gapi
 import (
 	"context"
 	"database/sql"
+	"errors"
 
 	"github.com/google/uuid"
 	db "github.com/siddheshRajendraNimbalkar/collage-prject-backend/db/sqlc"
+	"github.com/siddheshRajendraNimbalkar/collage-prject-backend/internal/pagination"
 	"github.com/siddheshRajendraNimbalkar/collage-prject-backend/pb"
+	"github.com/siddheshRajendraNimbalkar/collage-prject-backend/util"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 )
@@ -17,16 +20,20 @@ func (server *Server) CreateOrder(ctx context.Context, req *pb.CreateOrderReques
 		return nil, status.Errorf(codes.InvalidArgument, "Error in Auth Token: %v", err)
 	}
 	myOrder := &pb.CreateOrderRequest{
-		UserId:    token.ID.String(),
-		ProductId: req.ProductId,
-		Quantity:  req.Quantity,
+		UserId:     token.ID.String(),
+		ProductId:  req.ProductId,
+		Quantity:   req.Quantity,
+		CouponCode: util.NormalizeCouponCode(req.GetCouponCode()),
 	}
 	result, err := server.store.OrderTx(ctx, myOrder)
 	if err != nil {
+		if errors.Is(err, db.ErrInvalidCoupon) {
+			return nil, status.Errorf(codes.FailedPrecondition, "%v", err)
+		}
 		if err.Error() == "user not found" || err.Error() == "product not found" {
 			return nil, status.Errorf(codes.Internal, "%v", err)
 		}
-		if err.Error() == "insufficient stock" {
+		if err.Error() == "insufficient stock" || err.Error() == "product is not available" {
 			return nil, status.Errorf(codes.FailedPrecondition, "%v", err)
 		}
 		return nil, status.Errorf(codes.Internal, "failed to create order: %v", err)
@@ -54,12 +61,13 @@ func (server *Server) GetOrderByID(ctx context.Context, req *pb.GetOrderRequest)
 
 	resp := &pb.OrderResponse{
 		Order: &pb.Order{
-			Id:         order.ID.String(),
-			UserId:     order.UserID.UUID.String(),
-			ProductId:  order.ProductID.UUID.String(),
-			TotalPrice: parseFloat(order.TotalPrice),
-			Status:     order.Status.String,
-			CreatedAt:  order.CreatedAt.Time.Format("2006-01-02 15:04:05"),
+			Id:            order.ID.String(),
+			UserId:        order.UserID.UUID.String(),
+			ProductId:     order.ProductID.UUID.String(),
+			TotalPrice:    parseFloat(order.TotalPrice),
+			Status:        order.Status.String,
+			CreatedAt:     order.CreatedAt.Time.Format("2006-01-02 15:04:05"),
+			DiscountTotal: parseFloat(order.DiscountTotal),
 		},
 	}
 
@@ -77,63 +85,157 @@ func (server *Server) ListOrders(ctx context.Context, req *pb.ListOrdersByUserRe
 	if err != nil {
 		return nil, status.Errorf(codes.InvalidArgument, "invalid user ID format")
 	}
-	orders, err := server.store.GetOrdersByUser(ctx, uuid.NullUUID{UUID: userID, Valid: true})
+
+	const scope = "orders"
+	limit := pagination.PageSize(req.GetLimit())
+	after, err := pagination.Decode(scope, req.GetPageToken())
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "%v", err)
+	}
+
+	orders, err := server.store.GetOrdersByUser(ctx, db.GetOrdersByUserParams{
+		UserID:         uuid.NullUUID{UUID: userID, Valid: true},
+		AfterCreatedAt: after.CreatedAt,
+		AfterID:        after.ID,
+		LimitCount:     limit + 1,
+	})
 	if err != nil {
 		return nil, status.Errorf(codes.Internal, "failed to list orders: %v", err)
 	}
+	orders, nextPageToken := pagination.Page(scope, orders, limit, func(order db.Order) (sql.NullTime, uuid.UUID) {
+		return order.CreatedAt, order.ID
+	})
 
 	orderResponses := []*pb.Order{}
 	for _, order := range orders {
 		orderResponses = append(orderResponses, &pb.Order{
-			Id:         order.ID.String(),
-			UserId:     order.UserID.UUID.String(),
-			ProductId:  order.ProductID.UUID.String(),
-			TotalPrice: parseFloat(order.TotalPrice),
-			Status:     order.Status.String,
-			CreatedAt:  order.CreatedAt.Time.Format("2006-01-02 15:04:05"),
+			Id:            order.ID.String(),
+			UserId:        order.UserID.UUID.String(),
+			ProductId:     order.ProductID.UUID.String(),
+			TotalPrice:    parseFloat(order.TotalPrice),
+			Status:        order.Status.String,
+			CreatedAt:     order.CreatedAt.Time.Format("2006-01-02 15:04:05"),
+			DiscountTotal: parseFloat(order.DiscountTotal),
 		})
 	}
 
-	return &pb.ListOrdersResponse{Orders: orderResponses}, nil
+	return &pb.ListOrdersResponse{Orders: orderResponses, NextPageToken: nextPageToken}, nil
 }
 
-// UpdateOrderStatus - Updates the status of an order
+// orderRole is who a caller is to an order.
+type orderRole int
+
+const (
+	orderBuyer orderRole = 1 << iota
+	orderSeller
+	orderAdmin
+)
+
+// authorizeOrder loads an order for a caller who has one of the allowed
+// roles. Completed orders unlock reviews and downloads, so only the parties
+// to an order may change it.
+func (server *Server) authorizeOrder(ctx context.Context, id string, allowed orderRole) (db.Order, error) {
+	token, err := server.AuthInterceptor(ctx)
+	if err != nil {
+		return db.Order{}, status.Errorf(codes.InvalidArgument, "Error in Auth Token: %v", err)
+	}
+
+	if id == "" {
+		return db.Order{}, status.Errorf(codes.InvalidArgument, "order ID is required")
+	}
+	orderID, err := uuid.Parse(id)
+	if err != nil {
+		return db.Order{}, status.Errorf(codes.InvalidArgument, "invalid order ID format")
+	}
+
+	order, err := server.store.GetOrderByID(ctx, orderID)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return db.Order{}, status.Errorf(codes.NotFound, "order not found")
+		}
+		return db.Order{}, status.Errorf(codes.Internal, "failed to fetch order: %v", err)
+	}
+
+	if allowed&orderBuyer != 0 && order.UserID.Valid && order.UserID.UUID == token.ID {
+		return order, nil
+	}
+
+	if allowed&orderSeller != 0 && order.ProductID.Valid {
+		product, err := server.store.GetProductByIDIncludingDeleted(ctx, order.ProductID.UUID)
+		if err != nil && err != sql.ErrNoRows {
+			return db.Order{}, status.Errorf(codes.Internal, "failed to fetch product: %v", err)
+		}
+		if err == nil && product.CreatedBy.Valid && product.CreatedBy.UUID == token.ID {
+			return order, nil
+		}
+	}
+
+	if allowed&orderAdmin != 0 {
+		user, err := server.store.GetUserByID(ctx, token.ID)
+		if err != nil && err != sql.ErrNoRows {
+			return db.Order{}, status.Errorf(codes.Internal, "failed to verify user: %v", err)
+		}
+		if err == nil && user.Role == "admin" {
+			return order, nil
+		}
+	}
+
+	return db.Order{}, status.Errorf(codes.PermissionDenied, "not allowed to change this order")
+}
+
+// UpdateOrderStatus - Completes a pending order, only its seller or an admin
+// can. Completed orders unlock reviews and digital downloads.
 func (server *Server) UpdateOrderStatus(ctx context.Context, req *pb.UpdateOrderStatusRequest) (*pb.OrderResponse, error) {
 	if req.GetId() == "" || req.GetStatus() == "" {
 		return nil, status.Errorf(codes.InvalidArgument, "order ID and status are required")
 	}
+	if req.GetStatus() != "completed" {
+		return nil, status.Errorf(codes.InvalidArgument, `status can only be set to "completed", cancel orders with DeleteOrder`)
+	}
 
-	orderID, err := uuid.Parse(req.GetId())
+	current, err := server.authorizeOrder(ctx, req.GetId(), orderSeller|orderAdmin)
 	if err != nil {
-		return nil, status.Errorf(codes.InvalidArgument, "invalid order ID format")
+		return nil, err
 	}
 
 	order, err := server.store.UpdateOrderStatus(ctx, db.UpdateOrderStatusParams{
-		ID:     orderID,
+		ID:     current.ID,
 		Status: sql.NullString{String: req.GetStatus(), Valid: true},
 	})
 	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, status.Errorf(codes.FailedPrecondition, "only pending orders can be completed")
+		}
 		return nil, status.Errorf(codes.Internal, "failed to update order status: %v", err)
 	}
 
 	resp := &pb.OrderResponse{
 		Order: &pb.Order{
-			Id:         order.ID.String(),
-			UserId:     order.UserID.UUID.String(),
-			ProductId:  order.ProductID.UUID.String(),
-			TotalPrice: parseFloat(order.TotalPrice),
-			Status:     order.Status.String,
-			CreatedAt:  order.CreatedAt.Time.Format("2006-01-02 15:04:05"),
+			Id:            order.ID.String(),
+			UserId:        order.UserID.UUID.String(),
+			ProductId:     order.ProductID.UUID.String(),
+			TotalPrice:    parseFloat(order.TotalPrice),
+			Status:        order.Status.String,
+			CreatedAt:     order.CreatedAt.Time.Format("2006-01-02 15:04:05"),
+			DiscountTotal: parseFloat(order.DiscountTotal),
 		},
 	}
 
 	return resp, nil
 }
 
+// DeleteOrder cancels a pending order and restocks it, only its buyer or
+// the seller can.
 func (server *Server) DeleteOrder(ctx context.Context, req *pb.DeleteOrderRequest) (*pb.DeleteOrderResponse, error) {
+	if _, err := server.authorizeOrder(ctx, req.GetId(), orderBuyer|orderSeller); err != nil {
+		return nil, err
+	}
 
 	response, err := server.store.DeleteOrderTx(ctx, req)
 	if err != nil {
+		if err.Error() == "order not found or no longer pending" {
+			return nil, status.Errorf(codes.FailedPrecondition, "%v", err)
+		}
 		return nil, status.Errorf(codes.Internal, "failed to delete order: %v", err)
 	}
 	return response, nil
@@ -3,6 +3,7 @@ package gapi
 import (
 	"context"
 	"database/sql"
+	"errors"
 	"fmt"
 	"log"
 	"strconv"
@@ -10,7 +11,7 @@ import (
 
 	"github.com/google/uuid"
 	db "github.com/siddheshRajendraNimbalkar/collage-prject-backend/db/sqlc"
-	redisClient "github.com/siddheshRajendraNimbalkar/collage-prject-backend/internal/redis"
+	"github.com/siddheshRajendraNimbalkar/collage-prject-backend/internal/pagination"
 	"github.com/siddheshRajendraNimbalkar/collage-prject-backend/pb"
 	"github.com/siddheshRajendraNimbalkar/collage-prject-backend/util"
 	"google.golang.org/grpc/codes"
@@ -48,47 +49,58 @@ func (server *Server) CreateProduct(ctx context.Context, req *pb.CreateProductRe
 		return nil, status.Errorf(codes.Internal, "failed to verify user: %v", err)
 	}
 
-	productParams := db.CreateProductParams{
-		Name:        req.GetName(),
-		Description: req.GetDescription(),
-		Price:       fmt.Sprintf("%.2f", req.Price),
-		CreatedBy:   uuid.NullUUID{UUID: token.ID, Valid: true},
-		Stock:       req.GetStock(),
-		ProductUrl:  req.GetProductUrl(),
-		Category:    strings.ToLower(req.GetCategory()),
-		Type:        strings.ToLower(req.GetType()),
-	}
-
-	product, err := server.store.CreateProduct(ctx, productParams)
+	rootCategory, category, err := server.resolveProductCategory(ctx, req.GetCategoryId(), req.GetCategory(), req.GetType())
 	if err != nil {
-		return nil, status.Errorf(codes.Internal, "failed to create product: %v", err)
+		return nil, err
 	}
 
-	// Save to Redis for advanced autocomplete AFTER successful DB commit
-	log.Printf("Attempting to index product: ID=%s, Name=%s, Category=%s, Type=%s", product.ID.String(), product.Name, product.Category, product.Type)
-	if err := redisClient.IndexProduct(product.ID.String(), product.Name, product.Category, product.Type, product.ProductUrl); err != nil {
-		log.Printf("CRITICAL: Failed to index product in Redis: %v", err)
-		// Don't fail the request, but log the error prominently
+	productParams := db.CreateProductParams{
+		Name:              req.GetName(),
+		Description:       req.GetDescription(),
+		Price:             fmt.Sprintf("%.2f", req.Price),
+		CreatedBy:         uuid.NullUUID{UUID: token.ID, Valid: true},
+		Stock:             req.GetStock(),
+		ProductUrl:        req.GetProductUrl(),
+		Category:          rootCategory.Slug,
+		Type:              category.Slug,
+		Status:            server.initialProductStatus(req.GetStatus()),
+		CategoryID:        category.ID,
+		LowStockThreshold: req.GetLowStockThreshold(),
+		Kind:              req.GetKind(),
+	}
+
+	var product db.Product
+	if req.GetKind() == db.ProductKindBundle {
+		items, err := parseBundleItems(req.GetBundleItems())
+		if err != nil {
+			return nil, err
+		}
+		product, err = server.store.CreateBundleTx(ctx, productParams, items)
+		if err != nil {
+			if errors.Is(err, db.ErrInvalidBundle) {
+				return nil, status.Errorf(codes.InvalidArgument, "%v", err)
+			}
+			return nil, status.Errorf(codes.Internal, "failed to create product: %v", err)
+		}
 	} else {
-		log.Printf("SUCCESS: Indexed product in Redis: %s", product.Name)
+		product, err = server.store.CreateProductTx(ctx, productParams, db.StockReasonInitial)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to create product: %v", err)
+		}
 	}
 
+	if product.Status == productStatusPendingReview {
+		product, err = server.submitForReview(ctx, product)
+		if err != nil {
+			return nil, err
+		}
+	}
 
-
+	// The search index picks the product up from the outbox once it is published
 	resp := &pb.ProductResponse{
-		Product: &pb.Product{
-			Id:          product.ID.String(),
-			Name:        product.Name,
-			Description: product.Description,
-			Price:       parseFloat(product.Price),
-			CreatedBy:   product.CreatedBy.UUID.String(),
-			CreatedAt:   product.CreatedAt.Time.Format("2006-01-02 15:04:05"),
-			ProductUrl:  product.ProductUrl,
-			Category:    product.Category,
-			Type:        product.Type,
-			Stock:       product.Stock,
-		},
+		Product: convertProduct(product),
 	}
+	server.enrichProducts(ctx, resp.Product)
 
 	return resp, nil
 }
@@ -121,19 +133,9 @@ func (server *Server) GetProductByID(ctx context.Context, req *pb.GetProductRequ
 	}
 
 	resp := &pb.ProductResponse{
-		Product: &pb.Product{
-			Id:          product.ID.String(),
-			Name:        product.Name,
-			Description: product.Description,
-			Price:       parseFloat(product.Price),
-			CreatedBy:   product.CreatedBy.UUID.String(),
-			CreatedAt:   product.CreatedAt.Time.Format("2006-01-02 15:04:05"),
-			ProductUrl:  product.ProductUrl,
-			Category:    product.Category,
-			Type:        product.Type,
-			Stock:       product.Stock,
-		},
+		Product: convertProduct(product),
 	}
+	server.enrichProducts(ctx, resp.Product)
 
 	return resp, nil
 }
@@ -149,6 +151,10 @@ func (server *Server) UpdateProduct(ctx context.Context, req *pb.UpdateProductRe
 		return nil, status.Errorf(codes.InvalidArgument, "invalid product ID format")
 	}
 
+	if err := util.ValidateUpdateProductMask(req.GetUpdateMask().GetPaths()); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid update mask: %v", err)
+	}
+
 	product, err := server.store.GetProductByID(ctx, productID)
 	if err != nil {
 		if err == sql.ErrNoRows {
@@ -157,156 +163,191 @@ func (server *Server) UpdateProduct(ctx context.Context, req *pb.UpdateProductRe
 		return nil, status.Errorf(codes.Internal, "failed to fetch product: %v", err)
 	}
 
-	if err := util.ValidateUpdateProductInput(req); err != nil {
+	if product.CreatedBy.UUID != token.ID {
+		return nil, status.Errorf(codes.InvalidArgument, "Only Product Creator can change product data")
+	}
+
+	if req.GetVersion() != 0 && req.GetVersion() != product.Version {
+		return nil, status.Errorf(codes.FailedPrecondition, "product has changed since version %d (now %d), reload it and retry", req.GetVersion(), product.Version)
+	}
+
+	update := mergeProductUpdate(product, req)
+
+	// Bundles derive their stock from components and digital products have
+	// none, keep the stored zero
+	if product.Kind != db.ProductKindPhysical {
+		update.Stock = product.Stock
+	}
+	if err := util.ValidateUpdateProductInput(update); err != nil {
 		return nil, status.Errorf(codes.InvalidArgument, "invalid input: %v", err)
 	}
 
-	if product.CreatedBy.UUID != token.ID {
-		return nil, status.Errorf(codes.InvalidArgument, "Only Product Creator can change product data")
+	rootCategory, category, err := server.resolveProductCategory(ctx, update.GetCategoryId(), update.GetCategory(), update.GetType())
+	if err != nil {
+		return nil, err
 	}
 
 	updateParams := db.UpdateProductParams{
 		ID:          productID,
-		Name:        req.GetName(),
-		Description: req.GetDescription(),
-		Price:       fmt.Sprintf("%.2f", req.GetPrice()),
-		Stock:       req.GetStock(),
-		ProductUrl:  req.GetProductUrl(),
-		Category:    strings.ToLower(req.GetCategory()),
-		Type:        strings.ToLower(req.GetType()),
-	}
-
-	updatedProduct, err := server.store.UpdateProduct(ctx, updateParams)
+		Name:        update.GetName(),
+		Description: update.GetDescription(),
+		Price:       fmt.Sprintf("%.2f", update.GetPrice()),
+		Stock:       update.GetStock(),
+		ProductUrl:  update.GetProductUrl(),
+		Category:    rootCategory.Slug,
+		Type:        category.Slug,
+		CategoryID:  category.ID,
+		Version:     product.Version,
+	}
+
+	updatedProduct, err := server.store.UpdateProductTx(ctx, updateParams, token.ID)
 	if err != nil {
+		// The version moved between our read and the conditional update
+		if err == sql.ErrNoRows {
+			return nil, status.Errorf(codes.Aborted, "product was changed by another request, reload it and retry")
+		}
 		return nil, status.Errorf(codes.Internal, "failed to update product: %v", err)
 	}
 
+	// A live listing that now reads differently goes back to the moderators
+	if server.config.ModerationEnabled && product.Status == productStatusPublished && isMaterialEdit(product, updatedProduct) {
+		updatedProduct, err = server.submitForReview(ctx, updatedProduct)
+		if err != nil {
+			return nil, err
+		}
+	}
 
+	return server.productResponse(ctx, updatedProduct), nil
+}
 
-	resp := &pb.ProductResponse{
-		Product: &pb.Product{
-			Id:          updatedProduct.ID.String(),
-			Name:        updatedProduct.Name,
-			Description: updatedProduct.Description,
-			Price:       parseFloat(updatedProduct.Price),
-			CreatedBy:   product.CreatedBy.UUID.String(),
-			CreatedAt:   product.CreatedAt.Time.Format("2006-01-02 15:04:05"),
-			ProductUrl:  updatedProduct.ProductUrl,
-			Category:    updatedProduct.Category,
-			Type:        updatedProduct.Type,
-			Stock:       updatedProduct.Stock,
-		},
+// mergeProductUpdate applies the masked fields of req on top of the stored
+// product. Without a mask every field of req is used, as before masks existed.
+func mergeProductUpdate(product db.Product, req *pb.UpdateProductRequest) *pb.UpdateProductRequest {
+	paths := req.GetUpdateMask().GetPaths()
+	if len(paths) == 0 {
+		return req
+	}
+
+	update := &pb.UpdateProductRequest{
+		Id:          req.GetId(),
+		Name:        product.Name,
+		Description: product.Description,
+		Price:       parseFloat(product.Price),
+		Stock:       product.Stock,
+		ProductUrl:  product.ProductUrl,
+		Category:    product.Category,
+		Type:        product.Type,
+		CategoryId:  product.CategoryID.String(),
+	}
+
+	categoryChanged := false
+	for _, path := range paths {
+		switch path {
+		case "name":
+			update.Name = req.GetName()
+		case "description":
+			update.Description = req.GetDescription()
+		case "price":
+			update.Price = req.GetPrice()
+		case "stock":
+			update.Stock = req.GetStock()
+		case "product_url":
+			update.ProductUrl = req.GetProductUrl()
+		case "category":
+			update.Category = req.GetCategory()
+			categoryChanged = true
+		case "type":
+			update.Type = req.GetType()
+			categoryChanged = true
+		case "category_id":
+			update.CategoryId = req.GetCategoryId()
+			categoryChanged = true
+		}
 	}
 
-	return resp, nil
+	// category_id wins over category/type, so the stored id must not hide
+	// a category or type sent in the request
+	if categoryChanged {
+		update.CategoryId = req.GetCategoryId()
+	}
+
+	return update
 }
 
 func (server *Server) ListProducts(ctx context.Context, req *pb.ListAllProductsRequest) (*pb.ListProductsResponse, error) {
-	limit := req.GetLimit()
-	if limit <= 0 {
-		limit = 10
-	}
-
-	offset := req.GetOffset()
-	if offset < 0 {
-		offset = 0
+	const scope = "products"
+	limit := pagination.PageSize(req.GetLimit())
+	after, err := pagination.Decode(scope, req.GetPageToken())
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "%v", err)
 	}
 
 	products, err := server.store.GetAllProducts(ctx, db.GetAllProductsParams{
-		Limit:  int32(limit),
-		Offset: int32(offset),
+		AfterCreatedAt: after.CreatedAt,
+		AfterID:        after.ID,
+		LimitCount:     limit + 1,
 	})
 	if err != nil {
 		return nil, status.Errorf(codes.Internal, "failed to list products: %v", err)
 	}
-
-	productResponses := []*pb.Product{}
-	for _, product := range products {
-		productResponses = append(productResponses, &pb.Product{
-			Id:          product.ID.String(),
-			Name:        product.Name,
-			ProductUrl:  product.ProductUrl,
-			Category:    product.Category,
-			Type:        product.Type,
-			Description: product.Description,
-			Price:       parseFloat(product.Price),
-			CreatedBy:   product.CreatedBy.UUID.String(),
-			CreatedAt:   product.CreatedAt.Time.Format("2006-01-02 15:04:05"),
-			Stock:       product.Stock,
-		})
-	}
+	products, nextPageToken := pagination.Page(scope, products, limit, productKey)
 
 	resp := &pb.ListProductsResponse{
-		Products: productResponses,
+		Products:      convertProducts(products),
+		NextPageToken: nextPageToken,
 	}
+	server.enrichProducts(ctx, resp.Products...)
 
 	return resp, nil
 }
 
 func (server *Server) ListProductsByName(ctx context.Context, req *pb.ListAllProductsByNameRequest) (*pb.ListAllProductsByNameResponse, error) {
-	if len(strings.TrimSpace(req.GetName())) < 1 {
+	name := strings.TrimSpace(req.GetName())
+	if name == "" {
 		return &pb.ListAllProductsByNameResponse{Products: []*pb.Product{}}, nil
 	}
-	// Use partial matching - try exact match first, then LIKE pattern
-	query := strings.TrimSpace(req.GetName())
-	log.Printf("Searching for products with name: '%s'", query)
-	
-	// First try exact match
-	products, err := server.store.GetProductByName(ctx, query)
-	if err != nil || len(products) == 0 {
-		// Try with LIKE pattern
-		searchPattern := "%" + strings.ToLower(query) + "%"
-		log.Printf("Trying LIKE pattern: '%s'", searchPattern)
-		products, err = server.store.GetProductByName(ctx, searchPattern)
-	}
-	
-	log.Printf("Found %d products", len(products))
-	
-	// If still no results, get some recent products as fallback
-	if err != nil || len(products) == 0 {
-		log.Println("No products found, getting recent products as fallback")
-		allProducts, fallbackErr := server.store.GetAllProducts(ctx, db.GetAllProductsParams{
-			Limit:  5,
-			Offset: 0,
-		})
-		if fallbackErr == nil {
-			products = []db.Product{}
-			for _, p := range allProducts {
-				products = append(products, db.Product{
-					ID:          p.ID,
-					Name:        p.Name,
-					Description: p.Description,
-					Price:       p.Price,
-					CreatedBy:   p.CreatedBy,
-					CreatedAt:   p.CreatedAt,
-					ProductUrl:  p.ProductUrl,
-					Category:    p.Category,
-					Type:        p.Type,
-					Stock:       p.Stock,
-				})
-			}
-		}
+
+	scope := "products:name:" + strings.ToLower(name)
+	limit := pagination.PageSize(req.GetLimit())
+	after, err := pagination.Decode(scope, req.GetPageToken())
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "%v", err)
+	}
+
+	rows, err := server.store.ListProductsByName(ctx, db.ListProductsByNameParams{
+		Name:           name,
+		AfterKey:       after.Key,
+		AfterCreatedAt: after.CreatedAt,
+		AfterID:        after.ID,
+		LimitCount:     limit + 1,
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to list products: %v", err)
+	}
+	rows, nextPageToken := pagination.PageByKey(scope, rows, limit, func(row db.ListProductsByNameRow) (int64, sql.NullTime, uuid.UUID) {
+		return row.SortKey, row.Product.CreatedAt, row.Product.ID
+	})
+
+	products := make([]db.Product, 0, len(rows))
+	for _, row := range rows {
+		products = append(products, row.Product)
 	}
 
-	productResponses := []*pb.Product{}
-	for _, product := range products {
-		productResponses = append(productResponses, &pb.Product{
-			Id:          product.ID.String(),
-			Name:        product.Name,
-			Description: product.Description,
-			Price:       parseFloat(product.Price),
-			CreatedBy:   product.CreatedBy.UUID.String(),
-			ProductUrl:  product.ProductUrl,
-			Category:    product.Category,
-			Type:        product.Type,
-			CreatedAt:   product.CreatedAt.Time.Format("2006-01-02 15:04:05"),
-			Stock:       product.Stock,
+	// Without any match the first page falls back to some recent products
+	if len(products) == 0 && req.GetPageToken() == "" {
+		products, err = server.store.GetAllProducts(ctx, db.GetAllProductsParams{
+			LimitCount: 5,
 		})
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to list products: %v", err)
+		}
 	}
 
 	resp := &pb.ListAllProductsByNameResponse{
-		Products: productResponses,
+		Products:      convertProducts(products),
+		NextPageToken: nextPageToken,
 	}
+	server.enrichProducts(ctx, resp.Products...)
 
 	return resp, nil
 }
@@ -316,31 +357,31 @@ func (server *Server) GetProductByUserID(ctx context.Context, req *pb.ListAllPro
 	if err != nil {
 		return nil, status.Errorf(codes.InvalidArgument, "Error in Auth Token: %v", err)
 	}
-	products, err := server.store.GetProductByUserID(ctx, uuid.NullUUID{UUID: token.ID, Valid: true})
 
+	const scope = "products:creator"
+	limit := pagination.PageSize(req.GetLimit())
+	after, err := pagination.Decode(scope, req.GetPageToken())
 	if err != nil {
-		return nil, status.Errorf(codes.Internal, "failed to grt products: %v", err)
+		return nil, status.Errorf(codes.InvalidArgument, "%v", err)
 	}
 
-	productResponses := []*pb.Product{}
-	for _, product := range products {
-		productResponses = append(productResponses, &pb.Product{
-			Id:          product.ID.String(),
-			Name:        product.Name,
-			Description: product.Description,
-			Price:       parseFloat(product.Price),
-			CreatedBy:   product.CreatedBy.UUID.String(),
-			ProductUrl:  product.ProductUrl,
-			Category:    product.Category,
-			Type:        product.Type,
-			CreatedAt:   product.CreatedAt.Time.Format("2006-01-02 15:04:05"),
-			Stock:       product.Stock,
-		})
+	products, err := server.store.ListProductsByCreator(ctx, db.ListProductsByCreatorParams{
+		CreatedBy:      uuid.NullUUID{UUID: token.ID, Valid: true},
+		AfterCreatedAt: after.CreatedAt,
+		AfterID:        after.ID,
+		LimitCount:     limit + 1,
+	})
+
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to grt products: %v", err)
 	}
+	products, nextPageToken := pagination.Page(scope, products, limit, productKey)
 
 	resp := &pb.ListAllProductsByNameResponse{
-		Products: productResponses,
+		Products:      convertProducts(products),
+		NextPageToken: nextPageToken,
 	}
+	server.enrichProducts(ctx, resp.Products...)
 
 	return resp, nil
 }
@@ -363,87 +404,90 @@ func (server *Server) GetOnlyProductRequest(ctx context.Context, req *pb.GetProd
 		return nil, status.Errorf(codes.Internal, "failed to fetch product: %v", err)
 	}
 
+	if product.Status != productStatusPublished {
+		return nil, status.Errorf(codes.NotFound, "product not found")
+	}
+
+	// Views rank autocomplete suggestions, a lost one doesn't fail the request
+	if err := server.store.RecordProductView(ctx, productID); err != nil {
+		log.Printf("failed to record product view: %v", err)
+	}
+
 	resp := &pb.ProductResponse{
-		Product: &pb.Product{
-			Id:          product.ID.String(),
-			Name:        product.Name,
-			Description: product.Description,
-			Price:       parseFloat(product.Price),
-			CreatedBy:   product.CreatedBy.UUID.String(),
-			CreatedAt:   product.CreatedAt.Time.Format("2006-01-02 15:04:05"),
-			ProductUrl:  product.ProductUrl,
-			Category:    product.Category,
-			Type:        product.Type,
-			Stock:       product.Stock,
-		},
+		Product: convertProduct(product),
 	}
+	server.enrichProducts(ctx, resp.Product)
 
 	return resp, nil
 }
 
 func (server *Server) ListProductsByCategory(ctx context.Context, req *pb.ListAllProductsByCategoryRequest) (*pb.ListAllProductsByCategoryResponse, error) {
 
-	products, err := server.store.ListProductsByCategory(ctx, strings.ToLower(req.GetCategory()))
-	if err != nil {
-		return nil, status.Errorf(codes.Internal, "failed to list products: %v", err)
-	}
-
-	productResponses := []*pb.Product{}
-
-	for _, product := range products {
-		productResponses = append(productResponses, &pb.Product{
-			Id:          product.ID.String(),
-			Name:        product.Name,
-			Description: product.Description,
-			Price:       parseFloat(product.Price),
-			CreatedBy:   product.CreatedBy.UUID.String(),
-			ProductUrl:  product.ProductUrl,
-			Category:    product.Category,
-			Type:        product.Type,
-			CreatedAt:   product.CreatedAt.Time.Format("2006-01-02 15:04:05"),
-			Stock:       product.Stock,
-		})
-	}
-
-	resp := &pb.ListAllProductsByCategoryResponse{
-		Products: productResponses,
+	var categoryID uuid.UUID
+	if req.GetCategoryId() != "" {
+		id, err := uuid.Parse(req.GetCategoryId())
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid category ID format")
+		}
+		categoryID = id
+	} else {
+		category, err := server.store.GetRootCategoryBySlug(ctx, util.Slugify(req.GetCategory()))
+		if err != nil {
+			if err == sql.ErrNoRows {
+				return &pb.ListAllProductsByCategoryResponse{Products: []*pb.Product{}}, nil
+			}
+			return nil, status.Errorf(codes.Internal, "failed to fetch category: %v", err)
+		}
+		categoryID = category.ID
 	}
 
-	return resp, nil
+	// Listing a parent category includes every descendant category
+	return server.listCategoryProducts(ctx, categoryID, req.GetLimit(), req.GetPageToken())
 }
 
 func (server *Server) ListProductsByType(ctx context.Context, req *pb.ListAllProductsByTypeRequest) (*pb.ListAllProductsByCategoryResponse, error) {
 
-	data := db.ListProductsByTypeParams{
-		Category: strings.ToLower(req.GetCategory()),
-		Type:     strings.ToLower(req.GetType()),
+	_, category, err := server.resolveProductCategory(ctx, "", req.GetCategory(), req.GetType())
+	if err != nil {
+		if status.Code(err) == codes.InvalidArgument {
+			return &pb.ListAllProductsByCategoryResponse{Products: []*pb.Product{}}, nil
+		}
+		return nil, err
 	}
 
-	products, err := server.store.ListProductsByType(ctx, data)
+	return server.listCategoryProducts(ctx, category.ID, req.GetLimit(), req.GetPageToken())
+}
+
+// listCategoryProducts pages through a category and its descendants. Tokens
+// are scoped to the category so one can't be reused for another.
+func (server *Server) listCategoryProducts(ctx context.Context, categoryID uuid.UUID, pageSize int32, pageToken string) (*pb.ListAllProductsByCategoryResponse, error) {
+	scope := "products:category:" + categoryID.String()
+	limit := pagination.PageSize(pageSize)
+	after, err := pagination.Decode(scope, pageToken)
 	if err != nil {
-		return nil, status.Errorf(codes.Internal, "failed to list products: %v", err)
+		return nil, status.Errorf(codes.InvalidArgument, "%v", err)
 	}
 
-	productResponses := []*pb.Product{}
-
-	for _, product := range products {
-		productResponses = append(productResponses, &pb.Product{
-			Id:          product.ID.String(),
-			Name:        product.Name,
-			Description: product.Description,
-			Price:       parseFloat(product.Price),
-			CreatedBy:   product.CreatedBy.UUID.String(),
-			ProductUrl:  product.ProductUrl,
-			Category:    product.Category,
-			Type:        product.Type,
-			CreatedAt:   product.CreatedAt.Time.Format("2006-01-02 15:04:05"),
-			Stock:       product.Stock,
-		})
+	products, err := server.store.ListProductsByCategory(ctx, db.ListProductsByCategoryParams{
+		CategoryID:     categoryID,
+		AfterCreatedAt: after.CreatedAt,
+		AfterID:        after.ID,
+		LimitCount:     limit + 1,
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to list products: %v", err)
 	}
+	products, nextPageToken := pagination.Page(scope, products, limit, productKey)
 
 	resp := &pb.ListAllProductsByCategoryResponse{
-		Products: productResponses,
+		Products:      convertProducts(products),
+		NextPageToken: nextPageToken,
 	}
+	server.enrichProducts(ctx, resp.Products...)
 
 	return resp, nil
 }
+
+func productKey(product db.Product) (sql.NullTime, uuid.UUID) {
+	return product.CreatedAt, product.ID
+}
@@ -3,10 +3,8 @@ package gapi
 import (
 	"context"
 	"database/sql"
-	"log"
 
 	"github.com/google/uuid"
-	redisClient "github.com/siddheshRajendraNimbalkar/collage-prject-backend/internal/redis"
 	"github.com/siddheshRajendraNimbalkar/collage-prject-backend/pb"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
@@ -35,19 +33,12 @@ func (server *Server) DeleteProduct(ctx context.Context, req *pb.DeleteProductRe
 		return nil, status.Errorf(codes.PermissionDenied, "Only product creator can delete this product")
 	}
 
-	err = server.store.DeleteProduct(ctx, productID)
+	// Soft delete keeps the row so orders and carts still resolve it
+	err = server.store.SoftDeleteProduct(ctx, productID)
 	if err != nil {
 		return nil, status.Errorf(codes.Internal, "failed to delete product: %v", err)
 	}
 
-	// Remove from Redis autocomplete index
-	log.Printf("Attempting to remove product %s from Redis autocomplete", product.ID.String())
-	if err := redisClient.RemoveProduct(product.ID.String()); err != nil {
-		log.Printf("FAILED to remove product from Redis: %v", err)
-	} else {
-		log.Printf("SUCCESS: Removed product %s from Redis autocomplete", product.ID.String())
-	}
-
 	resp := &pb.DeleteProductResponse{
 		Message: "Product deleted successfully",
 	}
@@ -0,0 +1,113 @@
+package gapi
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	db "github.com/siddheshRajendraNimbalkar/collage-prject-backend/db/sqlc"
+	"github.com/siddheshRajendraNimbalkar/collage-prject-backend/pb"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const defaultDownloadLinkExpiry = 15 * time.Minute
+
+func convertDigitalAsset(asset db.DigitalAsset) *pb.DigitalAsset {
+	return &pb.DigitalAsset{
+		Id:            asset.ID.String(),
+		ProductId:     asset.ProductID.String(),
+		FileName:      asset.FileName,
+		ContentType:   asset.ContentType,
+		SizeBytes:     asset.SizeBytes,
+		DownloadLimit: asset.DownloadLimit,
+		CreatedAt:     asset.CreatedAt.Time.Format("2006-01-02 15:04:05"),
+	}
+}
+
+// GetDownloadLink issues a signed, short lived link to the file of a digital
+// product the caller bought. Every order gets a fixed number of downloads,
+// asking for a new link does not reset it.
+func (server *Server) GetDownloadLink(ctx context.Context, req *pb.GetDownloadLinkRequest) (*pb.GetDownloadLinkResponse, error) {
+	token, err := server.AuthInterceptor(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "Error in Auth Token: %v", err)
+	}
+
+	orderID, err := uuid.Parse(req.GetOrderId())
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid order ID format")
+	}
+
+	order, err := server.store.GetOrderByID(ctx, orderID)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, status.Errorf(codes.NotFound, "order not found")
+		}
+		return nil, status.Errorf(codes.Internal, "failed to fetch order: %v", err)
+	}
+
+	if order.UserID.UUID != token.ID {
+		return nil, status.Errorf(codes.PermissionDenied, "Only the buyer can download this order")
+	}
+	if order.Status.String != "completed" {
+		return nil, status.Errorf(codes.FailedPrecondition, "downloads are available once the order is completed")
+	}
+
+	// Buyers keep their download even if the product was removed later
+	product, err := server.store.GetProductByIDIncludingDeleted(ctx, order.ProductID.UUID)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to fetch product: %v", err)
+	}
+	if product.Kind != db.ProductKindDigital {
+		return nil, status.Errorf(codes.FailedPrecondition, "only digital products can be downloaded")
+	}
+
+	asset, err := server.store.GetDigitalAssetByProductID(ctx, product.ID)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, status.Errorf(codes.NotFound, "the seller has not uploaded a file for this product")
+		}
+		return nil, status.Errorf(codes.Internal, "failed to fetch digital asset: %v", err)
+	}
+
+	grant, err := server.store.CreateDownloadGrant(ctx, db.CreateDownloadGrantParams{
+		OrderID:       order.ID,
+		AssetID:       asset.ID,
+		UserID:        token.ID,
+		DownloadLimit: asset.DownloadLimit,
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to create download: %v", err)
+	}
+
+	remaining := grant.DownloadLimit - grant.DownloadCount
+	if remaining <= 0 {
+		return nil, status.Errorf(codes.ResourceExhausted, "download limit of %d reached for this order", grant.DownloadLimit)
+	}
+
+	expiry, err := time.ParseDuration(server.config.DownloadLinkExpiresIn)
+	if err != nil || expiry <= 0 {
+		expiry = defaultDownloadLinkExpiry
+	}
+	expiresAt := time.Now().Add(expiry).Truncate(time.Second)
+
+	return &pb.GetDownloadLinkResponse{
+		Url:                server.downloadURL(grant.ID, expiresAt),
+		ExpiresAt:          expiresAt.UTC().Format("2006-01-02 15:04:05"),
+		FileName:           asset.FileName,
+		DownloadsRemaining: remaining,
+	}, nil
+}
+
+func (server *Server) downloadURL(grantID uuid.UUID, expiresAt time.Time) string {
+	query := url.Values{}
+	query.Set("grant", grantID.String())
+	query.Set("expires", fmt.Sprintf("%d", expiresAt.Unix()))
+	query.Set("signature", server.urlSigner.Sign(grantID.String(), expiresAt))
+	return strings.TrimSuffix(server.config.PublicURL, "/") + "/api/downloads?" + query.Encode()
+}
@@ -0,0 +1,211 @@
+package gapi
+
+import (
+	"database/sql"
+	"fmt"
+	"io"
+	"log"
+	"mime"
+	"net/http"
+	"path/filepath"
+	"strconv"
+	"time"
+
+	"github.com/google/uuid"
+	db "github.com/siddheshRajendraNimbalkar/collage-prject-backend/db/sqlc"
+	"github.com/siddheshRajendraNimbalkar/collage-prject-backend/token"
+	"github.com/siddheshRajendraNimbalkar/collage-prject-backend/util"
+	"google.golang.org/protobuf/encoding/protojson"
+)
+
+const (
+	maxAssetUploadBytes  = 100 << 20
+	defaultDownloadLimit = 5
+)
+
+// UploadDigitalAssetHandler stores the "file" field of a multipart form as
+// the asset of the digital product given by the product_id query parameter.
+// Uploading again replaces the file, downloads already granted keep their
+// count.
+func (server *Server) UploadDigitalAssetHandler(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+
+	ctx := httpAuthContext(r)
+	product, err := server.getOwnedProduct(ctx, r.URL.Query().Get("product_id"), false)
+	if err != nil {
+		writeHTTPError(w, err)
+		return
+	}
+	if product.Kind != db.ProductKindDigital {
+		http.Error(w, "only digital products have a downloadable file", http.StatusBadRequest)
+		return
+	}
+
+	downloadLimit := int64(defaultDownloadLimit)
+	if limit := r.URL.Query().Get("download_limit"); limit != "" {
+		downloadLimit, err = strconv.ParseInt(limit, 10, 32)
+		if err != nil {
+			http.Error(w, fmt.Sprintf("invalid download_limit %q", limit), http.StatusBadRequest)
+			return
+		}
+	}
+
+	r.Body = http.MaxBytesReader(w, r.Body, maxAssetUploadBytes)
+	file, header, err := r.FormFile("file")
+	if err != nil {
+		http.Error(w, "file field is required", http.StatusBadRequest)
+		return
+	}
+	defer file.Close()
+
+	fileName := filepath.Base(header.Filename)
+	if err := util.ValidateDigitalAssetInput(fileName, int32(downloadLimit)); err != nil {
+		http.Error(w, fmt.Sprintf("invalid asset: %v", err), http.StatusBadRequest)
+		return
+	}
+
+	contentType := header.Header.Get("Content-Type")
+	if contentType == "" {
+		contentType = mime.TypeByExtension(filepath.Ext(fileName))
+	}
+	if contentType == "" {
+		contentType = "application/octet-stream"
+	}
+
+	previous, err := server.store.GetDigitalAssetByProductID(ctx, product.ID)
+	if err != nil && err != sql.ErrNoRows {
+		http.Error(w, "Failed to fetch digital asset", http.StatusInternalServerError)
+		return
+	}
+
+	key := fmt.Sprintf("products/%s/%s", product.ID, uuid.New())
+	size, err := server.storage.Put(ctx, key, file)
+	if err != nil {
+		http.Error(w, fmt.Sprintf("Failed to store file: %v", err), http.StatusInternalServerError)
+		return
+	}
+
+	asset, err := server.store.UpsertDigitalAsset(ctx, db.UpsertDigitalAssetParams{
+		ProductID:     product.ID,
+		StorageKey:    key,
+		FileName:      fileName,
+		ContentType:   contentType,
+		SizeBytes:     size,
+		DownloadLimit: int32(downloadLimit),
+	})
+	if err != nil {
+		server.storage.Delete(ctx, key)
+		http.Error(w, "Failed to save digital asset", http.StatusInternalServerError)
+		return
+	}
+
+	if previous.StorageKey != "" {
+		if err := server.storage.Delete(ctx, previous.StorageKey); err != nil {
+			log.Printf("Failed to delete replaced asset %s: %v", previous.StorageKey, err)
+		}
+	}
+
+	data, err := protojson.MarshalOptions{UseProtoNames: true, EmitUnpopulated: true}.Marshal(convertDigitalAsset(asset))
+	if err != nil {
+		http.Error(w, "Failed to encode response", http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.Write(data)
+}
+
+// DownloadHandler serves a file behind a link from GetDownloadLink. The link
+// itself is the credential, so no auth token is needed. A download is only
+// counted once the file could be opened.
+func (server *Server) DownloadHandler(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+
+	query := r.URL.Query()
+	grantID, err := uuid.Parse(query.Get("grant"))
+	if err != nil {
+		http.Error(w, "invalid download link", http.StatusBadRequest)
+		return
+	}
+	expires, err := strconv.ParseInt(query.Get("expires"), 10, 64)
+	if err != nil {
+		http.Error(w, "invalid download link", http.StatusBadRequest)
+		return
+	}
+
+	if err := server.urlSigner.Verify(grantID.String(), time.Unix(expires, 0), query.Get("signature")); err != nil {
+		if err == token.ErrTokenExpired {
+			http.Error(w, "download link has expired", http.StatusGone)
+			return
+		}
+		http.Error(w, "invalid download link", http.StatusForbidden)
+		return
+	}
+
+	ctx := r.Context()
+	grant, err := server.store.GetDownloadGrant(ctx, grantID)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			http.Error(w, "download not found", http.StatusNotFound)
+			return
+		}
+		http.Error(w, "Failed to fetch download", http.StatusInternalServerError)
+		return
+	}
+
+	// A refunded or cancelled order loses its download
+	order, err := server.store.GetOrderByID(ctx, grant.OrderID)
+	if err != nil {
+		http.Error(w, "Failed to fetch order", http.StatusInternalServerError)
+		return
+	}
+	if order.Status.String != "completed" {
+		http.Error(w, "order is no longer completed", http.StatusForbidden)
+		return
+	}
+
+	asset, err := server.store.GetDigitalAssetByID(ctx, grant.AssetID)
+	if err != nil {
+		http.Error(w, "Failed to fetch digital asset", http.StatusInternalServerError)
+		return
+	}
+
+	file, err := server.storage.Open(ctx, asset.StorageKey)
+	if err != nil {
+		log.Printf("Failed to open asset %s: %v", asset.StorageKey, err)
+		http.Error(w, "file is not available", http.StatusInternalServerError)
+		return
+	}
+	defer file.Close()
+
+	if _, err := server.store.ConsumeDownload(ctx, grant.ID); err != nil {
+		if err == sql.ErrNoRows {
+			http.Error(w, fmt.Sprintf("download limit of %d reached for this order", grant.DownloadLimit), http.StatusGone)
+			return
+		}
+		http.Error(w, "Failed to record download", http.StatusInternalServerError)
+		return
+	}
+
+	// The content type is whatever the seller uploaded, the file must be
+	// saved rather than rendered on this origin
+	disposition := mime.FormatMediaType("attachment", map[string]string{"filename": asset.FileName})
+	if disposition == "" {
+		disposition = "attachment"
+	}
+	w.Header().Set("Content-Type", asset.ContentType)
+	w.Header().Set("Content-Length", strconv.FormatInt(asset.SizeBytes, 10))
+	w.Header().Set("Content-Disposition", disposition)
+	w.Header().Set("X-Content-Type-Options", "nosniff")
+	w.Header().Set("Content-Security-Policy", "sandbox")
+	w.Header().Set("Cache-Control", "private, no-store")
+	if _, err := io.Copy(w, file); err != nil {
+		log.Printf("Failed to send asset %s: %v", asset.StorageKey, err)
+	}
+}
@@ -0,0 +1,243 @@
+package gapi
+
+import (
+	"context"
+	"database/sql"
+	"strings"
+
+	"github.com/google/uuid"
+	db "github.com/siddheshRajendraNimbalkar/collage-prject-backend/db/sqlc"
+	"github.com/siddheshRajendraNimbalkar/collage-prject-backend/internal/moderation"
+	"github.com/siddheshRajendraNimbalkar/collage-prject-backend/internal/pagination"
+	"github.com/siddheshRajendraNimbalkar/collage-prject-backend/pb"
+	"github.com/siddheshRajendraNimbalkar/collage-prject-backend/util"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func convertModerationEntry(entry db.ModerationLog) *pb.ModerationEntry {
+	moderatorID := ""
+	if entry.ModeratorID.Valid {
+		moderatorID = entry.ModeratorID.UUID.String()
+	}
+	return &pb.ModerationEntry{
+		Id:          entry.ID.String(),
+		ProductId:   entry.ProductID.String(),
+		ModeratorId: moderatorID,
+		Action:      entry.Action,
+		Reason:      entry.Reason,
+		CreatedAt:   entry.CreatedAt.Time.Format("2006-01-02 15:04:05"),
+	}
+}
+
+// initialProductStatus is the status a new product is stored with. With
+// moderation on, products asking to be published wait for review instead.
+func (server *Server) initialProductStatus(requested string) string {
+	productStatus := productStatusOrDefault(requested)
+	if server.config.ModerationEnabled && productStatus == productStatusPublished {
+		return productStatusPendingReview
+	}
+	return productStatus
+}
+
+// submitForReview runs the automated pre-screen on a product and puts it in
+// the moderation queue, or rejects it straight away when the pre-screen
+// finds a problem.
+func (server *Server) submitForReview(ctx context.Context, product db.Product) (db.Product, error) {
+	reasons := server.screener.Screen(moderation.Listing{
+		Name:        product.Name,
+		Description: product.Description,
+		ProductURL:  product.ProductUrl,
+	})
+
+	arg := db.ModerationParams{
+		ProductID: product.ID,
+		Status:    productStatusPendingReview,
+		Action:    db.ModerationActionSubmitted,
+	}
+	if len(reasons) > 0 {
+		arg.Status = productStatusRejected
+		arg.Action = db.ModerationActionRejected
+		arg.Reason = "automated pre-screen: " + strings.Join(reasons, "; ")
+	}
+
+	moderatedProduct, err := server.store.ModerateProductTx(ctx, arg)
+	if err != nil {
+		return db.Product{}, status.Errorf(codes.Internal, "failed to submit product for review: %v", err)
+	}
+
+	return moderatedProduct, nil
+}
+
+// isMaterialEdit reports whether an update changes what buyers see of the
+// listing. Price and stock changes don't need another review.
+func isMaterialEdit(before db.Product, after db.Product) bool {
+	return before.Name != after.Name ||
+		before.Description != after.Description ||
+		before.ProductUrl != after.ProductUrl ||
+		before.CategoryID != after.CategoryID
+}
+
+func (server *Server) ListPendingProducts(ctx context.Context, req *pb.ListPendingProductsRequest) (*pb.ListPendingProductsResponse, error) {
+	if _, err := server.ModeratorInterceptor(ctx); err != nil {
+		return nil, status.Errorf(codes.PermissionDenied, "%v", err)
+	}
+
+	const scope = "pending_products"
+	limit := pagination.PageSize(req.GetLimit())
+	after, err := pagination.Decode(scope, req.GetPageToken())
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "%v", err)
+	}
+
+	products, err := server.store.ListPendingProducts(ctx, db.ListPendingProductsParams{
+		AfterCreatedAt: after.CreatedAt,
+		AfterID:        after.ID,
+		LimitCount:     limit + 1,
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to list pending products: %v", err)
+	}
+	products, nextPageToken := pagination.Page(scope, products, limit, productKey)
+
+	total, err := server.store.CountPendingProducts(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to count pending products: %v", err)
+	}
+
+	productResponses := convertProducts(products)
+	server.enrichProducts(ctx, productResponses...)
+
+	return &pb.ListPendingProductsResponse{
+		Products:      productResponses,
+		Total:         total,
+		NextPageToken: nextPageToken,
+	}, nil
+}
+
+func (server *Server) ApproveProduct(ctx context.Context, req *pb.ApproveProductRequest) (*pb.ProductResponse, error) {
+	token, err := server.ModeratorInterceptor(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.PermissionDenied, "%v", err)
+	}
+
+	if err := util.ValidateApproveProductInput(req); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid input: %v", err)
+	}
+
+	product, err := server.getModeratedProduct(ctx, req.GetId())
+	if err != nil {
+		return nil, err
+	}
+
+	// Rejected products can be approved too, the pre-screen is not always right
+	if product.Status != productStatusPendingReview && product.Status != productStatusRejected {
+		return nil, status.Errorf(codes.FailedPrecondition, "only products pending review or rejected can be approved")
+	}
+
+	approvedProduct, err := server.store.ModerateProductTx(ctx, db.ModerationParams{
+		ProductID:   product.ID,
+		Status:      productStatusPublished,
+		ModeratorID: uuid.NullUUID{UUID: token.ID, Valid: true},
+		Action:      db.ModerationActionApproved,
+		Reason:      req.GetReason(),
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to approve product: %v", err)
+	}
+
+	return server.productResponse(ctx, approvedProduct), nil
+}
+
+func (server *Server) RejectProduct(ctx context.Context, req *pb.RejectProductRequest) (*pb.ProductResponse, error) {
+	token, err := server.ModeratorInterceptor(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.PermissionDenied, "%v", err)
+	}
+
+	if err := util.ValidateRejectProductInput(req); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid input: %v", err)
+	}
+
+	product, err := server.getModeratedProduct(ctx, req.GetId())
+	if err != nil {
+		return nil, err
+	}
+
+	// Live listings can be taken down as well
+	if product.Status != productStatusPendingReview && product.Status != productStatusPublished {
+		return nil, status.Errorf(codes.FailedPrecondition, "only products pending review or published can be rejected")
+	}
+
+	rejectedProduct, err := server.store.ModerateProductTx(ctx, db.ModerationParams{
+		ProductID:   product.ID,
+		Status:      productStatusRejected,
+		ModeratorID: uuid.NullUUID{UUID: token.ID, Valid: true},
+		Action:      db.ModerationActionRejected,
+		Reason:      req.GetReason(),
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to reject product: %v", err)
+	}
+
+	return server.productResponse(ctx, rejectedProduct), nil
+}
+
+// ListModerationLog shows the decisions on a product to moderators and to
+// the seller who owns it.
+func (server *Server) ListModerationLog(ctx context.Context, req *pb.ListModerationLogRequest) (*pb.ListModerationLogResponse, error) {
+	productID, err := uuid.Parse(req.GetProductId())
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid product ID format")
+	}
+
+	if _, err := server.ModeratorInterceptor(ctx); err != nil {
+		if _, err := server.getOwnedProduct(ctx, req.GetProductId(), true); err != nil {
+			return nil, err
+		}
+	}
+
+	scope := "moderation_log:" + productID.String()
+	limit := pagination.PageSize(req.GetLimit())
+	after, err := pagination.Decode(scope, req.GetPageToken())
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "%v", err)
+	}
+
+	entries, err := server.store.ListModerationLog(ctx, db.ListModerationLogParams{
+		ProductID:      productID,
+		AfterCreatedAt: after.CreatedAt,
+		AfterID:        after.ID,
+		LimitCount:     limit + 1,
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to list moderation log: %v", err)
+	}
+	entries, nextPageToken := pagination.Page(scope, entries, limit, func(entry db.ModerationLog) (sql.NullTime, uuid.UUID) {
+		return entry.CreatedAt, entry.ID
+	})
+
+	entryResponses := []*pb.ModerationEntry{}
+	for _, entry := range entries {
+		entryResponses = append(entryResponses, convertModerationEntry(entry))
+	}
+
+	return &pb.ListModerationLogResponse{Entries: entryResponses, NextPageToken: nextPageToken}, nil
+}
+
+func (server *Server) getModeratedProduct(ctx context.Context, id string) (db.Product, error) {
+	productID, err := uuid.Parse(id)
+	if err != nil {
+		return db.Product{}, status.Errorf(codes.InvalidArgument, "invalid product ID format")
+	}
+
+	product, err := server.store.GetProductByID(ctx, productID)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return db.Product{}, status.Errorf(codes.NotFound, "product not found")
+		}
+		return db.Product{}, status.Errorf(codes.Internal, "failed to fetch product: %v", err)
+	}
+
+	return product, nil
+}
@@ -0,0 +1,92 @@
+package gapi
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/google/uuid"
+	db "github.com/siddheshRajendraNimbalkar/collage-prject-backend/db/sqlc"
+	"github.com/siddheshRajendraNimbalkar/collage-prject-backend/internal/pagination"
+	"github.com/siddheshRajendraNimbalkar/collage-prject-backend/pb"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func convertNotification(notification db.Notification) *pb.Notification {
+	productID := ""
+	if notification.ProductID.Valid {
+		productID = notification.ProductID.UUID.String()
+	}
+	return &pb.Notification{
+		Id:        notification.ID.String(),
+		Kind:      notification.Kind,
+		Title:     notification.Title,
+		Body:      notification.Body,
+		ProductId: productID,
+		Read:      notification.ReadAt.Valid,
+		CreatedAt: notification.CreatedAt.Time.Format("2006-01-02 15:04:05"),
+	}
+}
+
+func (server *Server) ListNotifications(ctx context.Context, req *pb.ListNotificationsRequest) (*pb.ListNotificationsResponse, error) {
+	token, err := server.AuthInterceptor(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "Error in Auth Token: %v", err)
+	}
+
+	scope := "notifications"
+	if req.GetUnreadOnly() {
+		scope = "notifications:unread"
+	}
+	limit := pagination.PageSize(req.GetLimit())
+	after, err := pagination.Decode(scope, req.GetPageToken())
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "%v", err)
+	}
+
+	notifications, err := server.store.ListNotifications(ctx, db.ListNotificationsParams{
+		UserID:         token.ID,
+		UnreadOnly:     req.GetUnreadOnly(),
+		AfterCreatedAt: after.CreatedAt,
+		AfterID:        after.ID,
+		LimitCount:     limit + 1,
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to list notifications: %v", err)
+	}
+	notifications, nextPageToken := pagination.Page(scope, notifications, limit, func(notification db.Notification) (sql.NullTime, uuid.UUID) {
+		return notification.CreatedAt, notification.ID
+	})
+
+	notificationResponses := []*pb.Notification{}
+	for _, notification := range notifications {
+		notificationResponses = append(notificationResponses, convertNotification(notification))
+	}
+
+	return &pb.ListNotificationsResponse{Notifications: notificationResponses, NextPageToken: nextPageToken}, nil
+}
+
+func (server *Server) MarkNotificationRead(ctx context.Context, req *pb.MarkNotificationReadRequest) (*pb.NotificationResponse, error) {
+	token, err := server.AuthInterceptor(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "Error in Auth Token: %v", err)
+	}
+
+	notificationID, err := uuid.Parse(req.GetId())
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid notification ID format")
+	}
+
+	notification, err := server.store.MarkNotificationRead(ctx, db.MarkNotificationReadParams{
+		ID:     notificationID,
+		UserID: token.ID,
+	})
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, status.Errorf(codes.NotFound, "notification not found")
+		}
+		return nil, status.Errorf(codes.Internal, "failed to update notification: %v", err)
+	}
+
+	return &pb.NotificationResponse{Notification: convertNotification(notification)}, nil
+}
@@ -0,0 +1,188 @@
+package gapi
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/google/uuid"
+	db "github.com/siddheshRajendraNimbalkar/collage-prject-backend/db/sqlc"
+	"github.com/siddheshRajendraNimbalkar/collage-prject-backend/internal/pagination"
+	"github.com/siddheshRajendraNimbalkar/collage-prject-backend/pb"
+	"github.com/siddheshRajendraNimbalkar/collage-prject-backend/util"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func convertPriceEntry(price db.ProductPrice) *pb.PriceEntry {
+	validTo := ""
+	if price.ValidTo.Valid {
+		validTo = price.ValidTo.Time.Format("2006-01-02 15:04:05")
+	}
+	return &pb.PriceEntry{
+		Id:        price.ID.String(),
+		ProductId: price.ProductID.String(),
+		Price:     parseFloat(price.Price),
+		Kind:      price.Kind,
+		ValidFrom: price.ValidFrom.Format("2006-01-02 15:04:05"),
+		ValidTo:   validTo,
+		CreatedAt: price.CreatedAt.Time.Format("2006-01-02 15:04:05"),
+	}
+}
+
+// parseTimestamp accepts the format the API returns timestamps in as well as
+// RFC 3339. Times without an offset are taken as UTC.
+func parseTimestamp(value string) (time.Time, error) {
+	if t, err := time.Parse("2006-01-02 15:04:05", value); err == nil {
+		return t, nil
+	}
+	t, err := time.Parse(time.RFC3339, value)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("use YYYY-MM-DD HH:MM:SS or RFC 3339")
+	}
+	return t.UTC(), nil
+}
+
+// withSalePrices fills in the sale and effective price of products that have
+// a sale running right now. A failed lookup only leaves the list prices.
+func (server *Server) withSalePrices(ctx context.Context, products ...*pb.Product) {
+	if len(products) == 0 {
+		return
+	}
+
+	ids := make([]uuid.UUID, 0, len(products))
+	for _, product := range products {
+		if id, err := uuid.Parse(product.GetId()); err == nil {
+			ids = append(ids, id)
+		}
+	}
+
+	sales, err := server.store.ListActiveSalePrices(ctx, ids)
+	if err != nil {
+		log.Printf("failed to load sale prices: %v", err)
+		return
+	}
+
+	saleByProduct := make(map[string]db.ProductPrice, len(sales))
+	for _, sale := range sales {
+		saleByProduct[sale.ProductID.String()] = sale
+	}
+
+	for _, product := range products {
+		sale, ok := saleByProduct[product.GetId()]
+		if !ok {
+			continue
+		}
+		salePrice := parseFloat(sale.Price)
+		if salePrice >= product.GetPrice() {
+			continue
+		}
+		product.SalePrice = salePrice
+		product.SaleEndsAt = sale.ValidTo.Time.Format("2006-01-02 15:04:05")
+		product.EffectivePrice = salePrice
+	}
+}
+
+func (server *Server) ScheduleSale(ctx context.Context, req *pb.ScheduleSaleRequest) (*pb.ScheduleSaleResponse, error) {
+	if err := util.ValidateScheduleSaleInput(req); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid sale: %v", err)
+	}
+
+	token, err := server.AuthInterceptor(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "Error in Auth Token: %v", err)
+	}
+
+	now := time.Now().UTC().Truncate(time.Second)
+	startsAt := now
+	if req.GetStartsAt() != "" {
+		startsAt, err = parseTimestamp(req.GetStartsAt())
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid start time: %v", err)
+		}
+	}
+
+	endsAt, err := parseTimestamp(req.GetEndsAt())
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid end time: %v", err)
+	}
+	if !endsAt.After(startsAt) || !endsAt.After(now) {
+		return nil, status.Errorf(codes.InvalidArgument, "sale must end after it starts and in the future")
+	}
+
+	product, err := server.getOwnedProduct(ctx, req.GetProductId(), false)
+	if err != nil {
+		return nil, err
+	}
+
+	if req.GetSalePrice() >= parseFloat(product.Price) {
+		return nil, status.Errorf(codes.InvalidArgument, "sale price must be lower than the current price")
+	}
+
+	sale, err := server.store.ScheduleSaleTx(ctx, db.CreateProductPriceParams{
+		ProductID: product.ID,
+		Price:     fmt.Sprintf("%.2f", req.GetSalePrice()),
+		ValidFrom: startsAt,
+		ValidTo:   sql.NullTime{Time: endsAt, Valid: true},
+		CreatedBy: uuid.NullUUID{UUID: token.ID, Valid: true},
+	})
+	if err != nil {
+		if err.Error() == "sale overlaps an existing sale" {
+			return nil, status.Errorf(codes.FailedPrecondition, "%v", err)
+		}
+		return nil, status.Errorf(codes.Internal, "failed to schedule sale: %v", err)
+	}
+
+	return &pb.ScheduleSaleResponse{Sale: convertPriceEntry(sale)}, nil
+}
+
+// ListPriceHistory is public so buyers can check past prices.
+func (server *Server) ListPriceHistory(ctx context.Context, req *pb.ListPriceHistoryRequest) (*pb.ListPriceHistoryResponse, error) {
+	productID, err := uuid.Parse(req.GetProductId())
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid product ID format")
+	}
+
+	if _, err := server.store.GetProductByID(ctx, productID); err != nil {
+		if err == sql.ErrNoRows {
+			return nil, status.Errorf(codes.NotFound, "product not found")
+		}
+		return nil, status.Errorf(codes.Internal, "failed to fetch product: %v", err)
+	}
+
+	scope := "prices:" + productID.String()
+	limit := pagination.PageSize(req.GetLimit())
+	after, err := pagination.Decode(scope, req.GetPageToken())
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "%v", err)
+	}
+
+	// The sort key of price history is valid_from in microseconds
+	afterValidFrom := sql.NullTime{}
+	if after.Key.Valid {
+		afterValidFrom = sql.NullTime{Time: time.UnixMicro(after.Key.Int64).UTC(), Valid: true}
+	}
+
+	prices, err := server.store.ListPriceHistory(ctx, db.ListPriceHistoryParams{
+		ProductID:      productID,
+		AfterValidFrom: afterValidFrom,
+		AfterCreatedAt: after.CreatedAt,
+		AfterID:        after.ID,
+		LimitCount:     limit + 1,
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to list price history: %v", err)
+	}
+	prices, nextPageToken := pagination.PageByKey(scope, prices, limit, func(price db.ProductPrice) (int64, sql.NullTime, uuid.UUID) {
+		return price.ValidFrom.UnixMicro(), price.CreatedAt, price.ID
+	})
+
+	entries := []*pb.PriceEntry{}
+	for _, price := range prices {
+		entries = append(entries, convertPriceEntry(price))
+	}
+
+	return &pb.ListPriceHistoryResponse{Entries: entries, NextPageToken: nextPageToken}, nil
+}
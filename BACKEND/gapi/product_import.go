@@ -0,0 +1,356 @@
+package gapi
+
+import (
+	"bufio"
+	"context"
+	"database/sql"
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/google/uuid"
+	db "github.com/siddheshRajendraNimbalkar/collage-prject-backend/db/sqlc"
+	"github.com/siddheshRajendraNimbalkar/collage-prject-backend/pb"
+	"github.com/siddheshRajendraNimbalkar/collage-prject-backend/util"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/encoding/protojson"
+)
+
+const (
+	importModeAtomic = "atomic"
+	importModePerRow = "per_row"
+
+	exportFormatCSV   = "csv"
+	exportFormatJSONL = "jsonl"
+
+	maxImportRows = 1000
+)
+
+// exportColumns is also the header understood by the CSV import, so an
+// exported file can be edited and imported again. Extra columns are ignored.
+// bundle_items lists a bundle's components as product_id:quantity pairs
+// separated by semicolons.
+var exportColumns = []string{"id", "name", "description", "price", "stock", "product_url", "category", "type", "category_id", "status", "low_stock_threshold", "created_at", "kind", "bundle_items"}
+
+// importRow is one line of an import. err is set when the line could not
+// be parsed, so it is reported together with the validation errors.
+type importRow struct {
+	product *pb.CreateProductRequest
+	err     error
+}
+
+func (server *Server) ImportProducts(stream pb.CollageProject_ImportProductsServer) error {
+	ctx := stream.Context()
+
+	token, err := server.AuthInterceptor(ctx)
+	if err != nil {
+		return status.Errorf(codes.InvalidArgument, "Error in Auth Token: %v", err)
+	}
+
+	mode := ""
+	rows := []importRow{}
+	for {
+		req, err := stream.Recv()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return status.Errorf(codes.Unknown, "failed to receive product: %v", err)
+		}
+
+		if len(rows) == 0 {
+			mode = req.GetMode()
+		}
+		if len(rows) == maxImportRows {
+			return status.Errorf(codes.InvalidArgument, "an import may contain at most %d products", maxImportRows)
+		}
+
+		if req.GetProduct() == nil {
+			rows = append(rows, importRow{err: fmt.Errorf("product is required")})
+			continue
+		}
+		rows = append(rows, importRow{product: req.GetProduct()})
+	}
+
+	resp, err := server.importProducts(ctx, token.ID, mode, rows)
+	if err != nil {
+		return err
+	}
+
+	return stream.SendAndClose(resp)
+}
+
+func (server *Server) ExportProducts(req *pb.ExportProductsRequest, stream pb.CollageProject_ExportProductsServer) error {
+	ctx := stream.Context()
+
+	token, err := server.AuthInterceptor(ctx)
+	if err != nil {
+		return status.Errorf(codes.InvalidArgument, "Error in Auth Token: %v", err)
+	}
+
+	return server.exportProducts(ctx, token.ID, req.GetFormat(), exportStreamWriter{stream: stream})
+}
+
+// importProducts validates every row and creates the valid ones. In atomic
+// mode nothing is written unless all rows are valid.
+func (server *Server) importProducts(ctx context.Context, userID uuid.UUID, mode string, rows []importRow) (*pb.ImportProductsResponse, error) {
+	if mode == "" {
+		mode = importModeAtomic
+	}
+	if mode != importModeAtomic && mode != importModePerRow {
+		return nil, status.Errorf(codes.InvalidArgument, "mode must be atomic or per_row")
+	}
+	if len(rows) == 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "no products to import")
+	}
+	if len(rows) > maxImportRows {
+		return nil, status.Errorf(codes.InvalidArgument, "an import may contain at most %d products", maxImportRows)
+	}
+
+	// Verify user exists before creating products
+	_, err := server.store.GetUserByID(ctx, userID)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, status.Errorf(codes.NotFound, "user not found")
+		}
+		return nil, status.Errorf(codes.Internal, "failed to verify user: %v", err)
+	}
+
+	resp := &pb.ImportProductsResponse{Errors: []*pb.ImportRowError{}, Products: []*pb.Product{}}
+	rowNumbers := []int{}
+	params := []db.ImportProduct{}
+
+	for i, row := range rows {
+		productParams, err := server.importProductParams(ctx, userID, row)
+		if err != nil {
+			resp.Errors = append(resp.Errors, importRowError(i, row, err))
+			continue
+		}
+		rowNumbers = append(rowNumbers, i)
+		params = append(params, productParams)
+	}
+
+	products := []db.Product{}
+	if mode == importModeAtomic {
+		if len(resp.Errors) > 0 {
+			resp.Failed = int32(len(resp.Errors))
+			return resp, nil
+		}
+
+		products, err = server.store.ImportProductsTx(ctx, params)
+		if err != nil {
+			if errors.Is(err, db.ErrInvalidBundle) {
+				return nil, status.Errorf(codes.InvalidArgument, "%v", err)
+			}
+			return nil, status.Errorf(codes.Internal, "failed to import products: %v", err)
+		}
+	} else {
+		for i, productParams := range params {
+			var product db.Product
+			var err error
+			if productParams.Product.Kind == db.ProductKindBundle {
+				product, err = server.store.CreateBundleTx(ctx, productParams.Product, productParams.BundleItems)
+			} else {
+				product, err = server.store.CreateProductTx(ctx, productParams.Product, db.StockReasonImport)
+			}
+			if err != nil {
+				row := rowNumbers[i]
+				resp.Errors = append(resp.Errors, importRowError(row, rows[row], fmt.Errorf("failed to create product: %v", err)))
+				continue
+			}
+			products = append(products, product)
+		}
+		sort.Slice(resp.Errors, func(i, j int) bool {
+			return resp.Errors[i].Row < resp.Errors[j].Row
+		})
+	}
+
+	for _, product := range products {
+		if product.Status == productStatusPendingReview {
+			product, err = server.submitForReview(ctx, product)
+			if err != nil {
+				return nil, err
+			}
+		}
+		resp.Products = append(resp.Products, convertProduct(product))
+	}
+	server.enrichProducts(ctx, resp.Products...)
+
+	resp.Imported = int32(len(products))
+	resp.Failed = int32(len(resp.Errors))
+	return resp, nil
+}
+
+func (server *Server) importProductParams(ctx context.Context, userID uuid.UUID, row importRow) (db.ImportProduct, error) {
+	if row.err != nil {
+		return db.ImportProduct{}, row.err
+	}
+
+	req := row.product
+	if err := util.ValidateCreateProductInput(req); err != nil {
+		return db.ImportProduct{}, err
+	}
+
+	// Components are existing products, checked when the bundle is created
+	items, err := parseBundleItems(req.GetBundleItems())
+	if err != nil {
+		return db.ImportProduct{}, err
+	}
+
+	rootCategory, category, err := server.resolveProductCategory(ctx, req.GetCategoryId(), req.GetCategory(), req.GetType())
+	if err != nil {
+		return db.ImportProduct{}, err
+	}
+
+	product := db.CreateProductParams{
+		Name:              req.GetName(),
+		Description:       req.GetDescription(),
+		Price:             fmt.Sprintf("%.2f", req.GetPrice()),
+		CreatedBy:         uuid.NullUUID{UUID: userID, Valid: true},
+		Stock:             req.GetStock(),
+		ProductUrl:        req.GetProductUrl(),
+		Category:          rootCategory.Slug,
+		Type:              category.Slug,
+		Status:            server.initialProductStatus(req.GetStatus()),
+		CategoryID:        category.ID,
+		LowStockThreshold: req.GetLowStockThreshold(),
+		Kind:              req.GetKind(),
+	}
+	return db.ImportProduct{Product: product, BundleItems: items}, nil
+}
+
+func importRowError(index int, row importRow, err error) *pb.ImportRowError {
+	rowError := &pb.ImportRowError{
+		Row:   int32(index + 1),
+		Error: err.Error(),
+	}
+	if s, ok := status.FromError(err); ok {
+		rowError.Error = s.Message()
+	}
+	if row.product != nil {
+		rowError.Name = row.product.GetName()
+	}
+	return rowError
+}
+
+// exportProducts writes every non-deleted product of the user, drafts and
+// archived ones included, in the requested format.
+func (server *Server) exportProducts(ctx context.Context, userID uuid.UUID, format string, w io.Writer) error {
+	if format == "" {
+		format = exportFormatCSV
+	}
+	if format != exportFormatCSV && format != exportFormatJSONL {
+		return status.Errorf(codes.InvalidArgument, "format must be csv or jsonl")
+	}
+
+	products, err := server.store.GetProductByUserID(ctx, uuid.NullUUID{UUID: userID, Valid: true})
+	if err != nil {
+		return status.Errorf(codes.Internal, "failed to fetch products: %v", err)
+	}
+
+	bundleItems, err := server.exportBundleItems(ctx, products)
+	if err != nil {
+		return err
+	}
+
+	if format == exportFormatJSONL {
+		buf := bufio.NewWriter(w)
+		marshaler := protojson.MarshalOptions{UseProtoNames: true}
+		for _, product := range products {
+			pbProduct := convertProduct(product)
+			pbProduct.BundleItems = bundleItems[product.ID]
+			line, err := marshaler.Marshal(pbProduct)
+			if err != nil {
+				return status.Errorf(codes.Internal, "failed to encode product: %v", err)
+			}
+			buf.Write(line)
+			buf.WriteByte('\n')
+		}
+		if err := buf.Flush(); err != nil {
+			return status.Errorf(codes.Unavailable, "failed to send products: %v", err)
+		}
+		return nil
+	}
+
+	csvWriter := csv.NewWriter(w)
+	csvWriter.Write(exportColumns)
+	for _, product := range products {
+		csvWriter.Write([]string{
+			product.ID.String(),
+			product.Name,
+			product.Description,
+			product.Price,
+			strconv.Itoa(int(product.Stock)),
+			product.ProductUrl,
+			product.Category,
+			product.Type,
+			product.CategoryID.String(),
+			product.Status,
+			strconv.Itoa(int(product.LowStockThreshold)),
+			product.CreatedAt.Time.Format("2006-01-02 15:04:05"),
+			product.Kind,
+			formatBundleItems(bundleItems[product.ID]),
+		})
+	}
+	csvWriter.Flush()
+	if err := csvWriter.Error(); err != nil {
+		return status.Errorf(codes.Unavailable, "failed to send products: %v", err)
+	}
+	return nil
+}
+
+// exportBundleItems lists the components of the exported bundles. Stock is
+// left as stored, a bundle's derived stock would not import again.
+func (server *Server) exportBundleItems(ctx context.Context, products []db.Product) (map[uuid.UUID][]*pb.BundleItem, error) {
+	ids := []uuid.UUID{}
+	for _, product := range products {
+		if product.Kind == db.ProductKindBundle {
+			ids = append(ids, product.ID)
+		}
+	}
+
+	items := map[uuid.UUID][]*pb.BundleItem{}
+	if len(ids) == 0 {
+		return items, nil
+	}
+
+	components, err := server.store.ListBundleComponents(ctx, ids)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to fetch bundle components: %v", err)
+	}
+	for _, component := range components {
+		items[component.BundleID] = append(items[component.BundleID], &pb.BundleItem{
+			ProductId: component.ComponentID.String(),
+			Quantity:  component.Quantity,
+			Name:      component.Name,
+		})
+	}
+	return items, nil
+}
+
+// formatBundleItems is the bundle_items CSV column, parseBundleItemsColumn
+// reads it back.
+func formatBundleItems(items []*pb.BundleItem) string {
+	pairs := make([]string, 0, len(items))
+	for _, item := range items {
+		pairs = append(pairs, fmt.Sprintf("%s:%d", item.GetProductId(), item.GetQuantity()))
+	}
+	return strings.Join(pairs, ";")
+}
+
+// exportStreamWriter sends every write as one message of the export stream.
+type exportStreamWriter struct {
+	stream pb.CollageProject_ExportProductsServer
+}
+
+func (w exportStreamWriter) Write(p []byte) (int, error) {
+	if err := w.stream.Send(&pb.ExportProductsResponse{Data: p}); err != nil {
+		return 0, err
+	}
+	return len(p), nil
+}
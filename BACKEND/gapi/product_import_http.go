@@ -0,0 +1,291 @@
+package gapi
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/csv"
+	"fmt"
+	"io"
+	"mime"
+	"net/http"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+	"github.com/siddheshRajendraNimbalkar/collage-prject-backend/pb"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/encoding/protojson"
+)
+
+const maxImportUploadBytes = 10 << 20
+
+// ImportProductsHandler accepts a CSV or JSON Lines upload, either as the raw
+// request body or as the "file" field of a multipart form. The format comes
+// from the format query parameter, the file extension or the content type.
+func (server *Server) ImportProductsHandler(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+
+	token, err := server.authenticateHTTP(r)
+	if err != nil {
+		http.Error(w, fmt.Sprintf("Error in Auth Token: %v", err), http.StatusUnauthorized)
+		return
+	}
+
+	r.Body = http.MaxBytesReader(w, r.Body, maxImportUploadBytes)
+
+	body := io.Reader(r.Body)
+	format := r.URL.Query().Get("format")
+	contentType, _, _ := mime.ParseMediaType(r.Header.Get("Content-Type"))
+
+	if contentType == "multipart/form-data" {
+		file, header, err := r.FormFile("file")
+		if err != nil {
+			http.Error(w, "file field is required", http.StatusBadRequest)
+			return
+		}
+		defer file.Close()
+		body = file
+		if format == "" {
+			format = strings.TrimPrefix(strings.ToLower(filepath.Ext(header.Filename)), ".")
+		}
+	}
+	if format == "" {
+		format = importFormatFromContentType(contentType)
+	}
+
+	var rows []importRow
+	switch format {
+	case exportFormatCSV:
+		rows, err = parseImportCSV(body)
+	case exportFormatJSONL, "ndjson":
+		rows, err = parseImportJSONL(body)
+	default:
+		http.Error(w, "format must be csv or jsonl", http.StatusBadRequest)
+		return
+	}
+	if err != nil {
+		http.Error(w, fmt.Sprintf("invalid upload: %v", err), http.StatusBadRequest)
+		return
+	}
+
+	resp, err := server.importProducts(r.Context(), token.ID, r.URL.Query().Get("mode"), rows)
+	if err != nil {
+		writeHTTPError(w, err)
+		return
+	}
+
+	data, err := protojson.MarshalOptions{UseProtoNames: true, EmitUnpopulated: true}.Marshal(resp)
+	if err != nil {
+		http.Error(w, "Failed to encode response", http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.Write(data)
+}
+
+// ExportProductsHandler downloads the caller's catalogue as CSV (default) or
+// JSON Lines.
+func (server *Server) ExportProductsHandler(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+
+	token, err := server.authenticateHTTP(r)
+	if err != nil {
+		http.Error(w, fmt.Sprintf("Error in Auth Token: %v", err), http.StatusUnauthorized)
+		return
+	}
+
+	format := r.URL.Query().Get("format")
+	if format == "" {
+		format = exportFormatCSV
+	}
+
+	// Render into memory first so errors still get a proper status code
+	var buf bytes.Buffer
+	if err := server.exportProducts(r.Context(), token.ID, format, &buf); err != nil {
+		writeHTTPError(w, err)
+		return
+	}
+
+	if format == exportFormatJSONL {
+		w.Header().Set("Content-Type", "application/x-ndjson")
+	} else {
+		w.Header().Set("Content-Type", "text/csv")
+	}
+	w.Header().Set("Content-Disposition", fmt.Sprintf("attachment; filename=products.%s", format))
+	w.Write(buf.Bytes())
+}
+
+func importFormatFromContentType(contentType string) string {
+	switch contentType {
+	case "text/csv":
+		return exportFormatCSV
+	case "application/x-ndjson", "application/jsonl", "application/x-jsonlines":
+		return exportFormatJSONL
+	}
+	return ""
+}
+
+func writeHTTPError(w http.ResponseWriter, err error) {
+	s := status.Convert(err)
+	http.Error(w, s.Message(), runtime.HTTPStatusFromCode(s.Code()))
+}
+
+// parseImportCSV reads a CSV file with a header line. Columns are matched by
+// name, so the order does not matter and unknown columns are skipped.
+func parseImportCSV(r io.Reader) ([]importRow, error) {
+	reader := csv.NewReader(r)
+	reader.FieldsPerRecord = -1
+	reader.TrimLeadingSpace = true
+
+	header, err := reader.Read()
+	if err != nil {
+		if err == io.EOF {
+			return nil, fmt.Errorf("file is empty")
+		}
+		return nil, err
+	}
+
+	columns := make(map[string]int, len(header))
+	for i, name := range header {
+		columns[strings.ToLower(strings.TrimSpace(name))] = i
+	}
+	if _, ok := columns["name"]; !ok {
+		return nil, fmt.Errorf("header must contain a name column")
+	}
+
+	rows := []importRow{}
+	for {
+		record, err := reader.Read()
+		if err == io.EOF {
+			break
+		}
+
+		// Lines that fail to parse count toward the limit too
+		if len(rows) == maxImportRows {
+			return nil, fmt.Errorf("an import may contain at most %d products", maxImportRows)
+		}
+
+		if err != nil {
+			if _, ok := err.(*csv.ParseError); ok {
+				rows = append(rows, importRow{err: err})
+				continue
+			}
+			return nil, err
+		}
+
+		field := func(name string) string {
+			i, ok := columns[name]
+			if !ok || i >= len(record) {
+				return ""
+			}
+			return strings.TrimSpace(record[i])
+		}
+
+		product := &pb.CreateProductRequest{
+			Name:        field("name"),
+			Description: field("description"),
+			ProductUrl:  field("product_url"),
+			Category:    field("category"),
+			Type:        field("type"),
+			Status:      field("status"),
+			CategoryId:  field("category_id"),
+			Kind:        field("kind"),
+		}
+
+		if items := field("bundle_items"); items != "" {
+			product.BundleItems, err = parseBundleItemsColumn(items)
+			if err != nil {
+				rows = append(rows, importRow{product: product, err: err})
+				continue
+			}
+		}
+
+		if price := field("price"); price != "" {
+			product.Price, err = strconv.ParseFloat(price, 64)
+			if err != nil {
+				rows = append(rows, importRow{product: product, err: fmt.Errorf("invalid price %q", price)})
+				continue
+			}
+		}
+
+		if stock := field("stock"); stock != "" {
+			parsedStock, err := strconv.ParseInt(stock, 10, 32)
+			if err != nil {
+				rows = append(rows, importRow{product: product, err: fmt.Errorf("invalid stock %q", stock)})
+				continue
+			}
+			product.Stock = int32(parsedStock)
+		}
+
+		if threshold := field("low_stock_threshold"); threshold != "" {
+			parsedThreshold, err := strconv.ParseInt(threshold, 10, 32)
+			if err != nil {
+				rows = append(rows, importRow{product: product, err: fmt.Errorf("invalid low_stock_threshold %q", threshold)})
+				continue
+			}
+			product.LowStockThreshold = int32(parsedThreshold)
+		}
+
+		rows = append(rows, importRow{product: product})
+	}
+
+	return rows, nil
+}
+
+// parseBundleItemsColumn reads the product_id:quantity pairs of the
+// bundle_items column.
+func parseBundleItemsColumn(column string) ([]*pb.BundleItem, error) {
+	items := []*pb.BundleItem{}
+	for _, pair := range strings.Split(column, ";") {
+		if pair = strings.TrimSpace(pair); pair == "" {
+			continue
+		}
+		productID, quantity, ok := strings.Cut(pair, ":")
+		parsedQuantity, err := strconv.ParseInt(strings.TrimSpace(quantity), 10, 32)
+		if !ok || err != nil {
+			return nil, fmt.Errorf("invalid bundle item %q, expected product_id:quantity", pair)
+		}
+		items = append(items, &pb.BundleItem{ProductId: strings.TrimSpace(productID), Quantity: int32(parsedQuantity)})
+	}
+	return items, nil
+}
+
+// parseImportJSONL reads one CreateProductRequest JSON object per line.
+// Blank lines are skipped and do not count as rows.
+func parseImportJSONL(r io.Reader) ([]importRow, error) {
+	scanner := bufio.NewScanner(r)
+	scanner.Buffer(make([]byte, 0, 64*1024), 1024*1024)
+	unmarshaler := protojson.UnmarshalOptions{DiscardUnknown: true}
+
+	rows := []importRow{}
+	for scanner.Scan() {
+		line := bytes.TrimSpace(scanner.Bytes())
+		if len(line) == 0 {
+			continue
+		}
+
+		if len(rows) == maxImportRows {
+			return nil, fmt.Errorf("an import may contain at most %d products", maxImportRows)
+		}
+
+		product := &pb.CreateProductRequest{}
+		if err := unmarshaler.Unmarshal(line, product); err != nil {
+			rows = append(rows, importRow{err: fmt.Errorf("invalid JSON: %v", err)})
+			continue
+		}
+		rows = append(rows, importRow{product: product})
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+
+	return rows, nil
+}
@@ -0,0 +1,183 @@
+package gapi
+
+import (
+	"context"
+	"database/sql"
+	"strconv"
+	"strings"
+
+	"github.com/google/uuid"
+	db "github.com/siddheshRajendraNimbalkar/collage-prject-backend/db/sqlc"
+	"github.com/siddheshRajendraNimbalkar/collage-prject-backend/internal/pagination"
+	"github.com/siddheshRajendraNimbalkar/collage-prject-backend/pb"
+	"github.com/siddheshRajendraNimbalkar/collage-prject-backend/util"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// defaultPriceBounds are the price facet bucket edges when the client sends none
+var defaultPriceBounds = []float64{100, 500, 1000, 5000, 10000}
+
+func (server *Server) QueryProducts(ctx context.Context, req *pb.QueryProductsRequest) (*pb.QueryProductsResponse, error) {
+	if err := util.ValidateQueryProductsInput(req); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid query: %v", err)
+	}
+
+	var sellerID uuid.NullUUID
+	if req.GetSellerId() != "" {
+		id, err := uuid.Parse(req.GetSellerId())
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid seller ID format")
+		}
+		sellerID = uuid.NullUUID{UUID: id, Valid: true}
+	}
+
+	language, err := server.searchLanguage(ctx, req.GetLanguage())
+	if err != nil {
+		return nil, err
+	}
+
+	sort := req.GetSort()
+	if sort == "" {
+		sort = "newest"
+	}
+
+	// A token only continues the query, sort and filters it was issued for
+	scope := pagination.Scope("products:query", language, sort, strings.TrimSpace(req.GetQuery()),
+		nonEmpty(req.GetCategories()), nonEmpty(req.GetTypes()),
+		req.GetMinPrice(), req.GetMaxPrice(), req.GetInStockOnly(),
+		req.GetSellerId(), strings.ToLower(strings.TrimSpace(req.GetOrganization())), req.GetMinRating())
+	limit := pagination.PageSize(req.GetLimit())
+	after, err := pagination.Decode(scope, req.GetPageToken())
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "%v", err)
+	}
+
+	filters := db.QueryProductsParams{
+		Language:       language,
+		Queries:        server.dictionary.Dictionary(ctx).Alternatives(req.GetQuery()),
+		Categories:     nonEmpty(req.GetCategories()),
+		Types:          nonEmpty(req.GetTypes()),
+		MinPrice:       optionalDecimal(req.GetMinPrice()),
+		MaxPrice:       optionalDecimal(req.GetMaxPrice()),
+		InStockOnly:    req.GetInStockOnly(),
+		SellerID:       sellerID,
+		Organization:   strings.TrimSpace(req.GetOrganization()),
+		MinRating:      optionalDecimal(req.GetMinRating()),
+		Sort:           sort,
+		AfterKey:       after.Key,
+		AfterCreatedAt: after.CreatedAt,
+		AfterID:        after.ID,
+		LimitCount:     limit + 1,
+	}
+
+	rows, err := server.store.QueryProducts(ctx, filters)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to query products: %v", err)
+	}
+	rows, nextPageToken := pagination.PageByKey(scope, rows, limit, func(row db.QueryProductsRow) (int64, sql.NullTime, uuid.UUID) {
+		return row.SortKey, row.Product.CreatedAt, row.Product.ID
+	})
+
+	products := make([]db.Product, 0, len(rows))
+	for _, row := range rows {
+		products = append(products, row.Product)
+	}
+
+	total, err := server.store.CountQueryProducts(ctx, db.CountQueryProductsParams{
+		Language:     filters.Language,
+		Queries:      filters.Queries,
+		Categories:   filters.Categories,
+		Types:        filters.Types,
+		MinPrice:     filters.MinPrice,
+		MaxPrice:     filters.MaxPrice,
+		InStockOnly:  filters.InStockOnly,
+		SellerID:     filters.SellerID,
+		Organization: filters.Organization,
+		MinRating:    filters.MinRating,
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to count products: %v", err)
+	}
+
+	bounds := req.GetPriceBounds()
+	if len(bounds) == 0 {
+		bounds = defaultPriceBounds
+	}
+	priceBounds := make([]string, 0, len(bounds))
+	for _, bound := range bounds {
+		priceBounds = append(priceBounds, strconv.FormatFloat(bound, 'f', 2, 64))
+	}
+
+	facets, err := server.store.QueryProductFacets(ctx, db.QueryProductFacetsParams{
+		Language:     filters.Language,
+		Queries:      filters.Queries,
+		Categories:   filters.Categories,
+		Types:        filters.Types,
+		MinPrice:     filters.MinPrice,
+		MaxPrice:     filters.MaxPrice,
+		InStockOnly:  filters.InStockOnly,
+		SellerID:     filters.SellerID,
+		Organization: filters.Organization,
+		MinRating:    filters.MinRating,
+		PriceBounds:  priceBounds,
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to count facets: %v", err)
+	}
+
+	resp := &pb.QueryProductsResponse{
+		Products:      convertProducts(products),
+		Total:         total,
+		Categories:    []*pb.FacetCount{},
+		Types:         []*pb.FacetCount{},
+		PriceBuckets:  priceBuckets(bounds),
+		NextPageToken: nextPageToken,
+	}
+	for _, facet := range facets {
+		switch facet.Facet {
+		case "category":
+			resp.Categories = append(resp.Categories, &pb.FacetCount{Value: facet.Value, Count: facet.Count})
+		case "type":
+			resp.Types = append(resp.Types, &pb.FacetCount{Value: facet.Value, Count: facet.Count})
+		case "price":
+			bucket, err := strconv.Atoi(facet.Value)
+			if err == nil && bucket >= 0 && bucket < len(resp.PriceBuckets) {
+				resp.PriceBuckets[bucket].Count = facet.Count
+			}
+		}
+	}
+	server.enrichProducts(ctx, resp.Products...)
+
+	return resp, nil
+}
+
+// priceBuckets lists every bucket the bounds make, empty ones included, so
+// clients can render a fixed set of ranges.
+func priceBuckets(bounds []float64) []*pb.PriceBucket {
+	buckets := make([]*pb.PriceBucket, 0, len(bounds)+1)
+	lower := 0.0
+	for _, bound := range bounds {
+		buckets = append(buckets, &pb.PriceBucket{Min: lower, Max: bound})
+		lower = bound
+	}
+	return append(buckets, &pb.PriceBucket{Min: lower})
+}
+
+// optionalDecimal maps the proto zero value to a filter that is not applied.
+func optionalDecimal(value float64) sql.NullString {
+	if value == 0 {
+		return sql.NullString{}
+	}
+	return sql.NullString{String: strconv.FormatFloat(value, 'f', 2, 64), Valid: true}
+}
+
+func nonEmpty(values []string) []string {
+	out := []string{}
+	for _, value := range values {
+		if value = strings.TrimSpace(value); value != "" {
+			out = append(out, value)
+		}
+	}
+	return out
+}
@@ -0,0 +1,135 @@
+package gapi
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/google/uuid"
+	db "github.com/siddheshRajendraNimbalkar/collage-prject-backend/db/sqlc"
+	"github.com/siddheshRajendraNimbalkar/collage-prject-backend/pb"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	productStatusPublished     = "published"
+	productStatusArchived      = "archived"
+	productStatusPendingReview = "pending_review"
+	productStatusRejected      = "rejected"
+)
+
+func productStatusOrDefault(productStatus string) string {
+	if productStatus == "" {
+		return productStatusPublished
+	}
+	return productStatus
+}
+
+// getOwnedProduct loads a product and makes sure the caller created it.
+// Soft deleted products are only returned when includeDeleted is set.
+func (server *Server) getOwnedProduct(ctx context.Context, id string, includeDeleted bool) (db.Product, error) {
+	token, err := server.AuthInterceptor(ctx)
+	if err != nil {
+		return db.Product{}, status.Errorf(codes.InvalidArgument, "Error in Auth Token: %v", err)
+	}
+
+	productID, err := uuid.Parse(id)
+	if err != nil {
+		return db.Product{}, status.Errorf(codes.InvalidArgument, "invalid product ID format")
+	}
+
+	var product db.Product
+	if includeDeleted {
+		product, err = server.store.GetProductByIDIncludingDeleted(ctx, productID)
+	} else {
+		product, err = server.store.GetProductByID(ctx, productID)
+	}
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return db.Product{}, status.Errorf(codes.NotFound, "product not found")
+		}
+		return db.Product{}, status.Errorf(codes.Internal, "failed to fetch product: %v", err)
+	}
+
+	if product.CreatedBy.UUID != token.ID {
+		return db.Product{}, status.Errorf(codes.PermissionDenied, "Only the product creator can manage this product")
+	}
+
+	return product, nil
+}
+
+func (server *Server) PublishProduct(ctx context.Context, req *pb.PublishProductRequest) (*pb.ProductResponse, error) {
+	product, err := server.getOwnedProduct(ctx, req.GetId(), false)
+	if err != nil {
+		return nil, err
+	}
+
+	if product.Status == productStatusPublished || product.Status == productStatusPendingReview {
+		return server.productResponse(ctx, product), nil
+	}
+
+	// With moderation on, publishing asks for a review. Rejected listings
+	// always need one so a rejection can't be undone by the seller.
+	if server.config.ModerationEnabled || product.Status == productStatusRejected {
+		submittedProduct, err := server.submitForReview(ctx, product)
+		if err != nil {
+			return nil, err
+		}
+		return server.productResponse(ctx, submittedProduct), nil
+	}
+
+	updatedProduct, err := server.store.UpdateProductStatus(ctx, db.UpdateProductStatusParams{
+		ID:     product.ID,
+		Status: productStatusPublished,
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to publish product: %v", err)
+	}
+
+	return server.productResponse(ctx, updatedProduct), nil
+}
+
+func (server *Server) ArchiveProduct(ctx context.Context, req *pb.ArchiveProductRequest) (*pb.ProductResponse, error) {
+	product, err := server.getOwnedProduct(ctx, req.GetId(), false)
+	if err != nil {
+		return nil, err
+	}
+
+	if product.Status == productStatusArchived {
+		return server.productResponse(ctx, product), nil
+	}
+
+	// Archiving would drop the rejection and let PublishProduct skip the
+	// review when moderation is off
+	if product.Status == productStatusRejected {
+		return nil, status.Errorf(codes.FailedPrecondition, "rejected products must be edited and resubmitted for review")
+	}
+
+	updatedProduct, err := server.store.UpdateProductStatus(ctx, db.UpdateProductStatusParams{
+		ID:     product.ID,
+		Status: productStatusArchived,
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to archive product: %v", err)
+	}
+
+	return server.productResponse(ctx, updatedProduct), nil
+}
+
+func (server *Server) RestoreProduct(ctx context.Context, req *pb.RestoreProductRequest) (*pb.ProductResponse, error) {
+	product, err := server.getOwnedProduct(ctx, req.GetId(), true)
+	if err != nil {
+		return nil, err
+	}
+
+	if !product.DeletedAt.Valid {
+		return nil, status.Errorf(codes.FailedPrecondition, "product is not deleted")
+	}
+
+	restoredProduct, err := server.store.RestoreProduct(ctx, product.ID)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to restore product: %v", err)
+	}
+
+	return server.productResponse(ctx, restoredProduct), nil
+}
@@ -0,0 +1,396 @@
+package gapi
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/google/uuid"
+	db "github.com/siddheshRajendraNimbalkar/collage-prject-backend/db/sqlc"
+	"github.com/siddheshRajendraNimbalkar/collage-prject-backend/internal/pagination"
+	"github.com/siddheshRajendraNimbalkar/collage-prject-backend/pb"
+	"github.com/siddheshRajendraNimbalkar/collage-prject-backend/util"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func convertQuestion(question db.ProductQuestion) *pb.Question {
+	return &pb.Question{
+		Id:          question.ID.String(),
+		ProductId:   question.ProductID.String(),
+		UserId:      question.UserID.String(),
+		Body:        question.Body,
+		UpvoteCount: question.UpvoteCount,
+		Hidden:      question.Hidden,
+		CreatedAt:   question.CreatedAt.Time.Format("2006-01-02 15:04:05"),
+		Answers:     []*pb.Answer{},
+	}
+}
+
+func convertAnswer(answer db.ProductAnswer) *pb.Answer {
+	return &pb.Answer{
+		Id:          answer.ID.String(),
+		QuestionId:  answer.QuestionID.String(),
+		UserId:      answer.UserID.String(),
+		Body:        answer.Body,
+		UpvoteCount: answer.UpvoteCount,
+		Hidden:      answer.Hidden,
+		CreatedAt:   answer.CreatedAt.Time.Format("2006-01-02 15:04:05"),
+	}
+}
+
+func (server *Server) AskQuestion(ctx context.Context, req *pb.AskQuestionRequest) (*pb.QuestionResponse, error) {
+	if err := util.ValidateAskQuestionInput(req); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid question: %v", err)
+	}
+
+	token, err := server.AuthInterceptor(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "Error in Auth Token: %v", err)
+	}
+
+	productID, err := uuid.Parse(req.GetProductId())
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid product ID format")
+	}
+
+	product, err := server.store.GetProductByID(ctx, productID)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, status.Errorf(codes.NotFound, "product not found")
+		}
+		return nil, status.Errorf(codes.Internal, "failed to fetch product: %v", err)
+	}
+	if product.Status != productStatusPublished {
+		return nil, status.Errorf(codes.NotFound, "product not found")
+	}
+	if product.CreatedBy.UUID == token.ID {
+		return nil, status.Errorf(codes.FailedPrecondition, "you cannot ask about your own product")
+	}
+
+	question, err := server.store.AskQuestionTx(ctx, db.CreateQuestionParams{
+		ProductID: productID,
+		UserID:    token.ID,
+		Body:      req.GetBody(),
+	}, product)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to create question: %v", err)
+	}
+
+	return &pb.QuestionResponse{Question: convertQuestion(question)}, nil
+}
+
+func (server *Server) AnswerQuestion(ctx context.Context, req *pb.AnswerQuestionRequest) (*pb.AnswerResponse, error) {
+	if err := util.ValidateAnswerQuestionInput(req); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid answer: %v", err)
+	}
+
+	token, err := server.AuthInterceptor(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "Error in Auth Token: %v", err)
+	}
+
+	question, err := server.getVisibleQuestion(ctx, req.GetQuestionId())
+	if err != nil {
+		return nil, err
+	}
+
+	product, err := server.store.GetProductByIDIncludingDeleted(ctx, question.ProductID)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to fetch product: %v", err)
+	}
+
+	if product.CreatedBy.UUID != token.ID {
+		return nil, status.Errorf(codes.PermissionDenied, "Only the seller can answer questions")
+	}
+
+	answer, err := server.store.CreateAnswer(ctx, db.CreateAnswerParams{
+		QuestionID: question.ID,
+		UserID:     token.ID,
+		Body:       req.GetBody(),
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to create answer: %v", err)
+	}
+
+	return &pb.AnswerResponse{Answer: convertAnswer(answer)}, nil
+}
+
+func (server *Server) ListProductQuestions(ctx context.Context, req *pb.ListProductQuestionsRequest) (*pb.ListProductQuestionsResponse, error) {
+	productID, err := uuid.Parse(req.GetProductId())
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid product ID format")
+	}
+
+	sort := req.GetSort()
+	if sort != "" && sort != "newest" && sort != "top" {
+		return nil, status.Errorf(codes.InvalidArgument, "sort must be newest or top")
+	}
+
+	scope := "questions:" + productID.String() + ":" + sort
+	limit := pagination.PageSize(req.GetLimit())
+	after, err := pagination.Decode(scope, req.GetPageToken())
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "%v", err)
+	}
+
+	if _, err := server.store.GetProductByID(ctx, productID); err != nil {
+		if err == sql.ErrNoRows {
+			return nil, status.Errorf(codes.NotFound, "product not found")
+		}
+		return nil, status.Errorf(codes.Internal, "failed to fetch product: %v", err)
+	}
+
+	questions, err := server.store.ListProductQuestions(ctx, db.ListProductQuestionsParams{
+		ProductID:      productID,
+		Sort:           sort,
+		AfterKey:       after.Key,
+		AfterCreatedAt: after.CreatedAt,
+		AfterID:        after.ID,
+		LimitCount:     limit + 1,
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to list questions: %v", err)
+	}
+	questions, nextPageToken := pagination.PageByKey(scope, questions, limit, func(question db.ProductQuestion) (int64, sql.NullTime, uuid.UUID) {
+		return questionSortKey(sort, question), question.CreatedAt, question.ID
+	})
+
+	total, err := server.store.CountProductQuestions(ctx, productID)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to count questions: %v", err)
+	}
+
+	questionResponses := []*pb.Question{}
+	questionIDs := []uuid.UUID{}
+	byID := map[uuid.UUID]*pb.Question{}
+	for _, question := range questions {
+		questionResponse := convertQuestion(question)
+		questionResponses = append(questionResponses, questionResponse)
+		questionIDs = append(questionIDs, question.ID)
+		byID[question.ID] = questionResponse
+	}
+
+	if len(questionIDs) > 0 {
+		answers, err := server.store.ListAnswersByQuestionIDs(ctx, questionIDs)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to list answers: %v", err)
+		}
+		for _, answer := range answers {
+			question := byID[answer.QuestionID]
+			question.Answers = append(question.Answers, convertAnswer(answer))
+		}
+	}
+
+	return &pb.ListProductQuestionsResponse{
+		Questions:     questionResponses,
+		Total:         total,
+		NextPageToken: nextPageToken,
+	}, nil
+}
+
+// questionSortKey is the sort key ListProductQuestions orders by before
+// created_at and id.
+func questionSortKey(sort string, question db.ProductQuestion) int64 {
+	if sort == "top" {
+		return int64(question.UpvoteCount)
+	}
+	return 0
+}
+
+func (server *Server) UpvoteQuestion(ctx context.Context, req *pb.UpvoteQuestionRequest) (*pb.QuestionResponse, error) {
+	token, err := server.AuthInterceptor(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "Error in Auth Token: %v", err)
+	}
+
+	question, err := server.getVisibleQuestion(ctx, req.GetQuestionId())
+	if err != nil {
+		return nil, err
+	}
+
+	if question.UserID == token.ID {
+		return nil, status.Errorf(codes.FailedPrecondition, "you cannot vote on your own question")
+	}
+
+	question, err = server.store.UpvoteQuestionTx(ctx, db.CreateQuestionVoteParams{
+		QuestionID: question.ID,
+		UserID:     token.ID,
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to record vote: %v", err)
+	}
+
+	return &pb.QuestionResponse{Question: convertQuestion(question)}, nil
+}
+
+func (server *Server) UpvoteAnswer(ctx context.Context, req *pb.UpvoteAnswerRequest) (*pb.AnswerResponse, error) {
+	token, err := server.AuthInterceptor(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "Error in Auth Token: %v", err)
+	}
+
+	answer, err := server.getVisibleAnswer(ctx, req.GetAnswerId())
+	if err != nil {
+		return nil, err
+	}
+
+	if answer.UserID == token.ID {
+		return nil, status.Errorf(codes.FailedPrecondition, "you cannot vote on your own answer")
+	}
+
+	answer, err = server.store.UpvoteAnswerTx(ctx, db.CreateAnswerVoteParams{
+		AnswerID: answer.ID,
+		UserID:   token.ID,
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to record vote: %v", err)
+	}
+
+	return &pb.AnswerResponse{Answer: convertAnswer(answer)}, nil
+}
+
+func (server *Server) FlagQuestion(ctx context.Context, req *pb.FlagQuestionRequest) (*pb.FlagResponse, error) {
+	reason, err := util.ValidateFlagReason(req.GetReason())
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid flag: %v", err)
+	}
+
+	token, err := server.AuthInterceptor(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "Error in Auth Token: %v", err)
+	}
+
+	question, err := server.getVisibleQuestion(ctx, req.GetQuestionId())
+	if err != nil {
+		return nil, err
+	}
+
+	_, err = server.store.FlagQuestionTx(ctx, db.CreateQuestionFlagParams{
+		QuestionID: question.ID,
+		UserID:     token.ID,
+		Reason:     reason,
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to flag question: %v", err)
+	}
+
+	return &pb.FlagResponse{Message: "question flagged for review"}, nil
+}
+
+func (server *Server) FlagAnswer(ctx context.Context, req *pb.FlagAnswerRequest) (*pb.FlagResponse, error) {
+	reason, err := util.ValidateFlagReason(req.GetReason())
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid flag: %v", err)
+	}
+
+	token, err := server.AuthInterceptor(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "Error in Auth Token: %v", err)
+	}
+
+	answer, err := server.getVisibleAnswer(ctx, req.GetAnswerId())
+	if err != nil {
+		return nil, err
+	}
+
+	_, err = server.store.FlagAnswerTx(ctx, db.CreateAnswerFlagParams{
+		AnswerID: answer.ID,
+		UserID:   token.ID,
+		Reason:   reason,
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to flag answer: %v", err)
+	}
+
+	return &pb.FlagResponse{Message: "answer flagged for review"}, nil
+}
+
+func (server *Server) ModerateQuestion(ctx context.Context, req *pb.ModerateQuestionRequest) (*pb.QuestionResponse, error) {
+	if _, err := server.AdminInterceptor(ctx); err != nil {
+		return nil, status.Errorf(codes.PermissionDenied, "%v", err)
+	}
+
+	questionID, err := uuid.Parse(req.GetQuestionId())
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid question ID format")
+	}
+
+	question, err := server.store.SetQuestionHidden(ctx, db.SetQuestionHiddenParams{
+		ID:     questionID,
+		Hidden: req.GetHidden(),
+	})
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, status.Errorf(codes.NotFound, "question not found")
+		}
+		return nil, status.Errorf(codes.Internal, "failed to update question: %v", err)
+	}
+
+	return &pb.QuestionResponse{Question: convertQuestion(question)}, nil
+}
+
+func (server *Server) ModerateAnswer(ctx context.Context, req *pb.ModerateAnswerRequest) (*pb.AnswerResponse, error) {
+	if _, err := server.AdminInterceptor(ctx); err != nil {
+		return nil, status.Errorf(codes.PermissionDenied, "%v", err)
+	}
+
+	answerID, err := uuid.Parse(req.GetAnswerId())
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid answer ID format")
+	}
+
+	answer, err := server.store.SetAnswerHidden(ctx, db.SetAnswerHiddenParams{
+		ID:     answerID,
+		Hidden: req.GetHidden(),
+	})
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, status.Errorf(codes.NotFound, "answer not found")
+		}
+		return nil, status.Errorf(codes.Internal, "failed to update answer: %v", err)
+	}
+
+	return &pb.AnswerResponse{Answer: convertAnswer(answer)}, nil
+}
+
+// getVisibleQuestion fetches a question that has not been hidden by
+// moderation. Hidden questions look like missing ones to everyone.
+func (server *Server) getVisibleQuestion(ctx context.Context, id string) (db.ProductQuestion, error) {
+	questionID, err := uuid.Parse(id)
+	if err != nil {
+		return db.ProductQuestion{}, status.Errorf(codes.InvalidArgument, "invalid question ID format")
+	}
+
+	question, err := server.store.GetQuestionByID(ctx, questionID)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return db.ProductQuestion{}, status.Errorf(codes.NotFound, "question not found")
+		}
+		return db.ProductQuestion{}, status.Errorf(codes.Internal, "failed to fetch question: %v", err)
+	}
+	if question.Hidden {
+		return db.ProductQuestion{}, status.Errorf(codes.NotFound, "question not found")
+	}
+
+	return question, nil
+}
+
+func (server *Server) getVisibleAnswer(ctx context.Context, id string) (db.ProductAnswer, error) {
+	answerID, err := uuid.Parse(id)
+	if err != nil {
+		return db.ProductAnswer{}, status.Errorf(codes.InvalidArgument, "invalid answer ID format")
+	}
+
+	answer, err := server.store.GetAnswerByID(ctx, answerID)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return db.ProductAnswer{}, status.Errorf(codes.NotFound, "answer not found")
+		}
+		return db.ProductAnswer{}, status.Errorf(codes.Internal, "failed to fetch answer: %v", err)
+	}
+	if answer.Hidden {
+		return db.ProductAnswer{}, status.Errorf(codes.NotFound, "answer not found")
+	}
+
+	return answer, nil
+}
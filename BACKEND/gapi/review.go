@@ -0,0 +1,243 @@
+package gapi
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/google/uuid"
+	db "github.com/siddheshRajendraNimbalkar/collage-prject-backend/db/sqlc"
+	"github.com/siddheshRajendraNimbalkar/collage-prject-backend/internal/pagination"
+	"github.com/siddheshRajendraNimbalkar/collage-prject-backend/pb"
+	"github.com/siddheshRajendraNimbalkar/collage-prject-backend/util"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func convertReview(review db.Review) *pb.Review {
+	repliedAt := ""
+	if review.SellerRepliedAt.Valid {
+		repliedAt = review.SellerRepliedAt.Time.Format("2006-01-02 15:04:05")
+	}
+	return &pb.Review{
+		Id:              review.ID.String(),
+		ProductId:       review.ProductID.String(),
+		UserId:          review.UserID.String(),
+		Rating:          review.Rating,
+		Title:           review.Title,
+		Body:            review.Body,
+		Images:          review.Images,
+		HelpfulCount:    review.HelpfulCount,
+		SellerReply:     review.SellerReply.String,
+		SellerRepliedAt: repliedAt,
+		CreatedAt:       review.CreatedAt.Time.Format("2006-01-02 15:04:05"),
+	}
+}
+
+func (server *Server) CreateReview(ctx context.Context, req *pb.CreateReviewRequest) (*pb.ReviewResponse, error) {
+	if err := util.ValidateCreateReviewInput(req); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid review details: %v", err)
+	}
+
+	token, err := server.AuthInterceptor(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "Error in Auth Token: %v", err)
+	}
+
+	productID, err := uuid.Parse(req.GetProductId())
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid product ID format")
+	}
+
+	if _, err := server.store.GetProductByID(ctx, productID); err != nil {
+		if err == sql.ErrNoRows {
+			return nil, status.Errorf(codes.NotFound, "product not found")
+		}
+		return nil, status.Errorf(codes.Internal, "failed to fetch product: %v", err)
+	}
+
+	// Only verified buyers may review a product
+	purchased, err := server.store.HasCompletedOrder(ctx, db.HasCompletedOrderParams{
+		UserID:    uuid.NullUUID{UUID: token.ID, Valid: true},
+		ProductID: uuid.NullUUID{UUID: productID, Valid: true},
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to verify purchase: %v", err)
+	}
+	if !purchased {
+		return nil, status.Errorf(codes.PermissionDenied, "only buyers with a completed order can review this product")
+	}
+
+	images := req.GetImages()
+	if images == nil {
+		images = []string{}
+	}
+
+	review, err := server.store.CreateReviewTx(ctx, db.CreateReviewParams{
+		ProductID: productID,
+		UserID:    token.ID,
+		Rating:    req.GetRating(),
+		Title:     req.GetTitle(),
+		Body:      req.GetBody(),
+		Images:    images,
+	})
+	if err != nil {
+		if isUniqueViolation(err) {
+			return nil, status.Errorf(codes.AlreadyExists, "you have already reviewed this product")
+		}
+		return nil, status.Errorf(codes.Internal, "failed to create review: %v", err)
+	}
+
+	return &pb.ReviewResponse{Review: convertReview(review)}, nil
+}
+
+func (server *Server) ListProductReviews(ctx context.Context, req *pb.ListProductReviewsRequest) (*pb.ListProductReviewsResponse, error) {
+	productID, err := uuid.Parse(req.GetProductId())
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid product ID format")
+	}
+
+	sort := req.GetSort()
+	validSorts := map[string]bool{"": true, "newest": true, "highest": true, "lowest": true, "helpful": true}
+	if !validSorts[sort] {
+		return nil, status.Errorf(codes.InvalidArgument, "sort must be newest, highest, lowest or helpful")
+	}
+
+	scope := "reviews:" + productID.String() + ":" + sort
+	limit := pagination.PageSize(req.GetLimit())
+	after, err := pagination.Decode(scope, req.GetPageToken())
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "%v", err)
+	}
+
+	product, err := server.store.GetProductByID(ctx, productID)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, status.Errorf(codes.NotFound, "product not found")
+		}
+		return nil, status.Errorf(codes.Internal, "failed to fetch product: %v", err)
+	}
+
+	reviews, err := server.store.ListProductReviews(ctx, db.ListProductReviewsParams{
+		ProductID:      productID,
+		Sort:           sort,
+		AfterKey:       after.Key,
+		AfterCreatedAt: after.CreatedAt,
+		AfterID:        after.ID,
+		LimitCount:     limit + 1,
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to list reviews: %v", err)
+	}
+	reviews, nextPageToken := pagination.PageByKey(scope, reviews, limit, func(review db.Review) (int64, sql.NullTime, uuid.UUID) {
+		return reviewSortKey(sort, review), review.CreatedAt, review.ID
+	})
+
+	total, err := server.store.CountProductReviews(ctx, productID)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to count reviews: %v", err)
+	}
+
+	reviewResponses := []*pb.Review{}
+	for _, review := range reviews {
+		reviewResponses = append(reviewResponses, convertReview(review))
+	}
+
+	return &pb.ListProductReviewsResponse{
+		Reviews:       reviewResponses,
+		Total:         total,
+		RatingAverage: parseFloat(product.RatingAverage),
+		RatingCount:   product.RatingCount,
+		NextPageToken: nextPageToken,
+	}, nil
+}
+
+// reviewSortKey is the sort key ListProductReviews orders by before
+// created_at and id.
+func reviewSortKey(sort string, review db.Review) int64 {
+	switch sort {
+	case "highest":
+		return int64(review.Rating)
+	case "lowest":
+		return -int64(review.Rating)
+	case "helpful":
+		return int64(review.HelpfulCount)
+	}
+	return 0
+}
+
+func (server *Server) MarkReviewHelpful(ctx context.Context, req *pb.MarkReviewHelpfulRequest) (*pb.ReviewResponse, error) {
+	token, err := server.AuthInterceptor(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "Error in Auth Token: %v", err)
+	}
+
+	reviewID, err := uuid.Parse(req.GetReviewId())
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid review ID format")
+	}
+
+	review, err := server.store.GetReviewByID(ctx, reviewID)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, status.Errorf(codes.NotFound, "review not found")
+		}
+		return nil, status.Errorf(codes.Internal, "failed to fetch review: %v", err)
+	}
+
+	if review.UserID == token.ID {
+		return nil, status.Errorf(codes.FailedPrecondition, "you cannot vote on your own review")
+	}
+
+	review, err = server.store.MarkReviewHelpfulTx(ctx, db.CreateReviewVoteParams{
+		ReviewID: reviewID,
+		UserID:   token.ID,
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to record vote: %v", err)
+	}
+
+	return &pb.ReviewResponse{Review: convertReview(review)}, nil
+}
+
+func (server *Server) ReplyToReview(ctx context.Context, req *pb.ReplyToReviewRequest) (*pb.ReviewResponse, error) {
+	if err := util.ValidateReplyToReviewInput(req); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid reply: %v", err)
+	}
+
+	token, err := server.AuthInterceptor(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "Error in Auth Token: %v", err)
+	}
+
+	reviewID, err := uuid.Parse(req.GetReviewId())
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid review ID format")
+	}
+
+	review, err := server.store.GetReviewByID(ctx, reviewID)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, status.Errorf(codes.NotFound, "review not found")
+		}
+		return nil, status.Errorf(codes.Internal, "failed to fetch review: %v", err)
+	}
+
+	product, err := server.store.GetProductByIDIncludingDeleted(ctx, review.ProductID)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to fetch product: %v", err)
+	}
+
+	if product.CreatedBy.UUID != token.ID {
+		return nil, status.Errorf(codes.PermissionDenied, "Only the seller can reply to reviews")
+	}
+
+	review, err = server.store.SetSellerReply(ctx, db.SetSellerReplyParams{
+		ID:          reviewID,
+		SellerReply: sql.NullString{String: req.GetReply(), Valid: true},
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to save reply: %v", err)
+	}
+
+	return &pb.ReviewResponse{Review: convertReview(review)}, nil
+}
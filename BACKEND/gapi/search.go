@@ -2,206 +2,243 @@ package gapi
 
 import (
 	"context"
-	"fmt"
-	"strconv"
+	"database/sql"
+	"html"
 	"strings"
-	"time"
 
 	"github.com/google/uuid"
-	"github.com/redis/go-redis/v9"
 	db "github.com/siddheshRajendraNimbalkar/collage-prject-backend/db/sqlc"
+	"github.com/siddheshRajendraNimbalkar/collage-prject-backend/internal/pagination"
+	"github.com/siddheshRajendraNimbalkar/collage-prject-backend/internal/search"
 	"github.com/siddheshRajendraNimbalkar/collage-prject-backend/pb"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 )
 
+const (
+	defaultSearchLanguage  = "english"
+	defaultFuzzyMinResults = 3
+	snippetLength          = 160
+	highlightStart         = "<mark>"
+	highlightStop          = "</mark>"
+)
+
+// fuzzyKeyBase shifts the similarity keys of fuzzy matches below the rank
+// keys of every exact match, so one page token runs through the exact and
+// then the fuzzy matches. A token with a negative key continues in the fuzzy
+// matches.
+const fuzzyKeyBase = 1000001
+
+// searchResult is a product of a search page with its hit and page key.
+type searchResult struct {
+	product db.Product
+	hit     *pb.SearchHit
+	key     int64
+}
+
 func (server *Server) SearchProducts(ctx context.Context, req *pb.SearchProductsRequest) (*pb.SearchProductsResponse, error) {
-	query := strings.ToUpper(strings.TrimSpace(req.GetQuery()))
-	if len(query) < 1 {
-		return &pb.SearchProductsResponse{Products: []*pb.Product{}}, nil
-	}
-
-	results := []*pb.Product{}
-
-	// Use Redis for fast autocomplete if available
-	if server.redis != nil {
-		// Get autocomplete suggestions
-		suggestions := server.getAutocompleteSuggestions(ctx, query)
-		
-		// Convert suggestions to products
-		for _, suggestion := range suggestions {
-			productIDs, err := server.redis.SMembers(ctx, "search:"+suggestion).Result()
-			if err == nil {
-				for _, productID := range productIDs {
-					product, err := server.getProductFromCache(ctx, productID)
-					if err == nil && !containsProduct(results, product) {
-						results = append(results, product)
-						if len(results) >= 10 { // Limit results
-							break
-						}
-					}
-				}
-			}
-			if len(results) >= 10 {
-				break
-			}
-		}
+	// Synonym spellings are searched alongside the query, stop words dropped
+	dict := server.dictionary.Dictionary(ctx)
+	queries := dict.Alternatives(req.GetQuery())
+	if len(queries) == 0 {
+		return &pb.SearchProductsResponse{Products: []*pb.Product{}, Hits: []*pb.SearchHit{}}, nil
+	}
+
+	language, err := server.searchLanguage(ctx, req.GetLanguage())
+	if err != nil {
+		return nil, err
 	}
 
-	// If no Redis results or Redis unavailable, fallback to database
-	if len(results) == 0 {
-		return server.fallbackSearch(ctx, req.GetQuery())
+	scope := pagination.Scope("products:search", language, queries)
+	limit := pagination.PageSize(req.GetLimit())
+	after, err := pagination.Decode(scope, req.GetPageToken())
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "%v", err)
 	}
 
-	return &pb.SearchProductsResponse{Products: results}, nil
-}
+	total, err := server.store.CountSearchProducts(ctx, db.CountSearchProductsParams{
+		Language: language,
+		Queries:  queries,
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to count search results: %v", err)
+	}
 
-func (server *Server) getAutocompleteSuggestions(ctx context.Context, query string) []string {
-	query = strings.ToLower(strings.TrimSpace(query))
-	suggestions := []string{}
-	
-	// Get matches that start with the query
-	matches, err := server.redis.ZRangeByScore(ctx, "autocomplete", &redis.ZRangeBy{
-		Min: fmt.Sprintf("[%s", query),
-		Max: fmt.Sprintf("[%s\xff", query),
-		Offset: 0,
-		Count: 50,
-	}).Result()
-	
-	if err == nil {
-		// Prioritize complete sentences (with *) first
-		completeSentences := []string{}
-		partialMatches := []string{}
-		
-		for _, match := range matches {
-			if strings.HasPrefix(match, query) {
-				if strings.HasSuffix(match, "*") {
-					completeSentences = append(completeSentences, match)
-				} else {
-					partialMatches = append(partialMatches, match)
-				}
-			}
+	resp := &pb.SearchProductsResponse{
+		Products: []*pb.Product{},
+		Total:    total,
+		Hits:     []*pb.SearchHit{},
+	}
+
+	results := []searchResult{}
+	if !after.Key.Valid || after.Key.Int64 >= 0 {
+		rows, err := server.store.SearchProducts(ctx, db.SearchProductsParams{
+			Language:       language,
+			Queries:        queries,
+			AfterKey:       after.Key,
+			AfterCreatedAt: after.CreatedAt,
+			AfterID:        after.ID,
+			LimitCount:     limit + 1,
+		})
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to search products: %v", err)
+		}
+
+		for _, row := range rows {
+			results = append(results, searchResult{
+				product: row.Product,
+				key:     row.RankKey,
+				hit: &pb.SearchHit{
+					ProductId:     row.Product.ID.String(),
+					Rank:          row.Rank,
+					NameHighlight: escapeHighlight(row.NameHighlight),
+					Snippet:       escapeHighlight(row.Snippet),
+				},
+			})
+		}
+	}
+
+	// Few exact matches usually mean a typo, similar names are listed after
+	// every exact match and the query is checked against the vocabulary
+	if total < int64(server.fuzzyMinResults()) {
+		results, err = server.addFuzzyMatches(ctx, resp, results, language, queries, after, limit+1)
+		if err != nil {
+			return nil, err
 		}
-		
-		// Return complete sentences first, then partial matches
-		suggestions = append(suggestions, completeSentences...)
-		suggestions = append(suggestions, partialMatches...)
 	}
-	
-	return suggestions
+
+	results, resp.NextPageToken = pagination.PageByKey(scope, results, limit, func(result searchResult) (int64, sql.NullTime, uuid.UUID) {
+		return result.key, result.product.CreatedAt, result.product.ID
+	})
+	for _, result := range results {
+		resp.Products = append(resp.Products, convertProduct(result.product))
+		resp.Hits = append(resp.Hits, result.hit)
+	}
+	server.enrichProducts(ctx, resp.Products...)
+
+	// Paging through the results is the same search
+	if req.GetPageToken() == "" {
+		resp.SearchId = server.analytics.LogQuery(ctx, search.SourceSearch, req.GetQuery(), int(resp.Total))
+	}
+
+	return resp, nil
 }
 
-func (server *Server) getProductFromCache(ctx context.Context, productID string) (*pb.Product, error) {
-	// Try to get from Redis cache first
-	productData, err := server.redis.HGetAll(ctx, "product:"+productID).Result()
-	if err == nil && len(productData) > 0 {
-		// Convert cached data to Product
-		price, _ := strconv.ParseFloat(productData["price"], 64)
-		stock, _ := strconv.ParseInt(productData["stock"], 10, 32)
-		
-		return &pb.Product{
-			Id:          productData["id"],
-			Name:        productData["name"],
-			Description: productData["description"],
-			Price:       price,
-			Stock:       int32(stock),
-			CreatedBy:   productData["created_by"],
-			CreatedAt:   productData["created_at"],
-			ProductUrl:  productData["product_url"],
-			Category:    productData["category"],
-			Type:        productData["type"],
-		}, nil
-	}
-	
-	// Fallback to database
-	productUUID, err := uuid.Parse(productID)
+// addFuzzyMatches appends trigram matches to the exact matches of a page,
+// up to size results in all, and adds them to the total.
+func (server *Server) addFuzzyMatches(ctx context.Context, resp *pb.SearchProductsResponse, results []searchResult, language string, queries []string, after pagination.After, size int32) ([]searchResult, error) {
+	// A token from the exact matches starts at the first fuzzy match
+	fuzzyAfter := pagination.After{}
+	if after.Key.Valid && after.Key.Int64 < 0 {
+		fuzzyAfter = after
+		fuzzyAfter.Key.Int64 += fuzzyKeyBase
+	}
+
+	fuzzy, err := server.store.FuzzySearchTx(ctx, server.similarityThreshold(), db.FuzzySearchProductsParams{
+		Query:          queries[0],
+		Queries:        queries,
+		Language:       language,
+		AfterKey:       fuzzyAfter.Key,
+		AfterCreatedAt: fuzzyAfter.CreatedAt,
+		AfterID:        fuzzyAfter.ID,
+		LimitCount:     max(size-int32(len(results)), 0),
+	})
 	if err != nil {
-		return nil, err
+		return nil, status.Errorf(codes.Internal, "failed to search similar products: %v", err)
+	}
+
+	resp.Total += fuzzy.Total
+	for _, row := range fuzzy.Rows {
+		results = append(results, searchResult{
+			product: row.Product,
+			key:     row.SimilarityKey - fuzzyKeyBase,
+			hit: &pb.SearchHit{
+				ProductId:     row.Product.ID.String(),
+				Rank:          row.Similarity,
+				NameHighlight: html.EscapeString(row.Product.Name),
+				Snippet:       html.EscapeString(truncateRunes(row.Product.Description, snippetLength)),
+				Fuzzy:         true,
+			},
+		})
+	}
+
+	resp.DidYouMean, err = server.didYouMean(ctx, queries[0])
+	return results, err
+}
+
+// didYouMean rewrites the query with every unknown word replaced by the
+// closest word used in products. It is empty when nothing was replaced.
+func (server *Server) didYouMean(ctx context.Context, query string) (string, error) {
+	terms := search.Tokens(query)
+	if len(terms) == 0 {
+		return "", nil
 	}
-	
-	product, err := server.store.GetProductByID(ctx, productUUID)
+
+	corrections, err := server.store.SpellingSuggestionsTx(ctx, server.similarityThreshold(), terms)
 	if err != nil {
-		return nil, err
+		return "", status.Errorf(codes.Internal, "failed to suggest spelling: %v", err)
 	}
-	
-	// Cache the product for future use
-	server.cacheProduct(ctx, product)
-	
-	return &pb.Product{
-		Id:          product.ID.String(),
-		Name:        product.Name,
-		Description: product.Description,
-		Price:       parseFloat(product.Price),
-		Stock:       product.Stock,
-		CreatedBy:   product.CreatedBy.UUID.String(),
-		CreatedAt:   product.CreatedAt.Time.Format("2006-01-02 15:04:05"),
-		ProductUrl:  product.ProductUrl,
-		Category:    product.Category,
-		Type:        product.Type,
-	}, nil
+	if len(corrections) == 0 {
+		return "", nil
+	}
+
+	for i, term := range terms {
+		if word, ok := corrections[term]; ok {
+			terms[i] = word
+		}
+	}
+	return strings.Join(terms, " "), nil
 }
 
-func (server *Server) cacheProduct(ctx context.Context, product db.Product) {
-	if server.redis == nil {
-		return
-	}
-	
-	productData := map[string]interface{}{
-		"id":          product.ID.String(),
-		"name":        product.Name,
-		"description": product.Description,
-		"price":       product.Price,
-		"stock":       product.Stock,
-		"created_by":  product.CreatedBy.UUID.String(),
-		"created_at":  product.CreatedAt.Time.Format("2006-01-02 15:04:05"),
-		"product_url": product.ProductUrl,
-		"category":    product.Category,
-		"type":        product.Type,
-	}
-	
-	server.redis.HMSet(ctx, "product:"+product.ID.String(), productData)
-	server.redis.Expire(ctx, "product:"+product.ID.String(), time.Hour*24) // Cache for 24 hours
+func (server *Server) similarityThreshold() float64 {
+	threshold := server.config.SimilarityThreshold
+	if threshold <= 0 || threshold > 1 {
+		threshold = search.DefaultSimilarityThreshold
+	}
+	return threshold
 }
 
-func (server *Server) fallbackSearch(ctx context.Context, query string) (*pb.SearchProductsResponse, error) {
-	products, err := server.store.GetProductByName(ctx, query)
-	if err != nil {
-		return nil, status.Errorf(codes.Internal, "failed to search products: %v", err)
-	}
-
-	results := []*pb.Product{}
-	for _, product := range products {
-		results = append(results, &pb.Product{
-			Id:          product.ID.String(),
-			Name:        product.Name,
-			Description: product.Description,
-			Price:       parseFloat(product.Price),
-			CreatedBy:   product.CreatedBy.UUID.String(),
-			CreatedAt:   product.CreatedAt.Time.Format("2006-01-02 15:04:05"),
-			ProductUrl:  product.ProductUrl,
-			Category:    product.Category,
-			Type:        product.Type,
-			Stock:       product.Stock,
-		})
+func (server *Server) fuzzyMinResults() int {
+	if server.config.FuzzyMinResults <= 0 {
+		return defaultFuzzyMinResults
 	}
+	return server.config.FuzzyMinResults
+}
 
-	return &pb.SearchProductsResponse{Products: results}, nil
+// searchLanguage resolves the text search configuration for a request, only
+// configurations with indexed documents can be searched
+func (server *Server) searchLanguage(ctx context.Context, language string) (string, error) {
+	language = strings.ToLower(strings.TrimSpace(language))
+	if language == "" {
+		language = server.config.SearchLanguage
+	}
+	if language == "" {
+		language = defaultSearchLanguage
+	}
+
+	exists, err := server.store.SearchLanguageExists(ctx, language)
+	if err != nil {
+		return "", status.Errorf(codes.Internal, "failed to check search language: %v", err)
+	}
+	if !exists {
+		return "", status.Errorf(codes.InvalidArgument, "unsupported search language: %s", language)
+	}
+	return language, nil
 }
 
-func (server *Server) getProductByIDString(ctx context.Context, productIDStr string) (*pb.Product, error) {
-	// This would need to be implemented based on your existing GetProductByID logic
-	// For now, return a placeholder
-	return &pb.Product{
-		Id:   productIDStr,
-		Name: "Sample Product",
-	}, nil
+// escapeHighlight HTML escapes a ts_headline result while keeping the
+// highlight markers so clients can render it as is
+func escapeHighlight(headline string) string {
+	escaped := html.EscapeString(headline)
+	escaped = strings.ReplaceAll(escaped, html.EscapeString(highlightStart), highlightStart)
+	return strings.ReplaceAll(escaped, html.EscapeString(highlightStop), highlightStop)
 }
 
-func containsProduct(products []*pb.Product, product *pb.Product) bool {
-	for _, p := range products {
-		if p.Id == product.Id {
-			return true
-		}
+// truncateRunes shortens s to at most n runes, marking the cut with an ellipsis.
+func truncateRunes(s string, n int) string {
+	runes := []rune(s)
+	if len(runes) <= n {
+		return s
 	}
-	return false
-}
\ No newline at end of file
+	return strings.TrimSpace(string(runes[:n])) + "…"
+}
@@ -0,0 +1,192 @@
+package gapi
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	db "github.com/siddheshRajendraNimbalkar/collage-prject-backend/db/sqlc"
+	"github.com/siddheshRajendraNimbalkar/collage-prject-backend/internal/search"
+	"github.com/siddheshRajendraNimbalkar/collage-prject-backend/pb"
+	"github.com/siddheshRajendraNimbalkar/collage-prject-backend/util"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	defaultAnalyticsPeriod = 30 * 24 * time.Hour
+	maxAnalyticsPeriod     = 366 * 24 * time.Hour
+)
+
+// RecordSearchClick is sent by clients when a search result is opened. It
+// needs no auth token, a search is logged without its user.
+func (server *Server) RecordSearchClick(ctx context.Context, req *pb.RecordSearchClickRequest) (*pb.RecordSearchClickResponse, error) {
+	if err := util.ValidateRecordSearchClickInput(req); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid search click: %v", err)
+	}
+
+	searchID, err := uuid.Parse(req.GetSearchId())
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid search ID format")
+	}
+	productID, err := uuid.Parse(req.GetProductId())
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid product ID format")
+	}
+
+	err = server.store.LogSearchClick(ctx, db.LogSearchClickParams{
+		SearchQueryID: searchID,
+		ProductID:     productID,
+		Position:      req.GetPosition(),
+	})
+	if err != nil {
+		if isForeignKeyViolation(err) {
+			return nil, status.Errorf(codes.NotFound, "search or product not found")
+		}
+		return nil, status.Errorf(codes.Internal, "failed to record search click: %v", err)
+	}
+
+	return &pb.RecordSearchClickResponse{Message: "Search click recorded"}, nil
+}
+
+func (server *Server) TopQueries(ctx context.Context, req *pb.SearchAnalyticsRequest) (*pb.TopQueriesResponse, error) {
+	from, to, limit, err := server.searchAnalyticsRequest(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	rows, err := server.store.TopSearchQueries(ctx, db.TopSearchQueriesParams{
+		FromTime:   from,
+		ToTime:     to,
+		Source:     reportSource(req),
+		LimitCount: limit,
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to list top queries: %v", err)
+	}
+
+	resp := &pb.TopQueriesResponse{Queries: []*pb.SearchQueryStat{}}
+	for _, row := range rows {
+		resp.Queries = append(resp.Queries, &pb.SearchQueryStat{
+			Query:           row.Query,
+			Searches:        row.Searches,
+			AvgResults:      row.AvgResults,
+			ClickedSearches: row.ClickedSearches,
+			Ctr:             clickThroughRate(row.ClickedSearches, row.Searches),
+		})
+	}
+	return resp, nil
+}
+
+func (server *Server) ZeroResultQueries(ctx context.Context, req *pb.SearchAnalyticsRequest) (*pb.ZeroResultQueriesResponse, error) {
+	from, to, limit, err := server.searchAnalyticsRequest(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	rows, err := server.store.ZeroResultSearchQueries(ctx, db.ZeroResultSearchQueriesParams{
+		FromTime:   from,
+		ToTime:     to,
+		Source:     reportSource(req),
+		LimitCount: limit,
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to list zero result queries: %v", err)
+	}
+
+	resp := &pb.ZeroResultQueriesResponse{Queries: []*pb.ZeroResultQuery{}}
+	for _, row := range rows {
+		resp.Queries = append(resp.Queries, &pb.ZeroResultQuery{
+			Query:          row.Query,
+			Searches:       row.Searches,
+			LastSearchedAt: row.LastSearchedAt.Format("2006-01-02 15:04:05"),
+		})
+	}
+	return resp, nil
+}
+
+func (server *Server) SearchCTR(ctx context.Context, req *pb.SearchAnalyticsRequest) (*pb.SearchCTRResponse, error) {
+	from, to, _, err := server.searchAnalyticsRequest(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	row, err := server.store.SearchClickThrough(ctx, db.SearchClickThroughParams{
+		FromTime: from,
+		ToTime:   to,
+		Source:   reportSource(req),
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to compute click-through rate: %v", err)
+	}
+
+	return &pb.SearchCTRResponse{
+		Searches:        row.Searches,
+		ClickedSearches: row.ClickedSearches,
+		Clicks:          row.Clicks,
+		Ctr:             clickThroughRate(row.ClickedSearches, row.Searches),
+	}, nil
+}
+
+// searchAnalyticsRequest checks the caller is an admin and resolves the
+// period of a report to [from, to), to being the day after the last one.
+func (server *Server) searchAnalyticsRequest(ctx context.Context, req *pb.SearchAnalyticsRequest) (time.Time, time.Time, int32, error) {
+	if _, err := server.AdminInterceptor(ctx); err != nil {
+		return time.Time{}, time.Time{}, 0, status.Errorf(codes.PermissionDenied, "%v", err)
+	}
+
+	if err := util.ValidateSearchAnalyticsInput(req); err != nil {
+		return time.Time{}, time.Time{}, 0, status.Errorf(codes.InvalidArgument, "invalid report request: %v", err)
+	}
+
+	to := time.Now().UTC().Truncate(24 * time.Hour).Add(24 * time.Hour)
+	if req.GetTo() != "" {
+		day, err := time.Parse("2006-01-02", req.GetTo())
+		if err != nil {
+			return time.Time{}, time.Time{}, 0, status.Errorf(codes.InvalidArgument, "invalid end date: use YYYY-MM-DD")
+		}
+		to = day.Add(24 * time.Hour)
+	}
+
+	from := to.Add(-defaultAnalyticsPeriod)
+	if req.GetFrom() != "" {
+		day, err := time.Parse("2006-01-02", req.GetFrom())
+		if err != nil {
+			return time.Time{}, time.Time{}, 0, status.Errorf(codes.InvalidArgument, "invalid start date: use YYYY-MM-DD")
+		}
+		from = day
+	}
+
+	if !from.Before(to) {
+		return time.Time{}, time.Time{}, 0, status.Errorf(codes.InvalidArgument, "start date must not be after the end date")
+	}
+	if to.Sub(from) > maxAnalyticsPeriod {
+		return time.Time{}, time.Time{}, 0, status.Errorf(codes.InvalidArgument, "period must not exceed a year")
+	}
+
+	limit := req.GetLimit()
+	if limit <= 0 || limit > 100 {
+		limit = 20
+	}
+	return from, to, limit, nil
+}
+
+// reportSource is the source filter of a report, empty counting every
+// source. Autocomplete prefixes would crowd out full queries, so reports
+// cover searches unless another source is asked for.
+func reportSource(req *pb.SearchAnalyticsRequest) string {
+	switch req.GetSource() {
+	case "":
+		return search.SourceSearch
+	case "all":
+		return ""
+	}
+	return req.GetSource()
+}
+
+func clickThroughRate(clicked, searches int64) float64 {
+	if searches == 0 {
+		return 0
+	}
+	return float64(clicked) / float64(searches)
+}
@@ -0,0 +1,167 @@
+package gapi
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	db "github.com/siddheshRajendraNimbalkar/collage-prject-backend/db/sqlc"
+	"github.com/siddheshRajendraNimbalkar/collage-prject-backend/internal/search"
+	"github.com/siddheshRajendraNimbalkar/collage-prject-backend/pb"
+	"github.com/siddheshRajendraNimbalkar/collage-prject-backend/util"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// Dictionary changes reach queries once the search.DictionaryCache reloads,
+// and the index once the outbox worker has re-indexed published products.
+
+func convertSynonymGroup(group db.SearchSynonymGroup) *pb.SynonymGroup {
+	createdBy := ""
+	if group.CreatedBy.Valid {
+		createdBy = group.CreatedBy.UUID.String()
+	}
+	return &pb.SynonymGroup{
+		Id:        group.ID.String(),
+		Terms:     group.Terms,
+		CreatedBy: createdBy,
+		CreatedAt: group.CreatedAt.Time.Format("2006-01-02 15:04:05"),
+	}
+}
+
+func (server *Server) GetSearchDictionary(ctx context.Context, req *pb.GetSearchDictionaryRequest) (*pb.SearchDictionaryResponse, error) {
+	if _, err := server.AdminInterceptor(ctx); err != nil {
+		return nil, status.Errorf(codes.PermissionDenied, "%v", err)
+	}
+
+	groups, err := server.store.ListSynonymGroups(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to list synonym groups: %v", err)
+	}
+
+	stopWords, err := server.store.ListStopWords(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to list stop words: %v", err)
+	}
+
+	resp := &pb.SearchDictionaryResponse{
+		SynonymGroups: []*pb.SynonymGroup{},
+		StopWords:     stopWords,
+	}
+	for _, group := range groups {
+		resp.SynonymGroups = append(resp.SynonymGroups, convertSynonymGroup(group))
+	}
+	return resp, nil
+}
+
+func (server *Server) CreateSynonymGroup(ctx context.Context, req *pb.CreateSynonymGroupRequest) (*pb.SynonymGroupResponse, error) {
+	admin, err := server.AdminInterceptor(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.PermissionDenied, "%v", err)
+	}
+
+	if err := util.ValidateCreateSynonymGroupInput(req); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid synonym group: %v", err)
+	}
+
+	// Terms are stored the way they are matched, duplicates collapse
+	terms := []string{}
+	seen := map[string]bool{}
+	for _, term := range req.GetTerms() {
+		term = search.Normalize(term)
+		if term == "" || seen[term] {
+			continue
+		}
+		seen[term] = true
+		terms = append(terms, term)
+	}
+	if len(terms) < 2 {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid synonym group: a synonym group needs at least two distinct terms")
+	}
+
+	group, err := server.store.CreateSynonymGroup(ctx, db.CreateSynonymGroupParams{
+		Terms:     terms,
+		CreatedBy: uuid.NullUUID{UUID: admin.ID, Valid: true},
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to create synonym group: %v", err)
+	}
+
+	return &pb.SynonymGroupResponse{SynonymGroup: convertSynonymGroup(group)}, nil
+}
+
+func (server *Server) DeleteSynonymGroup(ctx context.Context, req *pb.DeleteSynonymGroupRequest) (*pb.SearchDictionaryMessageResponse, error) {
+	if _, err := server.AdminInterceptor(ctx); err != nil {
+		return nil, status.Errorf(codes.PermissionDenied, "%v", err)
+	}
+
+	groupID, err := uuid.Parse(req.GetId())
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid synonym group ID format")
+	}
+
+	deleted, err := server.store.DeleteSynonymGroup(ctx, groupID)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to delete synonym group: %v", err)
+	}
+	if deleted == 0 {
+		return nil, status.Errorf(codes.NotFound, "synonym group not found")
+	}
+
+	return &pb.SearchDictionaryMessageResponse{Message: "Synonym group deleted successfully"}, nil
+}
+
+func (server *Server) AddStopWord(ctx context.Context, req *pb.StopWordRequest) (*pb.SearchDictionaryMessageResponse, error) {
+	if _, err := server.AdminInterceptor(ctx); err != nil {
+		return nil, status.Errorf(codes.PermissionDenied, "%v", err)
+	}
+
+	word, err := stopWord(req)
+	if err != nil {
+		return nil, err
+	}
+
+	added, err := server.store.AddStopWord(ctx, word)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to add stop word: %v", err)
+	}
+	if added == 0 {
+		return nil, status.Errorf(codes.AlreadyExists, "stop word already exists")
+	}
+
+	return &pb.SearchDictionaryMessageResponse{Message: "Stop word added successfully"}, nil
+}
+
+func (server *Server) RemoveStopWord(ctx context.Context, req *pb.StopWordRequest) (*pb.SearchDictionaryMessageResponse, error) {
+	if _, err := server.AdminInterceptor(ctx); err != nil {
+		return nil, status.Errorf(codes.PermissionDenied, "%v", err)
+	}
+
+	word, err := stopWord(req)
+	if err != nil {
+		return nil, err
+	}
+
+	removed, err := server.store.RemoveStopWord(ctx, word)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to remove stop word: %v", err)
+	}
+	if removed == 0 {
+		return nil, status.Errorf(codes.NotFound, "stop word not found")
+	}
+
+	return &pb.SearchDictionaryMessageResponse{Message: "Stop word removed successfully"}, nil
+}
+
+// stopWord validates and normalizes the word of a stop word request, a word
+// that normalizes into several tokens can't match one
+func stopWord(req *pb.StopWordRequest) (string, error) {
+	if err := util.ValidateStopWordInput(req); err != nil {
+		return "", status.Errorf(codes.InvalidArgument, "invalid stop word: %v", err)
+	}
+
+	tokens := search.Tokens(req.GetWord())
+	if len(tokens) != 1 {
+		return "", status.Errorf(codes.InvalidArgument, "invalid stop word: stop words must be a single word")
+	}
+	return tokens[0], nil
+}
@@ -1,13 +1,12 @@
 package gapi
 
 import (
-	"context"
 	"fmt"
-	"log"
 
-	"github.com/redis/go-redis/v9"
 	db "github.com/siddheshRajendraNimbalkar/collage-prject-backend/db/sqlc"
-	redisClient "github.com/siddheshRajendraNimbalkar/collage-prject-backend/internal/redis"
+	"github.com/siddheshRajendraNimbalkar/collage-prject-backend/internal/moderation"
+	"github.com/siddheshRajendraNimbalkar/collage-prject-backend/internal/search"
+	"github.com/siddheshRajendraNimbalkar/collage-prject-backend/internal/storage"
 	"github.com/siddheshRajendraNimbalkar/collage-prject-backend/pb"
 	"github.com/siddheshRajendraNimbalkar/collage-prject-backend/token"
 	"github.com/siddheshRajendraNimbalkar/collage-prject-backend/util"
@@ -20,49 +19,47 @@ type Server struct {
 	config     util.Config
 	store      *db.SQLStore
 	tokenMaker *token.PastoMaker
-	redis      *redis.Client
+	urlSigner  *token.URLSigner
+	storage    storage.Storage
+	screener   *moderation.Screener
+	searcher   search.Searcher
+	dictionary search.DictionarySource
+	analytics  *search.Analytics
 }
 
-func NewServer(config util.Config, store *db.SQLStore) (*Server, error) {
+// NewServer takes the searcher and dictionaries main shares with the search
+// workers and the autocomplete endpoint.
+func NewServer(config util.Config, store *db.SQLStore, searcher search.Searcher, dictionaries search.DictionarySource) (*Server, error) {
 	tokenMaker, err := token.NewMaker(config.SecretKey)
 	if err != nil {
 		err := fmt.Errorf("tokenMaker %s", err.Error())
 		return nil, err
 	}
 
-	// Initialize Redis client (optional)
-	var client *redis.Client
-	redisURL := config.RedisURL
-	if redisURL != "" {
-		opt, err := redis.ParseURL(redisURL)
-		if err == nil {
-			client = redis.NewClient(opt)
-			ctx := context.Background()
-			_, err = client.Ping(ctx).Result()
-			if err != nil {
-				log.Printf("Redis connection failed: %v, continuing without Redis", err)
-				client = nil
-			} else {
-				log.Println("Redis connected successfully")
-			}
-		} else {
-			log.Printf("Error parsing Redis URL: %v, continuing without Redis", err)
-		}
+	urlSigner, err := token.NewURLSigner(config.SecretKey)
+	if err != nil {
+		return nil, fmt.Errorf("urlSigner %s", err.Error())
 	}
 
-	// Initialize Redis for autocomplete
-	redisAddr := "localhost:6379"
-	if config.RedisURL != "" {
-		redisAddr = config.RedisURL
+	storageDir := config.StorageDir
+	if storageDir == "" {
+		storageDir = "uploads"
+	}
+	assetStorage, err := storage.NewLocalStorage(storageDir)
+	if err != nil {
+		return nil, err
 	}
-	// Initialize the global Redis client
-	redisClient.InitRedis(redisAddr)
 
 	server := &Server{
 		config:     config,
 		store:      store,
 		tokenMaker: tokenMaker,
-		redis:      client,
+		urlSigner:  urlSigner,
+		storage:    assetStorage,
+		screener:   moderation.NewScreener(config.BannedWords, config.BlockedHosts),
+		searcher:   searcher,
+		dictionary: dictionaries,
+		analytics:  search.NewAnalytics(store),
 	}
 
 	return server, nil
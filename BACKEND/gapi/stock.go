@@ -0,0 +1,180 @@
+package gapi
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/google/uuid"
+	db "github.com/siddheshRajendraNimbalkar/collage-prject-backend/db/sqlc"
+	"github.com/siddheshRajendraNimbalkar/collage-prject-backend/internal/pagination"
+	"github.com/siddheshRajendraNimbalkar/collage-prject-backend/pb"
+	"github.com/siddheshRajendraNimbalkar/collage-prject-backend/util"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func convertStockMovement(movement db.StockMovement) *pb.StockMovement {
+	actorID := ""
+	if movement.ActorID.Valid {
+		actorID = movement.ActorID.UUID.String()
+	}
+	referenceID := ""
+	if movement.ReferenceID.Valid {
+		referenceID = movement.ReferenceID.UUID.String()
+	}
+	return &pb.StockMovement{
+		Id:          movement.ID.String(),
+		ProductId:   movement.ProductID.String(),
+		Delta:       movement.Delta,
+		StockAfter:  movement.StockAfter,
+		Reason:      movement.Reason,
+		ActorId:     actorID,
+		ReferenceId: referenceID,
+		Note:        movement.Note,
+		CreatedAt:   movement.CreatedAt.Time.Format("2006-01-02 15:04:05"),
+	}
+}
+
+func (server *Server) AdjustStock(ctx context.Context, req *pb.AdjustStockRequest) (*pb.AdjustStockResponse, error) {
+	if err := util.ValidateAdjustStockInput(req); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid stock adjustment: %v", err)
+	}
+
+	token, err := server.AuthInterceptor(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "Error in Auth Token: %v", err)
+	}
+
+	referenceID, err := parseOptionalUUID(req.GetReferenceId())
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid reference ID format")
+	}
+
+	product, err := server.getOwnedProduct(ctx, req.GetProductId(), false)
+	if err != nil {
+		return nil, err
+	}
+
+	if product.Kind == db.ProductKindBundle {
+		return nil, status.Errorf(codes.FailedPrecondition, "bundle stock is derived from its components, adjust those instead")
+	}
+	if product.Kind == db.ProductKindDigital {
+		return nil, status.Errorf(codes.FailedPrecondition, "digital products do not hold stock")
+	}
+
+	reason := req.GetReason()
+	if reason == "" {
+		reason = db.StockReasonManualAdjustment
+	}
+
+	product, movement, err := server.store.AdjustStockTx(ctx, db.StockMovementParams{
+		ProductID:   product.ID,
+		Delta:       req.GetDelta(),
+		Reason:      reason,
+		ActorID:     uuid.NullUUID{UUID: token.ID, Valid: true},
+		ReferenceID: referenceID,
+		Note:        req.GetNote(),
+	})
+	if err != nil {
+		if err.Error() == "insufficient stock" {
+			return nil, status.Errorf(codes.FailedPrecondition, "stock cannot go below zero")
+		}
+		return nil, status.Errorf(codes.Internal, "failed to adjust stock: %v", err)
+	}
+
+	resp := &pb.AdjustStockResponse{
+		Product:  convertProduct(product),
+		Movement: convertStockMovement(movement),
+	}
+	server.enrichProducts(ctx, resp.Product)
+
+	return resp, nil
+}
+
+func (server *Server) ListStockMovements(ctx context.Context, req *pb.ListStockMovementsRequest) (*pb.ListStockMovementsResponse, error) {
+	// Deleted products keep their history
+	product, err := server.getOwnedProduct(ctx, req.GetProductId(), true)
+	if err != nil {
+		return nil, err
+	}
+
+	scope := "stock_movements:" + product.ID.String()
+	limit := pagination.PageSize(req.GetLimit())
+	after, err := pagination.Decode(scope, req.GetPageToken())
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "%v", err)
+	}
+
+	movements, err := server.store.ListStockMovements(ctx, db.ListStockMovementsParams{
+		ProductID:      product.ID,
+		AfterCreatedAt: after.CreatedAt,
+		AfterID:        after.ID,
+		LimitCount:     limit + 1,
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to list stock movements: %v", err)
+	}
+	movements, nextPageToken := pagination.Page(scope, movements, limit, func(movement db.StockMovement) (sql.NullTime, uuid.UUID) {
+		return movement.CreatedAt, movement.ID
+	})
+
+	movementResponses := []*pb.StockMovement{}
+	for _, movement := range movements {
+		movementResponses = append(movementResponses, convertStockMovement(movement))
+	}
+
+	return &pb.ListStockMovementsResponse{Movements: movementResponses, NextPageToken: nextPageToken}, nil
+}
+
+func (server *Server) SetLowStockThreshold(ctx context.Context, req *pb.SetLowStockThresholdRequest) (*pb.ProductResponse, error) {
+	if req.GetThreshold() < 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "threshold cannot be negative")
+	}
+
+	product, err := server.getOwnedProduct(ctx, req.GetProductId(), false)
+	if err != nil {
+		return nil, err
+	}
+
+	product, err = server.store.SetLowStockThreshold(ctx, db.SetLowStockThresholdParams{
+		ID:                product.ID,
+		LowStockThreshold: req.GetThreshold(),
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to update threshold: %v", err)
+	}
+
+	return server.productResponse(ctx, product), nil
+}
+
+// ListLowStockProducts lists the caller's products at or below their
+// threshold, emptiest first.
+func (server *Server) ListLowStockProducts(ctx context.Context, req *pb.ListLowStockProductsRequest) (*pb.ListLowStockProductsResponse, error) {
+	token, err := server.AuthInterceptor(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "Error in Auth Token: %v", err)
+	}
+
+	const scope = "products:low_stock"
+	limit := pagination.PageSize(req.GetLimit())
+	after, err := pagination.Decode(scope, req.GetPageToken())
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "%v", err)
+	}
+
+	products, err := server.store.ListLowStockProducts(ctx, db.ListLowStockProductsParams{
+		CreatedBy:      uuid.NullUUID{UUID: token.ID, Valid: true},
+		AfterKey:       after.Key,
+		AfterCreatedAt: after.CreatedAt,
+		AfterID:        after.ID,
+		LimitCount:     limit + 1,
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to list low stock products: %v", err)
+	}
+	products, nextPageToken := pagination.PageByKey(scope, products, limit, func(product db.Product) (int64, sql.NullTime, uuid.UUID) {
+		return -int64(product.Stock), product.CreatedAt, product.ID
+	})
+
+	return &pb.ListLowStockProductsResponse{Products: convertProducts(products), NextPageToken: nextPageToken}, nil
+}
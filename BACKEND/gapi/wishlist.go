@@ -0,0 +1,116 @@
+package gapi
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/google/uuid"
+	db "github.com/siddheshRajendraNimbalkar/collage-prject-backend/db/sqlc"
+	"github.com/siddheshRajendraNimbalkar/collage-prject-backend/internal/pagination"
+	"github.com/siddheshRajendraNimbalkar/collage-prject-backend/pb"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (server *Server) AddToWishlist(ctx context.Context, req *pb.AddToWishlistRequest) (*pb.WishlistResponse, error) {
+	token, err := server.AuthInterceptor(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "Error in Auth Token: %v", err)
+	}
+
+	productID, err := uuid.Parse(req.GetProductId())
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid product ID format")
+	}
+
+	// Out of stock products can be saved, that is what back in stock alerts are for
+	product, err := server.store.GetProductByID(ctx, productID)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, status.Errorf(codes.NotFound, "product not found")
+		}
+		return nil, status.Errorf(codes.Internal, "failed to fetch product: %v", err)
+	}
+	if product.Status != productStatusPublished {
+		return nil, status.Errorf(codes.NotFound, "product not found")
+	}
+
+	_, err = server.store.AddToWishlist(ctx, db.AddToWishlistParams{
+		UserID:    token.ID,
+		ProductID: productID,
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to add item to wishlist: %v", err)
+	}
+
+	return &pb.WishlistResponse{Message: "Item added to wishlist successfully"}, nil
+}
+
+func (server *Server) RemoveFromWishlist(ctx context.Context, req *pb.RemoveFromWishlistRequest) (*pb.WishlistResponse, error) {
+	token, err := server.AuthInterceptor(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "Error in Auth Token: %v", err)
+	}
+
+	productID, err := uuid.Parse(req.GetProductId())
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid product ID format")
+	}
+
+	removed, err := server.store.RemoveFromWishlist(ctx, db.RemoveFromWishlistParams{
+		UserID:    token.ID,
+		ProductID: productID,
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to remove wishlist item: %v", err)
+	}
+	if removed == 0 {
+		return nil, status.Errorf(codes.NotFound, "wishlist item not found")
+	}
+
+	return &pb.WishlistResponse{Message: "Item removed from wishlist successfully"}, nil
+}
+
+func (server *Server) ListWishlist(ctx context.Context, req *pb.ListWishlistRequest) (*pb.ListWishlistResponse, error) {
+	token, err := server.AuthInterceptor(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "Error in Auth Token: %v", err)
+	}
+
+	const scope = "wishlist"
+	limit := pagination.PageSize(req.GetLimit())
+	after, err := pagination.Decode(scope, req.GetPageToken())
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "%v", err)
+	}
+
+	rows, err := server.store.ListWishlist(ctx, db.ListWishlistParams{
+		UserID:         token.ID,
+		AfterCreatedAt: after.CreatedAt,
+		AfterID:        after.ID,
+		LimitCount:     limit + 1,
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to fetch wishlist: %v", err)
+	}
+	rows, nextPageToken := pagination.Page(scope, rows, limit, func(row db.ListWishlistRow) (sql.NullTime, uuid.UUID) {
+		return row.WishlistItem.CreatedAt, row.WishlistItem.ID
+	})
+
+	items := []*pb.WishlistItem{}
+	for _, row := range rows {
+		items = append(items, &pb.WishlistItem{
+			Id:        row.WishlistItem.ID.String(),
+			Product:   convertProduct(row.Product),
+			CreatedAt: row.WishlistItem.CreatedAt.Time.Format("2006-01-02 15:04:05"),
+		})
+	}
+
+	products := []*pb.Product{}
+	for _, item := range items {
+		products = append(products, item.Product)
+	}
+	server.enrichProducts(ctx, products...)
+
+	return &pb.ListWishlistResponse{Items: items, NextPageToken: nextPageToken}, nil
+}
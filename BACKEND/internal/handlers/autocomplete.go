@@ -5,65 +5,77 @@ import (
 	"net/http"
 	"strconv"
 
-	redisClient "github.com/siddheshRajendraNimbalkar/collage-prject-backend/internal/redis"
+	"github.com/siddheshRajendraNimbalkar/collage-prject-backend/internal/search"
 )
 
 type AutocompleteResponse struct {
-	Query string                   `json:"query"`
-	Items []map[string]string      `json:"items"`
+	Query    string                   `json:"query"`
+	Items    []search.Suggestion      `json:"items"`
+	SearchID string                   `json:"search_id,omitempty"`
 }
 
-func AutocompleteHandler(w http.ResponseWriter, r *http.Request) {
-	// Set CORS headers
-	w.Header().Set("Access-Control-Allow-Origin", "*")
-	w.Header().Set("Access-Control-Allow-Methods", "GET, OPTIONS")
-	w.Header().Set("Access-Control-Allow-Headers", "Content-Type")
-	
-	if r.Method == http.MethodOptions {
-		w.WriteHeader(http.StatusOK)
-		return
-	}
-	
-	if r.Method != http.MethodGet {
-		http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
-		return
-	}
+// AutocompleteHandler serves /api/autocomplete from searcher, the same index
+// the AutocompleteSearch RPC reads. First pages are logged to analytics.
+func AutocompleteHandler(searcher search.Searcher, analytics *search.Analytics) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		// Set CORS headers
+		w.Header().Set("Access-Control-Allow-Origin", "*")
+		w.Header().Set("Access-Control-Allow-Methods", "GET, OPTIONS")
+		w.Header().Set("Access-Control-Allow-Headers", "Content-Type")
+		
+		if r.Method == http.MethodOptions {
+			w.WriteHeader(http.StatusOK)
+			return
+		}
+		
+		if r.Method != http.MethodGet {
+			http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
+			return
+		}
 
-	prefix := r.URL.Query().Get("prefix")
-	limitStr := r.URL.Query().Get("limit")
-	offsetStr := r.URL.Query().Get("offset")
-	
-	limit := 10
-	if limitStr != "" {
-		if l, err := strconv.Atoi(limitStr); err == nil && l > 0 {
-			limit = l
+		prefix := r.URL.Query().Get("prefix")
+		limitStr := r.URL.Query().Get("limit")
+		offsetStr := r.URL.Query().Get("offset")
+		
+		limit := 10
+		if limitStr != "" {
+			if l, err := strconv.Atoi(limitStr); err == nil && l > 0 {
+				limit = l
+			}
 		}
-	}
-	
-	offset := 0
-	if offsetStr != "" {
-		if o, err := strconv.Atoi(offsetStr); err == nil && o >= 0 {
-			offset = o
+		
+		offset := 0
+		if offsetStr != "" {
+			if o, err := strconv.Atoi(offsetStr); err == nil && o >= 0 {
+				offset = o
+			}
 		}
-	}
 
-	results, err := redisClient.AutocompleteSearchWithOffset(prefix, limit, offset)
-	if err != nil {
-		http.Error(w, "Search failed", http.StatusInternalServerError)
-		return
-	}
+		if limit > 50 {
+			limit = 50
+		}
 
-	response := AutocompleteResponse{
-		Query: prefix,
-		Items: results,
-	}
+		results, err := searcher.Autocomplete(r.Context(), prefix, limit, offset)
+		if err != nil {
+			http.Error(w, "Search failed", http.StatusInternalServerError)
+			return
+		}
 
-	w.Header().Set("Content-Type", "application/json")
-	w.Header().Set("Access-Control-Allow-Origin", "*")
-	w.Header().Set("Access-Control-Allow-Methods", "GET, OPTIONS")
-	w.Header().Set("Access-Control-Allow-Headers", "Content-Type")
+		response := AutocompleteResponse{
+			Query: prefix,
+			Items: results,
+		}
+		if offset == 0 {
+			response.SearchID = analytics.LogQuery(r.Context(), search.SourceAutocomplete, prefix, len(results))
+		}
 
-	if err := json.NewEncoder(w).Encode(response); err != nil {
-		http.Error(w, "Failed to encode response", http.StatusInternalServerError)
+		w.Header().Set("Content-Type", "application/json")
+		w.Header().Set("Access-Control-Allow-Origin", "*")
+		w.Header().Set("Access-Control-Allow-Methods", "GET, OPTIONS")
+		w.Header().Set("Access-Control-Allow-Headers", "Content-Type")
+
+		if err := json.NewEncoder(w).Encode(response); err != nil {
+			http.Error(w, "Failed to encode response", http.StatusInternalServerError)
+		}
 	}
-}
\ No newline at end of file
+}
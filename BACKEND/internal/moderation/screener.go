@@ -0,0 +1,115 @@
+package moderation
+
+import (
+	"fmt"
+	"net"
+	"net/url"
+	"regexp"
+	"strings"
+	"unicode"
+)
+
+// Listing is the part of a product the pre-screen looks at.
+type Listing struct {
+	Name        string
+	Description string
+	ProductURL  string
+}
+
+// Screener is the automated pre-screen that runs before a listing reaches
+// the moderation queue. It flags banned words and suspicious links, a human
+// moderator still makes the final call.
+type Screener struct {
+	bannedWords  []string
+	blockedHosts []string
+}
+
+var linkPattern = regexp.MustCompile(`(?i)\bhttps?://[^\s<>"']+`)
+
+// NewScreener builds a screener from comma separated lists, as they come from
+// the config. Banned entries may be phrases, they match whole words only.
+func NewScreener(bannedWords, blockedHosts string) *Screener {
+	screener := &Screener{}
+	for _, word := range strings.Split(bannedWords, ",") {
+		if word = normalizeText(word); word != "" {
+			screener.bannedWords = append(screener.bannedWords, word)
+		}
+	}
+	for _, host := range strings.Split(blockedHosts, ",") {
+		if host = strings.Trim(strings.ToLower(strings.TrimSpace(host)), "."); host != "" {
+			screener.blockedHosts = append(screener.blockedHosts, host)
+		}
+	}
+	return screener
+}
+
+// Screen returns one reason per problem found, none means the listing passed.
+func (s *Screener) Screen(listing Listing) []string {
+	reasons := []string{}
+
+	fields := []struct{ name, text string }{
+		{"name", listing.Name},
+		{"description", listing.Description},
+	}
+	for _, field := range fields {
+		text := " " + normalizeText(field.text) + " "
+		for _, word := range s.bannedWords {
+			if strings.Contains(text, " "+word+" ") {
+				reasons = append(reasons, fmt.Sprintf("%s contains banned word %q", field.name, word))
+			}
+		}
+	}
+
+	if reason := s.checkURL(listing.ProductURL); reason != "" {
+		reasons = append(reasons, "product_url "+reason)
+	}
+	for _, link := range linkPattern.FindAllString(listing.Description, -1) {
+		if reason := s.checkURL(link); reason != "" {
+			reasons = append(reasons, fmt.Sprintf("description link %s %s", link, reason))
+		}
+	}
+
+	return reasons
+}
+
+// checkURL rejects links that are not plain https links to a public host.
+func (s *Screener) checkURL(rawURL string) string {
+	parsed, err := url.Parse(strings.TrimSpace(rawURL))
+	if err != nil || parsed.Host == "" {
+		return "is not a valid URL"
+	}
+	if parsed.Scheme != "https" {
+		return "must use https"
+	}
+	if parsed.User != nil {
+		return "must not contain credentials"
+	}
+
+	host := strings.ToLower(parsed.Hostname())
+	if ip := net.ParseIP(host); ip != nil {
+		if ip.IsLoopback() || ip.IsPrivate() || ip.IsLinkLocalUnicast() || ip.IsUnspecified() {
+			return "points at a private address"
+		}
+		return "must use a domain name, not an IP address"
+	}
+	if host == "localhost" || strings.HasSuffix(host, ".localhost") || strings.HasSuffix(host, ".local") {
+		return "points at a private address"
+	}
+
+	for _, blocked := range s.blockedHosts {
+		if host == blocked || strings.HasSuffix(host, "."+blocked) {
+			return fmt.Sprintf("points at blocked host %s", blocked)
+		}
+	}
+
+	return ""
+}
+
+// normalizeText lowercases text and collapses everything that is not a
+// letter or digit into single spaces, so punctuation and case can't hide a
+// banned word.
+func normalizeText(text string) string {
+	return strings.Join(strings.FieldsFunc(strings.ToLower(text), func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+	}), " ")
+}
@@ -0,0 +1,67 @@
+package notify
+
+import (
+	"context"
+	"log"
+	"time"
+
+	db "github.com/siddheshRajendraNimbalkar/collage-prject-backend/db/sqlc"
+)
+
+const (
+	dispatchInterval  = 30 * time.Second
+	dispatchBatchSize = 50
+	maxEmailAttempts  = 5
+)
+
+// Dispatcher emails the notifications that asked for it. In-app delivery is
+// the notifications row itself, so only email goes through here.
+type Dispatcher struct {
+	store  *db.SQLStore
+	sender Sender
+}
+
+func NewDispatcher(store *db.SQLStore, sender Sender) *Dispatcher {
+	return &Dispatcher{store: store, sender: sender}
+}
+
+// Run polls for pending emails until ctx is cancelled.
+func (d *Dispatcher) Run(ctx context.Context) {
+	ticker := time.NewTicker(dispatchInterval)
+	defer ticker.Stop()
+
+	for {
+		d.dispatch(ctx)
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+func (d *Dispatcher) dispatch(ctx context.Context) {
+	pending, err := d.store.ListPendingEmailNotifications(ctx, db.ListPendingEmailNotificationsParams{
+		MaxAttempts: maxEmailAttempts,
+		LimitCount:  dispatchBatchSize,
+	})
+	if err != nil {
+		log.Printf("notify: failed to list pending emails: %v", err)
+		return
+	}
+
+	for _, notification := range pending {
+		if err := d.sender.Send(ctx, notification.Email, notification.Title, notification.Body); err != nil {
+			log.Printf("notify: failed to email notification %s: %v", notification.ID, err)
+			if err := d.store.RecordNotificationEmailFailure(ctx, notification.ID); err != nil {
+				log.Printf("notify: failed to record email failure: %v", err)
+			}
+			continue
+		}
+
+		if err := d.store.MarkNotificationEmailed(ctx, notification.ID); err != nil {
+			log.Printf("notify: failed to mark notification %s as emailed: %v", notification.ID, err)
+		}
+	}
+}
@@ -0,0 +1,53 @@
+package notify
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"net/smtp"
+	"strings"
+)
+
+// Sender delivers a notification outside the app. Implementations must be
+// safe to call from the dispatcher goroutine.
+type Sender interface {
+	Send(ctx context.Context, to, subject, body string) error
+}
+
+// LogSender only logs the message, it is used when no mail server is set up.
+type LogSender struct{}
+
+func (LogSender) Send(ctx context.Context, to, subject, body string) error {
+	log.Printf("email to %s: %s - %s", to, subject, body)
+	return nil
+}
+
+// SMTPSender sends plain text email through an SMTP server.
+type SMTPSender struct {
+	Addr     string // host:port
+	From     string
+	Username string
+	Password string
+}
+
+func (s SMTPSender) Send(ctx context.Context, to, subject, body string) error {
+	var auth smtp.Auth
+	if s.Username != "" {
+		host := s.Addr
+		if i := strings.LastIndex(host, ":"); i >= 0 {
+			host = host[:i]
+		}
+		auth = smtp.PlainAuth("", s.Username, s.Password, host)
+	}
+
+	msg := fmt.Sprintf("From: %s\r\nTo: %s\r\nSubject: %s\r\nContent-Type: text/plain; charset=UTF-8\r\n\r\n%s\r\n", s.From, to, subject, body)
+	return smtp.SendMail(s.Addr, auth, s.From, []string{to}, []byte(msg))
+}
+
+// NewSender returns an SMTP sender when addr is set and a LogSender otherwise.
+func NewSender(addr, from, username, password string) Sender {
+	if addr == "" {
+		return LogSender{}
+	}
+	return SMTPSender{Addr: addr, From: from, Username: username, Password: password}
+}
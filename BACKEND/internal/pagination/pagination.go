@@ -0,0 +1,120 @@
+// Package pagination implements the page_token/next_page_token keyset
+// pagination shared by the list RPCs. Lists are ordered by (created_at, id),
+// or by (sort key, created_at, id) when they sort on another column, and a
+// token holds the key of the last row served, so rows inserted while a
+// client pages through a list are neither skipped nor served twice.
+package pagination
+
+import (
+	"crypto/sha256"
+	"database/sql"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+const (
+	DefaultPageSize = 20
+	MaxPageSize     = 100
+)
+
+var ErrInvalidToken = errors.New("invalid page token")
+
+// After is the key a page starts after. The zero value starts at the first
+// row, its fields map onto the after_key, after_created_at and after_id
+// query params. Key is only set in tokens returned by PageByKey.
+type After struct {
+	Key       sql.NullInt64
+	CreatedAt sql.NullTime
+	ID        uuid.NullUUID
+}
+
+type token struct {
+	Scope     string    `json:"s"`
+	Key       *int64    `json:"k,omitempty"`
+	CreatedAt int64     `json:"t"`
+	ID        uuid.UUID `json:"i"`
+}
+
+// Scope names a list whose filters are too long to spell out in every token,
+// the filters are hashed into the scope.
+func Scope(name string, filters ...any) string {
+	raw, _ := json.Marshal(filters)
+	sum := sha256.Sum256(raw)
+	return name + ":" + hex.EncodeToString(sum[:8])
+}
+
+// PageSize applies the default and the server-side maximum to a requested
+// page size.
+func PageSize(requested int32) int32 {
+	if requested <= 0 {
+		return DefaultPageSize
+	}
+	if requested > MaxPageSize {
+		return MaxPageSize
+	}
+	return requested
+}
+
+// Decode reads a page token. The scope names the list and its filters, a
+// token is rejected by any other list so it can't be replayed against
+// different filters. An empty token is the first page.
+func Decode(scope, pageToken string) (After, error) {
+	if pageToken == "" {
+		return After{}, nil
+	}
+
+	raw, err := base64.RawURLEncoding.DecodeString(pageToken)
+	if err != nil {
+		return After{}, ErrInvalidToken
+	}
+
+	var t token
+	if err := json.Unmarshal(raw, &t); err != nil || t.Scope != scope || t.ID == uuid.Nil {
+		return After{}, ErrInvalidToken
+	}
+
+	after := After{
+		CreatedAt: sql.NullTime{Time: time.UnixMicro(t.CreatedAt).UTC(), Valid: true},
+		ID:        uuid.NullUUID{UUID: t.ID, Valid: true},
+	}
+	if t.Key != nil {
+		after.Key = sql.NullInt64{Int64: *t.Key, Valid: true}
+	}
+	return after, nil
+}
+
+func encode(scope string, sortKey *int64, createdAt time.Time, id uuid.UUID) string {
+	raw, _ := json.Marshal(token{Scope: scope, Key: sortKey, CreatedAt: createdAt.UnixMicro(), ID: id})
+	return base64.RawURLEncoding.EncodeToString(raw)
+}
+
+// Page trims rows fetched with a limit of size+1 to size and returns the
+// token of the next page, empty on the last page. key returns the
+// (created_at, id) of a row.
+func Page[T any](scope string, rows []T, size int32, key func(T) (sql.NullTime, uuid.UUID)) ([]T, string) {
+	if int32(len(rows)) <= size {
+		return rows, ""
+	}
+
+	rows = rows[:size]
+	createdAt, id := key(rows[len(rows)-1])
+	return rows, encode(scope, nil, createdAt.Time, id)
+}
+
+// PageByKey is Page for lists ordered by a sort key before (created_at, id),
+// key returns the (sort key, created_at, id) of a row. The scope should name
+// the sort so a token isn't read with a different sort key.
+func PageByKey[T any](scope string, rows []T, size int32, key func(T) (int64, sql.NullTime, uuid.UUID)) ([]T, string) {
+	if int32(len(rows)) <= size {
+		return rows, ""
+	}
+
+	rows = rows[:size]
+	sortKey, createdAt, id := key(rows[len(rows)-1])
+	return rows, encode(scope, &sortKey, createdAt.Time, id)
+}
@@ -0,0 +1,56 @@
+package search
+
+import (
+	"context"
+	"log"
+	"unicode/utf8"
+
+	db "github.com/siddheshRajendraNimbalkar/collage-prject-backend/db/sqlc"
+)
+
+const (
+	SourceSearch       = "search"
+	SourceAutocomplete = "autocomplete"
+
+	// maxLoggedQuery bounds the characters of a logged query
+	maxLoggedQuery = 100
+)
+
+// Analytics records searches for the admin reports. A search is logged as
+// its normalized query and result count only, nothing identifies who sent
+// it.
+type Analytics struct {
+	store *db.SQLStore
+}
+
+func NewAnalytics(store *db.SQLStore) *Analytics {
+	return &Analytics{store: store}
+}
+
+// LogQuery records a search and returns its id, which clicks on its results
+// refer to. Logging never fails a search, errors are only logged and give
+// an empty id.
+func (analytics *Analytics) LogQuery(ctx context.Context, source, query string, results int) string {
+	if analytics == nil || analytics.store == nil {
+		return ""
+	}
+
+	query = Normalize(query)
+	if query == "" {
+		return ""
+	}
+	if utf8.RuneCountInString(query) > maxLoggedQuery {
+		query = string([]rune(query)[:maxLoggedQuery])
+	}
+
+	id, err := analytics.store.LogSearchQuery(ctx, db.LogSearchQueryParams{
+		Query:       query,
+		Source:      source,
+		ResultCount: int32(results),
+	})
+	if err != nil {
+		log.Printf("search: failed to log query: %v", err)
+		return ""
+	}
+	return id.String()
+}
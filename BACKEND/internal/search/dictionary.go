@@ -0,0 +1,214 @@
+package search
+
+import (
+	"context"
+	"log"
+	"strings"
+	"sync"
+	"time"
+	"unicode"
+
+	db "github.com/siddheshRajendraNimbalkar/collage-prject-backend/db/sqlc"
+	"golang.org/x/text/unicode/norm"
+)
+
+const (
+	// dictionaryTTL is how long admin changes to synonyms and stop words
+	// take to reach queries.
+	dictionaryTTL = time.Minute
+
+	// maxAlternatives caps how many synonym spellings of one text are
+	// searched or indexed.
+	maxAlternatives = 8
+)
+
+// Normalize puts text in the form both indexing and querying use: NFKC,
+// lower case and single spaces, so "ＵＳＢ  Drive" and "usb drive" are equal.
+func Normalize(s string) string {
+	return strings.Join(Tokens(s), " ")
+}
+
+// Tokens splits normalized text into words. Combining marks are part of a
+// word, Devanagari vowel signs are marks and a word would break at them
+// otherwise.
+func Tokens(s string) []string {
+	s = strings.ToLower(norm.NFKC.String(s))
+	return strings.FieldsFunc(s, func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r) && !unicode.IsMark(r)
+	})
+}
+
+// Dictionary holds the synonym groups and stop words admins manage. A nil
+// Dictionary has neither.
+type Dictionary struct {
+	synonyms  map[string][][]string
+	stopWords map[string]bool
+	maxPhrase int
+}
+
+func NewDictionary(groups [][]string, stopWords []string) *Dictionary {
+	dict := &Dictionary{
+		synonyms:  map[string][][]string{},
+		stopWords: map[string]bool{},
+	}
+
+	for _, word := range stopWords {
+		if word = Normalize(word); word != "" {
+			dict.stopWords[word] = true
+		}
+	}
+
+	for _, group := range groups {
+		phrases := [][]string{}
+		for _, term := range group {
+			if tokens := Tokens(term); len(tokens) > 0 {
+				phrases = append(phrases, tokens)
+			}
+		}
+		for i, phrase := range phrases {
+			key := strings.Join(phrase, " ")
+			for j, other := range phrases {
+				if i != j && strings.Join(other, " ") != key {
+					dict.synonyms[key] = append(dict.synonyms[key], other)
+				}
+			}
+			if len(phrase) > dict.maxPhrase {
+				dict.maxPhrase = len(phrase)
+			}
+		}
+	}
+
+	return dict
+}
+
+// RemoveStopWords drops stop words from tokens. The last token is kept when
+// keepLast is set, it is still being typed during autocomplete. Text made
+// of stop words only is returned as is.
+func (dict *Dictionary) RemoveStopWords(tokens []string, keepLast bool) []string {
+	if dict == nil || len(dict.stopWords) == 0 {
+		return tokens
+	}
+
+	out := []string{}
+	for i, token := range tokens {
+		if dict.stopWords[token] && !(keepLast && i == len(tokens)-1) {
+			continue
+		}
+		out = append(out, token)
+	}
+	if len(out) == 0 {
+		return tokens
+	}
+	return out
+}
+
+// Expand returns tokens followed by its spellings with synonyms substituted,
+// matching the longest phrase at every position. At most maxAlternatives
+// are returned.
+func (dict *Dictionary) Expand(tokens []string) [][]string {
+	if dict == nil || len(dict.synonyms) == 0 {
+		return [][]string{tokens}
+	}
+	return dict.expand(tokens)
+}
+
+func (dict *Dictionary) expand(tokens []string) [][]string {
+	if len(tokens) == 0 {
+		return [][]string{{}}
+	}
+
+	heads := [][]string{tokens[:1]}
+	length := 1
+	for n := min(dict.maxPhrase, len(tokens)); n > 0; n-- {
+		if others, ok := dict.synonyms[strings.Join(tokens[:n], " ")]; ok {
+			heads = append([][]string{tokens[:n]}, others...)
+			length = n
+			break
+		}
+	}
+
+	out := [][]string{}
+	for _, tail := range dict.expand(tokens[length:]) {
+		for _, head := range heads {
+			if len(out) == maxAlternatives {
+				return out
+			}
+			alternative := append(append([]string{}, head...), tail...)
+			out = append(out, alternative)
+		}
+	}
+	return out
+}
+
+// Alternatives is the normalized text and its synonym spellings with stop
+// words removed, ready for a full-text query.
+func (dict *Dictionary) Alternatives(text string) []string {
+	tokens := dict.RemoveStopWords(Tokens(text), false)
+	if len(tokens) == 0 {
+		return []string{}
+	}
+
+	out := []string{}
+	for _, alternative := range dict.Expand(tokens) {
+		out = append(out, strings.Join(alternative, " "))
+	}
+	return out
+}
+
+// DictionarySource provides the current dictionary.
+type DictionarySource interface {
+	Dictionary(ctx context.Context) *Dictionary
+}
+
+// DictionaryCache loads the dictionary from the database and reloads it
+// once it is older than dictionaryTTL. A failed reload keeps the old one.
+type DictionaryCache struct {
+	store *db.SQLStore
+
+	mu       sync.Mutex
+	dict     *Dictionary
+	loadedAt time.Time
+}
+
+func NewDictionaryCache(store *db.SQLStore) *DictionaryCache {
+	return &DictionaryCache{store: store}
+}
+
+func (cache *DictionaryCache) Dictionary(ctx context.Context) *Dictionary {
+	if cache == nil || cache.store == nil {
+		return nil
+	}
+
+	cache.mu.Lock()
+	defer cache.mu.Unlock()
+
+	if cache.dict != nil && time.Since(cache.loadedAt) < dictionaryTTL {
+		return cache.dict
+	}
+
+	dict, err := cache.load(ctx)
+	if err != nil {
+		log.Printf("search: failed to load dictionary: %v", err)
+		return cache.dict
+	}
+	cache.dict = dict
+	cache.loadedAt = time.Now()
+	return dict
+}
+
+func (cache *DictionaryCache) load(ctx context.Context) (*Dictionary, error) {
+	groups, err := cache.store.ListSynonymGroups(ctx)
+	if err != nil {
+		return nil, err
+	}
+	stopWords, err := cache.store.ListStopWords(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	terms := make([][]string, 0, len(groups))
+	for _, group := range groups {
+		terms = append(terms, group.Terms)
+	}
+	return NewDictionary(terms, stopWords), nil
+}
@@ -0,0 +1,74 @@
+package search
+
+import (
+	"context"
+	"log"
+	"unicode/utf8"
+
+	db "github.com/siddheshRajendraNimbalkar/collage-prject-backend/db/sqlc"
+)
+
+// DefaultSimilarityThreshold is used when no threshold is configured.
+const DefaultSimilarityThreshold = 0.3
+
+// FuzzySearcher completes prefixes with a primary Searcher and tops up short
+// first pages with products whose name is similar to the prefix, so
+// "calcl" still offers "Scientific Calculator". Postgres also answers alone
+// when the primary index is unavailable.
+type FuzzySearcher struct {
+	primary   Searcher
+	store     *db.SQLStore
+	threshold float64
+}
+
+func NewFuzzySearcher(primary Searcher, store *db.SQLStore, threshold float64) *FuzzySearcher {
+	if threshold <= 0 || threshold > 1 {
+		threshold = DefaultSimilarityThreshold
+	}
+	return &FuzzySearcher{primary: primary, store: store, threshold: threshold}
+}
+
+func (searcher *FuzzySearcher) Autocomplete(ctx context.Context, prefix string, limit, offset int) ([]Suggestion, error) {
+	results, err := searcher.primary.Autocomplete(ctx, prefix, limit, offset)
+	if err != nil {
+		log.Printf("search: autocomplete index failed, using fuzzy matches only: %v", err)
+		results = []Suggestion{}
+	}
+
+	// Fuzzy matches only fill the first page, later pages would need to know
+	// how many products the primary index holds for the prefix
+	prefix = Normalize(prefix)
+	if offset > 0 || len(results) >= limit || utf8.RuneCountInString(prefix) < 3 {
+		return results, err
+	}
+
+	products, fuzzyErr := searcher.store.FuzzyMatchProductsTx(ctx, searcher.threshold, db.FuzzyMatchProductsParams{
+		Query:      prefix,
+		LimitCount: int32(limit),
+	})
+	if fuzzyErr != nil {
+		if err != nil {
+			return nil, err
+		}
+		log.Printf("search: fuzzy autocomplete failed: %v", fuzzyErr)
+		return results, nil
+	}
+
+	seen := map[string]bool{}
+	for _, suggestion := range results {
+		seen[suggestion.ID] = true
+	}
+	for _, product := range products {
+		if len(results) == limit {
+			break
+		}
+		doc := ProductDocument(product)
+		if seen[doc.ID] {
+			continue
+		}
+		seen[doc.ID] = true
+		results = append(results, doc.Suggestion())
+	}
+
+	return results, nil
+}
@@ -0,0 +1,79 @@
+package search
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/redis/go-redis/v9"
+)
+
+const (
+	// schemaKey holds the version of the index layout in Redis.
+	schemaKey     = "autocomplete:schema_version"
+	schemaVersion = "3"
+
+	// legacyProductsKey was written by an older autocomplete with numeric
+	// product ids that never matched the products table.
+	legacyProductsKey = "autocomplete:products"
+)
+
+// MigrateLegacyKeys brings an existing Redis up to the current index layout.
+// It drops the legacy autocomplete:products set and any autocomplete:titles
+// members that don't parse, such as the unranked members of version 1, and
+// lists the remaining members in the members set of their product, which
+// version 2 didn't keep. It then records the schema version so later runs
+// return straight away. Products dropped here come back on the next reindex.
+func (index *RedisIndex) MigrateLegacyKeys(ctx context.Context) error {
+	if index.client == nil {
+		return fmt.Errorf("Redis client not initialized")
+	}
+
+	version, err := index.client.Get(ctx, schemaKey).Result()
+	if err != nil && err != redis.Nil {
+		return err
+	}
+	if version == schemaVersion {
+		return nil
+	}
+
+	if err := index.client.Del(ctx, legacyProductsKey).Err(); err != nil {
+		return fmt.Errorf("failed to drop %s: %w", legacyProductsKey, err)
+	}
+
+	var cursor uint64
+	for {
+		members, next, err := index.client.ZScan(ctx, titlesKey, cursor, "", scanBatch).Result()
+		if err != nil {
+			return fmt.Errorf("failed to scan %s: %w", titlesKey, err)
+		}
+
+		// ZSCAN returns member, score pairs
+		broken := []interface{}{}
+		_, err = index.client.Pipelined(ctx, func(pipe redis.Pipeliner) error {
+			for i := 0; i < len(members); i += 2 {
+				doc, ok := parseDocument(members[i])
+				if !ok {
+					broken = append(broken, members[i])
+					continue
+				}
+				pipe.SAdd(ctx, membersKey(doc.ID), members[i])
+			}
+			return nil
+		})
+		if err != nil {
+			return fmt.Errorf("failed to list product entries: %w", err)
+		}
+		if len(broken) > 0 {
+			if err := index.client.ZRem(ctx, titlesKey, broken...).Err(); err != nil {
+				return fmt.Errorf("failed to drop unreadable entries: %w", err)
+			}
+		}
+
+		cursor = next
+		if cursor == 0 {
+			break
+		}
+	}
+
+	return index.client.Set(ctx, schemaKey, schemaVersion, 0).Err()
+}
@@ -0,0 +1,148 @@
+package search
+
+import (
+	"context"
+	"database/sql"
+	"log"
+	"time"
+
+	"github.com/google/uuid"
+	db "github.com/siddheshRajendraNimbalkar/collage-prject-backend/db/sqlc"
+)
+
+const (
+	outboxInterval  = 2 * time.Second
+	outboxBatchSize = 100
+
+	// Processed entries are kept for a day to help debug index problems
+	outboxRetention = 24 * time.Hour
+	cleanupInterval = time.Hour
+
+	// The "did you mean" vocabulary is rebuilt at most this often
+	termsRefreshInterval = time.Minute
+)
+
+// OutboxWorker applies the search_outbox queue to the index. The queue is
+// filled by a trigger on products, so every create, update, stock change and
+// delete reaches the index even if Redis was down when it happened.
+type OutboxWorker struct {
+	store       *db.SQLStore
+	index       Indexer
+	lastCleanup time.Time
+
+	termsStale       bool
+	lastTermsRefresh time.Time
+}
+
+func NewOutboxWorker(store *db.SQLStore, index Indexer) *OutboxWorker {
+	return &OutboxWorker{store: store, index: index}
+}
+
+// ProductDocument is the index document for a product.
+func ProductDocument(product db.Product) Document {
+	return Document{
+		ID:       product.ID.String(),
+		Name:     product.Name,
+		Category: product.Category,
+		Type:     product.Type,
+		ImageURL: product.ProductUrl,
+	}
+}
+
+// Run processes the queue until ctx is cancelled.
+func (w *OutboxWorker) Run(ctx context.Context) {
+	ticker := time.NewTicker(outboxInterval)
+	defer ticker.Stop()
+
+	for {
+		w.process(ctx)
+		w.refreshTerms(ctx)
+		w.cleanup(ctx)
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+func (w *OutboxWorker) process(ctx context.Context) {
+	pending, err := w.store.ListPendingSearchOutbox(ctx, outboxBatchSize)
+	if err != nil {
+		log.Printf("search: failed to list outbox: %v", err)
+		return
+	}
+
+	for _, entry := range pending {
+		if err := w.sync(ctx, entry.ProductID); err != nil {
+			log.Printf("search: failed to sync product %s (attempt %d): %v", entry.ProductID, entry.Attempts+1, err)
+			err = w.store.RecordSearchOutboxFailure(ctx, db.RecordSearchOutboxFailureParams{
+				LastError: err.Error(),
+				ProductID: entry.ProductID,
+				LastID:    entry.LastID,
+			})
+			if err != nil {
+				log.Printf("search: failed to record outbox failure: %v", err)
+			}
+			continue
+		}
+
+		err := w.store.MarkSearchOutboxProcessed(ctx, db.MarkSearchOutboxProcessedParams{
+			ProductID: entry.ProductID,
+			LastID:    entry.LastID,
+		})
+		if err != nil {
+			log.Printf("search: failed to mark outbox entry for %s: %v", entry.ProductID, err)
+		}
+		w.termsStale = true
+	}
+}
+
+// sync makes the index match the product as it is now. Only published,
+// non-deleted products are searchable.
+func (w *OutboxWorker) sync(ctx context.Context, productID uuid.UUID) error {
+	id := productID.String()
+
+	product, err := w.store.GetProductByIDIncludingDeleted(ctx, productID)
+	switch {
+	case err == sql.ErrNoRows:
+		err = w.index.Remove(ctx, id)
+	case err != nil:
+		return err
+	case product.Status == "published" && !product.DeletedAt.Valid:
+		err = w.index.Index(ctx, ProductDocument(product))
+	default:
+		err = w.index.Remove(ctx, id)
+	}
+	if err != nil {
+		return err
+	}
+
+	return w.index.Invalidate(ctx, id)
+}
+
+// refreshTerms rebuilds the search_terms vocabulary once products changed.
+func (w *OutboxWorker) refreshTerms(ctx context.Context) {
+	if !w.termsStale || time.Since(w.lastTermsRefresh) < termsRefreshInterval {
+		return
+	}
+	w.lastTermsRefresh = time.Now()
+
+	if err := w.store.RefreshSearchTerms(ctx); err != nil {
+		log.Printf("search: failed to refresh search terms: %v", err)
+		return
+	}
+	w.termsStale = false
+}
+
+func (w *OutboxWorker) cleanup(ctx context.Context) {
+	if time.Since(w.lastCleanup) < cleanupInterval {
+		return
+	}
+	w.lastCleanup = time.Now()
+
+	if _, err := w.store.DeleteProcessedSearchOutbox(ctx, time.Now().UTC().Add(-outboxRetention)); err != nil {
+		log.Printf("search: failed to clean up outbox: %v", err)
+	}
+}
@@ -0,0 +1,150 @@
+package search
+
+import (
+	"context"
+	"log"
+	"math"
+	"time"
+
+	"github.com/google/uuid"
+	db "github.com/siddheshRajendraNimbalkar/collage-prject-backend/db/sqlc"
+)
+
+const (
+	popularityInterval  = 10 * time.Minute
+	popularityBatchSize = 500
+
+	// Orders and views older than popularityWindow don't count, views are
+	// kept that long
+	popularityWindow = 30 * 24 * time.Hour
+
+	// Weights of the blended score. Counts are damped with log1p so a few
+	// best sellers don't bury everything else.
+	orderWeight   = 1.0
+	viewWeight    = 0.3
+	recencyWeight = 1.0
+
+	// recencyHalfLife is the age at which the recency bonus is halved
+	recencyHalfLife = 14 * 24 * time.Hour
+
+	// A product is reindexed once its score moved at least this much, so
+	// slowly decaying recency doesn't reindex everything every run
+	scoreTolerance = 0.1
+)
+
+// PopularityScore blends the ranking signals of a product into its
+// autocomplete score. Entries matching the start of the name get
+// exactPrefixBoost on top.
+func PopularityScore(orders, views int64, createdAt, now time.Time) float64 {
+	age := max(now.Sub(createdAt), 0)
+	return orderWeight*math.Log1p(float64(orders)) +
+		viewWeight*math.Log1p(float64(views)) +
+		recencyWeight*math.Exp2(-float64(age)/float64(recencyHalfLife))
+}
+
+// PopularityWorker scores published products from their orders, views and
+// age. Products whose score changed are queued on the search outbox, which
+// indexes them again with the new rank.
+type PopularityWorker struct {
+	store *db.SQLStore
+	index *RedisIndex
+}
+
+func NewPopularityWorker(store *db.SQLStore, index *RedisIndex) *PopularityWorker {
+	return &PopularityWorker{store: store, index: index}
+}
+
+// Run scores products until ctx is cancelled.
+func (w *PopularityWorker) Run(ctx context.Context) {
+	ticker := time.NewTicker(popularityInterval)
+	defer ticker.Stop()
+
+	for {
+		if err := w.refresh(ctx); err != nil {
+			log.Printf("search: failed to refresh popularity: %v", err)
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+func (w *PopularityWorker) refresh(ctx context.Context) error {
+	now := time.Now().UTC()
+	since := now.Add(-popularityWindow)
+
+	changed := 0
+	afterID := uuid.Nil
+	for {
+		rows, err := w.store.ListProductPopularity(ctx, db.ListProductPopularityParams{
+			Since:      since,
+			AfterID:    afterID,
+			LimitCount: popularityBatchSize,
+		})
+		if err != nil {
+			return err
+		}
+		if len(rows) == 0 {
+			break
+		}
+		afterID = rows[len(rows)-1].ID
+
+		n, err := w.apply(ctx, rows, now)
+		if err != nil {
+			return err
+		}
+		changed += n
+
+		if len(rows) < popularityBatchSize {
+			break
+		}
+	}
+
+	if changed > 0 {
+		log.Printf("search: popularity changed for %d products", changed)
+	}
+
+	if _, err := w.store.DeleteProductViewsBefore(ctx, since); err != nil {
+		log.Printf("search: failed to prune product views: %v", err)
+	}
+	return nil
+}
+
+// apply stores the scores of a batch and queues the products whose score
+// moved for reindexing.
+func (w *PopularityWorker) apply(ctx context.Context, rows []db.ListProductPopularityRow, now time.Time) (int, error) {
+	ids := make([]string, len(rows))
+	for i, row := range rows {
+		ids[i] = row.ID.String()
+	}
+
+	current, found, err := w.index.Scores(ctx, ids)
+	if err != nil {
+		return 0, err
+	}
+
+	scores := map[string]float64{}
+	reindex := []uuid.UUID{}
+	for i, row := range rows {
+		score := PopularityScore(row.Orders, row.Views, row.CreatedAt.Time, now)
+		if found[i] && math.Abs(score-current[i]) < scoreTolerance {
+			continue
+		}
+		scores[ids[i]] = score
+		reindex = append(reindex, row.ID)
+	}
+	if len(reindex) == 0 {
+		return 0, nil
+	}
+
+	if err := w.index.SetScores(ctx, scores); err != nil {
+		return 0, err
+	}
+	if err := w.store.EnqueueSearchOutbox(ctx, reindex); err != nil {
+		return 0, err
+	}
+	return len(reindex), nil
+}
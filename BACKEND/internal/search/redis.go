@@ -0,0 +1,401 @@
+package search
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/redis/go-redis/v9"
+)
+
+const (
+	// titlesKey is a sorted set where every member is
+	// prefix|rank|id|name|category|type|image and all scores are 0, so
+	// members sharing a prefix sit next to each other in lexical order, best
+	// ranked first. rank is the entry's score inverted and zero padded.
+	titlesKey = "autocomplete:titles"
+
+	// membersKeyPrefix starts the set listing the titlesKey members of one
+	// product, so a product is removed without scanning the whole index.
+	membersKeyPrefix = "autocomplete:members:"
+
+	// scoresKey holds the popularity score of every product, written by the
+	// PopularityWorker and read when a product is indexed.
+	scoresKey = "autocomplete:scores"
+
+	// maxRank bounds the inverted rank, scores are kept to two decimals.
+	maxRank = 99999999
+
+	// exactPrefixBoost is added to the entries of prefixes of the product
+	// name itself, over those of its category, type or later words.
+	exactPrefixBoost = 2.0
+
+	// A product indexes at most maxPrefixesPerProduct prefixes of at most
+	// maxPrefixRunes characters, long names don't grow the index unbounded.
+	maxPrefixesPerProduct = 200
+	maxPrefixRunes        = 40
+
+	// scanBatch is how many index entries are read per round trip while
+	// collecting unique products.
+	scanBatch = 200
+)
+
+// RedisIndex is the Redis implementation of Index. Synonyms and stop words
+// of the dictionary are applied both to indexed names and to queries.
+type RedisIndex struct {
+	client       *redis.Client
+	dictionaries DictionarySource
+}
+
+// NewRedisIndex returns an index over client, dictionaries may be nil.
+func NewRedisIndex(client *redis.Client, dictionaries DictionarySource) *RedisIndex {
+	return &RedisIndex{client: client, dictionaries: dictionaries}
+}
+
+func (index *RedisIndex) dictionary(ctx context.Context) *Dictionary {
+	if index.dictionaries == nil {
+		return nil
+	}
+	return index.dictionaries.Dictionary(ctx)
+}
+
+func (index *RedisIndex) Index(ctx context.Context, doc Document) error {
+	if index.client == nil {
+		return fmt.Errorf("Redis client not initialized")
+	}
+	if doc.ID == "" || doc.Name == "" {
+		return fmt.Errorf("id and name are required")
+	}
+
+	score, err := index.score(ctx, doc.ID)
+	if err != nil {
+		return err
+	}
+
+	members := entries(index.dictionary(ctx), doc, score)
+	if len(members) == 0 {
+		return nil
+	}
+
+	// A product is indexed whole or not at all, its old entries are
+	// replaced in the same transaction
+	_, err = index.client.TxPipelined(ctx, func(pipe redis.Pipeliner) error {
+		removeScript.Eval(ctx, pipe, removeKeys(doc.ID))
+		pipe.ZAdd(ctx, titlesKey, members...)
+		pipe.SAdd(ctx, membersKey(doc.ID), memberValues(members)...)
+		return nil
+	})
+	if err != nil {
+		return fmt.Errorf("failed to add Redis members: %w", err)
+	}
+	return nil
+}
+
+// membersKey is the set of the titlesKey members of a product.
+func membersKey(id string) string {
+	return membersKeyPrefix + id
+}
+
+func memberValues(members []redis.Z) []interface{} {
+	values := make([]interface{}, len(members))
+	for i, member := range members {
+		values[i] = member.Member
+	}
+	return values
+}
+
+// entries returns the index members of doc, ranked by its popularity score.
+func entries(dict *Dictionary, doc Document, score float64) []redis.Z {
+	name := dict.RemoveStopWords(Tokens(doc.Name), false)
+	category := dict.RemoveStopWords(Tokens(doc.Category), false)
+	productType := dict.RemoveStopWords(Tokens(doc.Type), false)
+	payload := strings.Join([]string{
+		doc.ID,
+		cleanField(doc.Name),
+		cleanField(doc.Category),
+		cleanField(doc.Type),
+		doc.ImageURL,
+	}, "|")
+
+	// Any order of name, category and type can be typed
+	combinations := [][][]string{
+		{name},
+		{category},
+		{productType},
+		{name, category},
+		{name, productType},
+		{category, productType},
+		{name, category, productType},
+		{category, name},
+		{productType, name},
+		{category, productType, name},
+		{productType, category},
+		{productType, name, category},
+	}
+
+	// Typing the start of the name is an exact prefix match
+	exact := map[string]bool{}
+	for _, alternative := range dict.Expand(name) {
+		for _, prefix := range runePrefixes(strings.Join(alternative, "")) {
+			exact[prefix] = true
+		}
+	}
+
+	seen := map[string]bool{}
+	members := []redis.Z{}
+	for _, combination := range combinations {
+		tokens := []string{}
+		for _, part := range combination {
+			tokens = append(tokens, part...)
+		}
+		for _, alternative := range dict.Expand(tokens) {
+			for _, prefix := range prefixes(alternative) {
+				if seen[prefix] || len(members) == maxPrefixesPerProduct {
+					continue
+				}
+				seen[prefix] = true
+
+				entryScore := score
+				if exact[prefix] {
+					entryScore += exactPrefixBoost
+				}
+				member := strings.Join([]string{prefix, rank(entryScore), payload}, "|")
+				members = append(members, redis.Z{Score: 0, Member: member})
+			}
+		}
+	}
+	return members
+}
+
+// removeScript removes the entries listed in a product's members set from
+// the index. Entries only in the shadow index of a running reindex stay
+// listed, they become live when it is swapped in.
+var removeScript = redis.NewScript(`
+	local members = redis.call('SMEMBERS', KEYS[3])
+	local removed = 0
+	for i = 1, #members do
+		if redis.call('ZREM', KEYS[1], members[i]) == 1 then
+			removed = removed + 1
+			redis.call('SREM', KEYS[3], members[i])
+		elseif not redis.call('ZSCORE', KEYS[2], members[i]) then
+			redis.call('SREM', KEYS[3], members[i])
+		end
+	end
+	return removed
+`)
+
+func removeKeys(id string) []string {
+	return []string{titlesKey, shadowKey, membersKey(id)}
+}
+
+func (index *RedisIndex) Remove(ctx context.Context, id string) error {
+	if index.client == nil {
+		return fmt.Errorf("Redis client not initialized")
+	}
+	if err := removeScript.Run(ctx, index.client, removeKeys(id)).Err(); err != nil {
+		return fmt.Errorf("failed to remove product entries: %w", err)
+	}
+	return nil
+}
+
+func (index *RedisIndex) Invalidate(ctx context.Context, id string) error {
+	if index.client == nil {
+		return fmt.Errorf("Redis client not initialized")
+	}
+	return index.client.Del(ctx, ProductCacheKey(id)).Err()
+}
+
+func (index *RedisIndex) Autocomplete(ctx context.Context, prefix string, limit, offset int) ([]Suggestion, error) {
+	if index.client == nil {
+		return nil, fmt.Errorf("Redis client not initialized")
+	}
+
+	results := []Suggestion{}
+	dict := index.dictionary(ctx)
+	tokens := dict.RemoveStopWords(Tokens(prefix), true)
+	if len(tokens) == 0 || limit <= 0 {
+		return results, nil
+	}
+
+	// Matches of the typed text come first, then those of its synonyms,
+	// each best ranked first. Offset counts unique products across all of
+	// them.
+	seen := map[string]bool{}
+	skipped := 0
+	for _, alternative := range dict.Expand(tokens) {
+		// Longer prefixes aren't indexed
+		key := strings.Join(alternative, "")
+		if cut := runePrefixes(key); len(cut) > 0 {
+			key = cut[len(cut)-1]
+		}
+		for start := int64(0); len(results) < limit; start += scanBatch {
+			members, err := index.client.ZRangeByLex(ctx, titlesKey, &redis.ZRangeBy{
+				Min:    "[" + key + "|",
+				Max:    "[" + key + "|\xff",
+				Offset: start,
+				Count:  scanBatch,
+			}).Result()
+			if err != nil {
+				return nil, err
+			}
+
+			for _, member := range members {
+				suggestion, ok := parseMember(member)
+				if !ok || seen[suggestion.ID] {
+					continue
+				}
+				seen[suggestion.ID] = true
+
+				if skipped < offset {
+					skipped++
+					continue
+				}
+				results = append(results, suggestion)
+				if len(results) == limit {
+					break
+				}
+			}
+
+			if len(members) < scanBatch {
+				break
+			}
+		}
+	}
+
+	return results, nil
+}
+
+func parseMember(member string) (Suggestion, bool) {
+	doc, ok := parseDocument(member)
+	if !ok {
+		return Suggestion{}, false
+	}
+	return doc.Suggestion(), true
+}
+
+// parseDocument reads the document stored in an index member.
+func parseDocument(member string) (Document, bool) {
+	parts := strings.SplitN(member, "|", 7)
+	if len(parts) != 7 || len(parts[1]) != 8 || parts[2] == "" {
+		return Document{}, false
+	}
+	return Document{
+		ID:       parts[2],
+		Name:     parts[3],
+		Category: parts[4],
+		Type:     parts[5],
+		ImageURL: parts[6],
+	}, true
+}
+
+// prefixes returns the prefixes of the joined tokens and of every token, so
+// "blue note" is found by "bluen" as well as by "note".
+func prefixes(tokens []string) []string {
+	out := []string{}
+	for _, word := range append([]string{strings.Join(tokens, "")}, tokens...) {
+		out = append(out, runePrefixes(word)...)
+	}
+	return out
+}
+
+// runePrefixes returns the prefixes of word up to maxPrefixRunes long.
+// Prefixes end on rune boundaries, a Devanagari name is never cut inside a
+// character.
+func runePrefixes(word string) []string {
+	out := []string{}
+	end := 0
+	for i := 0; i < maxPrefixRunes && end < len(word); i++ {
+		_, size := utf8.DecodeRuneInString(word[end:])
+		end += size
+		out = append(out, word[:end])
+	}
+	return out
+}
+
+// rank orders entries of one prefix by score, highest first, when compared
+// lexically.
+func rank(score float64) string {
+	r := maxRank - int64(math.Round(score*100))
+	if r < 0 {
+		r = 0
+	}
+	if r > maxRank {
+		r = maxRank
+	}
+	return fmt.Sprintf("%08d", r)
+}
+
+// score is the popularity score of a product, 0 until the PopularityWorker
+// scored it.
+func (index *RedisIndex) score(ctx context.Context, id string) (float64, error) {
+	score, err := index.client.ZScore(ctx, scoresKey, id).Result()
+	if err == redis.Nil {
+		return 0, nil
+	}
+	if err != nil {
+		return 0, fmt.Errorf("failed to read product score: %w", err)
+	}
+	return score, nil
+}
+
+// Scores returns the stored popularity scores of ids, ok is false for ids
+// that have none.
+func (index *RedisIndex) Scores(ctx context.Context, ids []string) ([]float64, []bool, error) {
+	if index.client == nil {
+		return nil, nil, fmt.Errorf("Redis client not initialized")
+	}
+
+	scores := make([]float64, len(ids))
+	found := make([]bool, len(ids))
+	if len(ids) == 0 {
+		return scores, found, nil
+	}
+
+	cmds, err := index.client.Pipelined(ctx, func(pipe redis.Pipeliner) error {
+		for _, id := range ids {
+			pipe.ZScore(ctx, scoresKey, id)
+		}
+		return nil
+	})
+	if err != nil && err != redis.Nil {
+		return nil, nil, fmt.Errorf("failed to read product scores: %w", err)
+	}
+	for i, cmd := range cmds {
+		score, err := cmd.(*redis.FloatCmd).Result()
+		if err == redis.Nil {
+			continue
+		}
+		if err != nil {
+			return nil, nil, fmt.Errorf("failed to read product scores: %w", err)
+		}
+		scores[i], found[i] = score, true
+	}
+	return scores, found, nil
+}
+
+// SetScores stores popularity scores. Entries already indexed keep their
+// rank until the product is indexed again.
+func (index *RedisIndex) SetScores(ctx context.Context, scores map[string]float64) error {
+	if index.client == nil {
+		return fmt.Errorf("Redis client not initialized")
+	}
+	if len(scores) == 0 {
+		return nil
+	}
+
+	members := make([]redis.Z, 0, len(scores))
+	for id, score := range scores {
+		members = append(members, redis.Z{Score: score, Member: id})
+	}
+	if err := index.client.ZAdd(ctx, scoresKey, members...).Err(); err != nil {
+		return fmt.Errorf("failed to store product scores: %w", err)
+	}
+	return nil
+}
+
+// cleanField keeps the member separator out of indexed values.
+func cleanField(s string) string {
+	return strings.TrimSpace(strings.ReplaceAll(s, "|", " "))
+}
@@ -0,0 +1,227 @@
+package search
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/redis/go-redis/v9"
+)
+
+const (
+	// shadowKey is where a reindex builds the new index, it replaces
+	// titlesKey in one RENAME once every product is in.
+	shadowKey = "autocomplete:titles:reindex"
+
+	// checkpointKey records how far the reindex into shadowKey got.
+	checkpointKey = "autocomplete:reindex:checkpoint"
+
+	// verifyBatch is how many index entries are read per round trip while
+	// verifying.
+	verifyBatch = 1000
+)
+
+// Checkpoint is the progress of a reindex. It is written together with
+// every batch, so a resumed run continues after the last stored product.
+type Checkpoint struct {
+	AfterCreatedAt time.Time `json:"after_created_at"`
+	AfterID        uuid.UUID `json:"after_id"`
+	// OutboxID is the newest outbox entry when the run started, entries
+	// after it are requeued once the shadow index is swapped in
+	OutboxID  int64     `json:"outbox_id"`
+	Indexed   int64     `json:"indexed"`
+	StartedAt time.Time `json:"started_at"`
+}
+
+// LoadCheckpoint returns the checkpoint of an interrupted reindex, nil when
+// there is none.
+func (index *RedisIndex) LoadCheckpoint(ctx context.Context) (*Checkpoint, error) {
+	if index.client == nil {
+		return nil, fmt.Errorf("Redis client not initialized")
+	}
+
+	raw, err := index.client.Get(ctx, checkpointKey).Bytes()
+	if err == redis.Nil {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to read reindex checkpoint: %w", err)
+	}
+
+	var checkpoint Checkpoint
+	if err := json.Unmarshal(raw, &checkpoint); err != nil {
+		return nil, fmt.Errorf("failed to decode reindex checkpoint: %w", err)
+	}
+	return &checkpoint, nil
+}
+
+// DiscardReindex drops the shadow index and checkpoint of an earlier run.
+func (index *RedisIndex) DiscardReindex(ctx context.Context) error {
+	if index.client == nil {
+		return fmt.Errorf("Redis client not initialized")
+	}
+	return index.client.Del(ctx, shadowKey, checkpointKey).Err()
+}
+
+// IndexShadow adds docs to the shadow index and stores checkpoint, both in
+// one transaction.
+func (index *RedisIndex) IndexShadow(ctx context.Context, docs []Document, checkpoint Checkpoint) error {
+	if index.client == nil {
+		return fmt.Errorf("Redis client not initialized")
+	}
+
+	ids := make([]string, len(docs))
+	for i, doc := range docs {
+		ids[i] = doc.ID
+	}
+	scores, _, err := index.Scores(ctx, ids)
+	if err != nil {
+		return err
+	}
+
+	raw, err := json.Marshal(checkpoint)
+	if err != nil {
+		return err
+	}
+
+	dict := index.dictionary(ctx)
+	_, err = index.client.TxPipelined(ctx, func(pipe redis.Pipeliner) error {
+		for i, doc := range docs {
+			if doc.ID == "" || doc.Name == "" {
+				continue
+			}
+			// The members set lists live and shadow entries alike, so the
+			// product can be removed before and after the swap
+			if members := entries(dict, doc, scores[i]); len(members) > 0 {
+				pipe.ZAdd(ctx, shadowKey, members...)
+				pipe.SAdd(ctx, membersKey(doc.ID), memberValues(members)...)
+			}
+		}
+		pipe.Set(ctx, checkpointKey, raw, 0)
+		return nil
+	})
+	if err != nil {
+		return fmt.Errorf("failed to write reindex batch: %w", err)
+	}
+	return nil
+}
+
+// SwapShadow replaces the live index with the shadow index and drops the
+// checkpoint. Without a shadow index there were no products and the live
+// index is emptied.
+func (index *RedisIndex) SwapShadow(ctx context.Context) error {
+	if index.client == nil {
+		return fmt.Errorf("Redis client not initialized")
+	}
+
+	exists, err := index.client.Exists(ctx, shadowKey).Result()
+	if err != nil {
+		return err
+	}
+
+	_, err = index.client.TxPipelined(ctx, func(pipe redis.Pipeliner) error {
+		if exists > 0 {
+			pipe.Rename(ctx, shadowKey, titlesKey)
+		} else {
+			pipe.Del(ctx, titlesKey)
+		}
+		pipe.Del(ctx, checkpointKey)
+		return nil
+	})
+	if err != nil {
+		return fmt.Errorf("failed to swap in the new index: %w", err)
+	}
+	return nil
+}
+
+// IndexDiff lists the product ids where the index and the products table
+// disagree.
+type IndexDiff struct {
+	Missing  []string // searchable but not indexed
+	Outdated []string // indexed with a different name, category, type or image
+	Stale    []string // indexed but no longer searchable
+}
+
+// Empty reports whether the index matches the products table.
+func (diff IndexDiff) Empty() bool {
+	return len(diff.Missing) == 0 && len(diff.Outdated) == 0 && len(diff.Stale) == 0
+}
+
+// Verify compares the live index with every searchable product. next
+// returns the products page by page and an empty page at the end.
+func (index *RedisIndex) Verify(ctx context.Context, next func() ([]Document, error)) (IndexDiff, error) {
+	diff := IndexDiff{Missing: []string{}, Outdated: []string{}, Stale: []string{}}
+
+	indexed, err := index.indexedDocuments(ctx)
+	if err != nil {
+		return diff, err
+	}
+
+	for {
+		docs, err := next()
+		if err != nil {
+			return diff, err
+		}
+		if len(docs) == 0 {
+			break
+		}
+
+		for _, doc := range docs {
+			got, ok := indexed[doc.ID]
+			switch {
+			case !ok:
+				diff.Missing = append(diff.Missing, doc.ID)
+			case got != doc.indexed():
+				diff.Outdated = append(diff.Outdated, doc.ID)
+			}
+			delete(indexed, doc.ID)
+		}
+	}
+
+	for id := range indexed {
+		diff.Stale = append(diff.Stale, id)
+	}
+	return diff, nil
+}
+
+// indexedDocuments reads the document of every product in the live index.
+func (index *RedisIndex) indexedDocuments(ctx context.Context) (map[string]Document, error) {
+	if index.client == nil {
+		return nil, fmt.Errorf("Redis client not initialized")
+	}
+
+	docs := map[string]Document{}
+	var cursor uint64
+	for {
+		members, next, err := index.client.ZScan(ctx, titlesKey, cursor, "", verifyBatch).Result()
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan %s: %w", titlesKey, err)
+		}
+
+		// ZSCAN returns member, score pairs
+		for i := 0; i < len(members); i += 2 {
+			if doc, ok := parseDocument(members[i]); ok {
+				docs[doc.ID] = doc
+			}
+		}
+
+		cursor = next
+		if cursor == 0 {
+			break
+		}
+	}
+	return docs, nil
+}
+
+// indexed is the document as its index entries store it.
+func (doc Document) indexed() Document {
+	return Document{
+		ID:       doc.ID,
+		Name:     cleanField(doc.Name),
+		Category: cleanField(doc.Category),
+		Type:     cleanField(doc.Type),
+		ImageURL: doc.ImageURL,
+	}
+}
@@ -0,0 +1,66 @@
+// Package search owns the product autocomplete index. Every reader and
+// writer of the index goes through the interfaces below, so the storage
+// schema lives in one place.
+package search
+
+import (
+	"context"
+	"fmt"
+)
+
+// Document is what gets indexed for one product.
+type Document struct {
+	ID       string
+	Name     string
+	Category string
+	Type     string
+	ImageURL string
+}
+
+// Suggestion is one autocomplete result.
+type Suggestion struct {
+	ID       string `json:"id"`
+	Title    string `json:"title"`
+	Name     string `json:"name"`
+	Category string `json:"category"`
+	Type     string `json:"type"`
+	Image    string `json:"image"`
+}
+
+// Suggestion is how the document is shown as an autocomplete result.
+func (doc Document) Suggestion() Suggestion {
+	return Suggestion{
+		ID:       doc.ID,
+		Title:    fmt.Sprintf("%s - %s %s", doc.Name, doc.Category, doc.Type),
+		Name:     doc.Name,
+		Category: doc.Category,
+		Type:     doc.Type,
+		Image:    doc.ImageURL,
+	}
+}
+
+// Searcher answers autocomplete queries. Results are unique per product,
+// offset and limit count products, not index entries.
+type Searcher interface {
+	Autocomplete(ctx context.Context, prefix string, limit, offset int) ([]Suggestion, error)
+}
+
+// Indexer keeps the index in step with the products table.
+type Indexer interface {
+	Index(ctx context.Context, doc Document) error
+	Remove(ctx context.Context, id string) error
+	// Invalidate drops cached copies of a product after it changed.
+	Invalidate(ctx context.Context, id string) error
+}
+
+// Index is a searchable index that can also be written to.
+type Index interface {
+	Searcher
+	Indexer
+}
+
+// ProductCacheKey is the Redis hash that caches a product for search
+// results. It is dropped whenever the product changes.
+func ProductCacheKey(id string) string {
+	return "product:" + id
+}
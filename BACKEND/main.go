@@ -6,7 +6,6 @@ import (
 	"log"
 	"net"
 	"net/http"
-	"os"
 
 	runtime "github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
 	"github.com/rs/cors"
@@ -14,7 +13,9 @@ import (
 	db "github.com/siddheshRajendraNimbalkar/collage-prject-backend/db/sqlc"
 	"github.com/siddheshRajendraNimbalkar/collage-prject-backend/gapi"
 	"github.com/siddheshRajendraNimbalkar/collage-prject-backend/internal/handlers"
+	"github.com/siddheshRajendraNimbalkar/collage-prject-backend/internal/notify"
 	redisClient "github.com/siddheshRajendraNimbalkar/collage-prject-backend/internal/redis"
+	"github.com/siddheshRajendraNimbalkar/collage-prject-backend/internal/search"
 	"github.com/siddheshRajendraNimbalkar/collage-prject-backend/pb"
 	"github.com/siddheshRajendraNimbalkar/collage-prject-backend/util"
 	"google.golang.org/grpc"
@@ -42,20 +43,38 @@ func main() {
 	store := db.NewStore(conn)
 
 	// Initialize Redis
-	redisURL := os.Getenv("REDIS_URL")
-	if redisURL == "" {
-		redisURL = "localhost:6379"
+	redisErr := redisClient.InitRedis(redisClient.ResolveURL(config.RedisURL))
+	if redisErr != nil {
+		log.Printf("Redis connection failed: %v", redisErr)
 	}
-	if err := redisClient.InitRedis(redisURL); err != nil {
-		log.Printf("Redis connection failed: %v", err)
+
+	// One index and dictionary cache serve the workers, the RPCs and the
+	// autocomplete endpoint
+	dictionaries := search.NewDictionaryCache(store)
+	searchIndex := search.NewRedisIndex(redisClient.Client, dictionaries)
+	searcher := search.NewFuzzySearcher(searchIndex, store, config.SimilarityThreshold)
+	if redisErr == nil {
+		if err := searchIndex.MigrateLegacyKeys(ctx); err != nil {
+			log.Printf("Search index migration failed: %v", err)
+		}
 	}
 
+	// The search index follows the products table through the outbox
+	go search.NewOutboxWorker(store, searchIndex).Run(ctx)
+
+	// Autocomplete suggestions are ranked by popularity
+	go search.NewPopularityWorker(store, searchIndex).Run(ctx)
+
+	// Email notifications go out in the background
+	sender := notify.NewSender(config.SMTPAddr, config.EmailFrom, config.SMTPUsername, config.SMTPPassword)
+	go notify.NewDispatcher(store, sender).Run(ctx)
+
 	// Start only the HTTP API server
-	grpcApiClient(*store, config)
+	grpcApiClient(*store, config, searcher, dictionaries)
 }
 
-func grpcApiClient(store db.SQLStore, config util.Config) {
-	server, err := gapi.NewServer(config, &store)
+func grpcApiClient(store db.SQLStore, config util.Config, searcher search.Searcher, dictionaries search.DictionarySource) {
+	server, err := gapi.NewServer(config, &store, searcher, dictionaries)
 	if err != nil {
 		log.Fatalf("[Can't get server]: %v", err)
 	}
@@ -93,7 +112,11 @@ func grpcApiClient(store db.SQLStore, config util.Config) {
 
 	mux := http.NewServeMux()
 	mux.Handle("/", grpcMux)
-	mux.HandleFunc("/api/autocomplete", handlers.AutocompleteHandler)
+	mux.HandleFunc("/api/autocomplete", handlers.AutocompleteHandler(searcher, search.NewAnalytics(&store)))
+	mux.HandleFunc("/api/products/import", server.ImportProductsHandler)
+	mux.HandleFunc("/api/products/export", server.ExportProductsHandler)
+	mux.HandleFunc("/api/products/asset", server.UploadDigitalAssetHandler)
+	mux.HandleFunc("/api/downloads", server.DownloadHandler)
 
 	log.Printf("About to listen on: %s", config.APIADDR)
 	listener, err := net.Listen("tcp", config.APIADDR)
@@ -111,8 +134,8 @@ func grpcApiClient(store db.SQLStore, config util.Config) {
 	}
 }
 
-func grpcClient(store db.SQLStore, config util.Config) {
-	server, err := gapi.NewServer(config, &store)
+func grpcClient(store db.SQLStore, config util.Config, searcher search.Searcher, dictionaries search.DictionarySource) {
+	server, err := gapi.NewServer(config, &store, searcher, dictionaries)
 	if err != nil {
 		log.Fatalf("[Can't get server]: %v", err)
 	}
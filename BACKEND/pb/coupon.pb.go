@@ -0,0 +1,553 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.10
+// 	protoc        v3.12.4
+// source: coupon.proto
+
+package pb
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type Coupon struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Id             string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Code           string                 `protobuf:"bytes,2,opt,name=code,proto3" json:"code,omitempty"`
+	DiscountType   string                 `protobuf:"bytes,3,opt,name=discount_type,json=discountType,proto3" json:"discount_type,omitempty"` // "percentage" or "fixed"
+	Amount         float64                `protobuf:"fixed64,4,opt,name=amount,proto3" json:"amount,omitempty"`
+	MinOrderValue  float64                `protobuf:"fixed64,5,opt,name=min_order_value,json=minOrderValue,proto3" json:"min_order_value,omitempty"`
+	MaxUses        int32                  `protobuf:"varint,6,opt,name=max_uses,json=maxUses,proto3" json:"max_uses,omitempty"`                          // 0 means unlimited
+	MaxUsesPerUser int32                  `protobuf:"varint,7,opt,name=max_uses_per_user,json=maxUsesPerUser,proto3" json:"max_uses_per_user,omitempty"` // 0 means unlimited
+	UsedCount      int32                  `protobuf:"varint,8,opt,name=used_count,json=usedCount,proto3" json:"used_count,omitempty"`
+	StartsAt       string                 `protobuf:"bytes,9,opt,name=starts_at,json=startsAt,proto3" json:"starts_at,omitempty"`
+	EndsAt         string                 `protobuf:"bytes,10,opt,name=ends_at,json=endsAt,proto3" json:"ends_at,omitempty"`
+	Scope          string                 `protobuf:"bytes,11,opt,name=scope,proto3" json:"scope,omitempty"` // "seller", "category" or "product"
+	ScopeId        string                 `protobuf:"bytes,12,opt,name=scope_id,json=scopeId,proto3" json:"scope_id,omitempty"`
+	CreatedAt      string                 `protobuf:"bytes,13,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *Coupon) Reset() {
+	*x = Coupon{}
+	mi := &file_coupon_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Coupon) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Coupon) ProtoMessage() {}
+
+func (x *Coupon) ProtoReflect() protoreflect.Message {
+	mi := &file_coupon_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Coupon.ProtoReflect.Descriptor instead.
+func (*Coupon) Descriptor() ([]byte, []int) {
+	return file_coupon_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *Coupon) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Coupon) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
+func (x *Coupon) GetDiscountType() string {
+	if x != nil {
+		return x.DiscountType
+	}
+	return ""
+}
+
+func (x *Coupon) GetAmount() float64 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
+}
+
+func (x *Coupon) GetMinOrderValue() float64 {
+	if x != nil {
+		return x.MinOrderValue
+	}
+	return 0
+}
+
+func (x *Coupon) GetMaxUses() int32 {
+	if x != nil {
+		return x.MaxUses
+	}
+	return 0
+}
+
+func (x *Coupon) GetMaxUsesPerUser() int32 {
+	if x != nil {
+		return x.MaxUsesPerUser
+	}
+	return 0
+}
+
+func (x *Coupon) GetUsedCount() int32 {
+	if x != nil {
+		return x.UsedCount
+	}
+	return 0
+}
+
+func (x *Coupon) GetStartsAt() string {
+	if x != nil {
+		return x.StartsAt
+	}
+	return ""
+}
+
+func (x *Coupon) GetEndsAt() string {
+	if x != nil {
+		return x.EndsAt
+	}
+	return ""
+}
+
+func (x *Coupon) GetScope() string {
+	if x != nil {
+		return x.Scope
+	}
+	return ""
+}
+
+func (x *Coupon) GetScopeId() string {
+	if x != nil {
+		return x.ScopeId
+	}
+	return ""
+}
+
+func (x *Coupon) GetCreatedAt() string {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return ""
+}
+
+type CreateCouponRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Code           string                 `protobuf:"bytes,1,opt,name=code,proto3" json:"code,omitempty"`
+	DiscountType   string                 `protobuf:"bytes,2,opt,name=discount_type,json=discountType,proto3" json:"discount_type,omitempty"`
+	Amount         float64                `protobuf:"fixed64,3,opt,name=amount,proto3" json:"amount,omitempty"`
+	MinOrderValue  float64                `protobuf:"fixed64,4,opt,name=min_order_value,json=minOrderValue,proto3" json:"min_order_value,omitempty"`
+	MaxUses        int32                  `protobuf:"varint,5,opt,name=max_uses,json=maxUses,proto3" json:"max_uses,omitempty"`
+	MaxUsesPerUser int32                  `protobuf:"varint,6,opt,name=max_uses_per_user,json=maxUsesPerUser,proto3" json:"max_uses_per_user,omitempty"`
+	StartsAt       string                 `protobuf:"bytes,7,opt,name=starts_at,json=startsAt,proto3" json:"starts_at,omitempty"` // defaults to now
+	EndsAt         string                 `protobuf:"bytes,8,opt,name=ends_at,json=endsAt,proto3" json:"ends_at,omitempty"`       // empty for no end
+	Scope          string                 `protobuf:"bytes,9,opt,name=scope,proto3" json:"scope,omitempty"`
+	ScopeId        string                 `protobuf:"bytes,10,opt,name=scope_id,json=scopeId,proto3" json:"scope_id,omitempty"` // defaults to the caller for the seller scope
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *CreateCouponRequest) Reset() {
+	*x = CreateCouponRequest{}
+	mi := &file_coupon_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateCouponRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateCouponRequest) ProtoMessage() {}
+
+func (x *CreateCouponRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_coupon_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateCouponRequest.ProtoReflect.Descriptor instead.
+func (*CreateCouponRequest) Descriptor() ([]byte, []int) {
+	return file_coupon_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *CreateCouponRequest) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
+func (x *CreateCouponRequest) GetDiscountType() string {
+	if x != nil {
+		return x.DiscountType
+	}
+	return ""
+}
+
+func (x *CreateCouponRequest) GetAmount() float64 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
+}
+
+func (x *CreateCouponRequest) GetMinOrderValue() float64 {
+	if x != nil {
+		return x.MinOrderValue
+	}
+	return 0
+}
+
+func (x *CreateCouponRequest) GetMaxUses() int32 {
+	if x != nil {
+		return x.MaxUses
+	}
+	return 0
+}
+
+func (x *CreateCouponRequest) GetMaxUsesPerUser() int32 {
+	if x != nil {
+		return x.MaxUsesPerUser
+	}
+	return 0
+}
+
+func (x *CreateCouponRequest) GetStartsAt() string {
+	if x != nil {
+		return x.StartsAt
+	}
+	return ""
+}
+
+func (x *CreateCouponRequest) GetEndsAt() string {
+	if x != nil {
+		return x.EndsAt
+	}
+	return ""
+}
+
+func (x *CreateCouponRequest) GetScope() string {
+	if x != nil {
+		return x.Scope
+	}
+	return ""
+}
+
+func (x *CreateCouponRequest) GetScopeId() string {
+	if x != nil {
+		return x.ScopeId
+	}
+	return ""
+}
+
+type CouponResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Coupon        *Coupon                `protobuf:"bytes,1,opt,name=coupon,proto3" json:"coupon,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CouponResponse) Reset() {
+	*x = CouponResponse{}
+	mi := &file_coupon_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CouponResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CouponResponse) ProtoMessage() {}
+
+func (x *CouponResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_coupon_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CouponResponse.ProtoReflect.Descriptor instead.
+func (*CouponResponse) Descriptor() ([]byte, []int) {
+	return file_coupon_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *CouponResponse) GetCoupon() *Coupon {
+	if x != nil {
+		return x.Coupon
+	}
+	return nil
+}
+
+type ValidateCouponRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Code          string                 `protobuf:"bytes,1,opt,name=code,proto3" json:"code,omitempty"`
+	ProductId     string                 `protobuf:"bytes,2,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
+	Quantity      int32                  `protobuf:"varint,3,opt,name=quantity,proto3" json:"quantity,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ValidateCouponRequest) Reset() {
+	*x = ValidateCouponRequest{}
+	mi := &file_coupon_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ValidateCouponRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ValidateCouponRequest) ProtoMessage() {}
+
+func (x *ValidateCouponRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_coupon_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ValidateCouponRequest.ProtoReflect.Descriptor instead.
+func (*ValidateCouponRequest) Descriptor() ([]byte, []int) {
+	return file_coupon_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *ValidateCouponRequest) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
+func (x *ValidateCouponRequest) GetProductId() string {
+	if x != nil {
+		return x.ProductId
+	}
+	return ""
+}
+
+func (x *ValidateCouponRequest) GetQuantity() int32 {
+	if x != nil {
+		return x.Quantity
+	}
+	return 0
+}
+
+type ValidateCouponResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Coupon        *Coupon                `protobuf:"bytes,1,opt,name=coupon,proto3" json:"coupon,omitempty"`
+	Subtotal      float64                `protobuf:"fixed64,2,opt,name=subtotal,proto3" json:"subtotal,omitempty"`
+	Discount      float64                `protobuf:"fixed64,3,opt,name=discount,proto3" json:"discount,omitempty"`
+	Total         float64                `protobuf:"fixed64,4,opt,name=total,proto3" json:"total,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ValidateCouponResponse) Reset() {
+	*x = ValidateCouponResponse{}
+	mi := &file_coupon_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ValidateCouponResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ValidateCouponResponse) ProtoMessage() {}
+
+func (x *ValidateCouponResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_coupon_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ValidateCouponResponse.ProtoReflect.Descriptor instead.
+func (*ValidateCouponResponse) Descriptor() ([]byte, []int) {
+	return file_coupon_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *ValidateCouponResponse) GetCoupon() *Coupon {
+	if x != nil {
+		return x.Coupon
+	}
+	return nil
+}
+
+func (x *ValidateCouponResponse) GetSubtotal() float64 {
+	if x != nil {
+		return x.Subtotal
+	}
+	return 0
+}
+
+func (x *ValidateCouponResponse) GetDiscount() float64 {
+	if x != nil {
+		return x.Discount
+	}
+	return 0
+}
+
+func (x *ValidateCouponResponse) GetTotal() float64 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+var File_coupon_proto protoreflect.FileDescriptor
+
+const file_coupon_proto_rawDesc = "" +
+	"\n" +
+	"\fcoupon.proto\x12\x02pb\"\xfc\x02\n" +
+	"\x06Coupon\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
+	"\x04code\x18\x02 \x01(\tR\x04code\x12#\n" +
+	"\rdiscount_type\x18\x03 \x01(\tR\fdiscountType\x12\x16\n" +
+	"\x06amount\x18\x04 \x01(\x01R\x06amount\x12&\n" +
+	"\x0fmin_order_value\x18\x05 \x01(\x01R\rminOrderValue\x12\x19\n" +
+	"\bmax_uses\x18\x06 \x01(\x05R\amaxUses\x12)\n" +
+	"\x11max_uses_per_user\x18\a \x01(\x05R\x0emaxUsesPerUser\x12\x1d\n" +
+	"\n" +
+	"used_count\x18\b \x01(\x05R\tusedCount\x12\x1b\n" +
+	"\tstarts_at\x18\t \x01(\tR\bstartsAt\x12\x17\n" +
+	"\aends_at\x18\n" +
+	" \x01(\tR\x06endsAt\x12\x14\n" +
+	"\x05scope\x18\v \x01(\tR\x05scope\x12\x19\n" +
+	"\bscope_id\x18\f \x01(\tR\ascopeId\x12\x1d\n" +
+	"\n" +
+	"created_at\x18\r \x01(\tR\tcreatedAt\"\xbb\x02\n" +
+	"\x13CreateCouponRequest\x12\x12\n" +
+	"\x04code\x18\x01 \x01(\tR\x04code\x12#\n" +
+	"\rdiscount_type\x18\x02 \x01(\tR\fdiscountType\x12\x16\n" +
+	"\x06amount\x18\x03 \x01(\x01R\x06amount\x12&\n" +
+	"\x0fmin_order_value\x18\x04 \x01(\x01R\rminOrderValue\x12\x19\n" +
+	"\bmax_uses\x18\x05 \x01(\x05R\amaxUses\x12)\n" +
+	"\x11max_uses_per_user\x18\x06 \x01(\x05R\x0emaxUsesPerUser\x12\x1b\n" +
+	"\tstarts_at\x18\a \x01(\tR\bstartsAt\x12\x17\n" +
+	"\aends_at\x18\b \x01(\tR\x06endsAt\x12\x14\n" +
+	"\x05scope\x18\t \x01(\tR\x05scope\x12\x19\n" +
+	"\bscope_id\x18\n" +
+	" \x01(\tR\ascopeId\"4\n" +
+	"\x0eCouponResponse\x12\"\n" +
+	"\x06coupon\x18\x01 \x01(\v2\n" +
+	".pb.CouponR\x06coupon\"f\n" +
+	"\x15ValidateCouponRequest\x12\x12\n" +
+	"\x04code\x18\x01 \x01(\tR\x04code\x12\x1d\n" +
+	"\n" +
+	"product_id\x18\x02 \x01(\tR\tproductId\x12\x1a\n" +
+	"\bquantity\x18\x03 \x01(\x05R\bquantity\"\x8a\x01\n" +
+	"\x16ValidateCouponResponse\x12\"\n" +
+	"\x06coupon\x18\x01 \x01(\v2\n" +
+	".pb.CouponR\x06coupon\x12\x1a\n" +
+	"\bsubtotal\x18\x02 \x01(\x01R\bsubtotal\x12\x1a\n" +
+	"\bdiscount\x18\x03 \x01(\x01R\bdiscount\x12\x14\n" +
+	"\x05total\x18\x04 \x01(\x01R\x05totalB@Z>github.com/siddheshRajendraNimbalkar/collage-prject-backend/pbb\x06proto3"
+
+var (
+	file_coupon_proto_rawDescOnce sync.Once
+	file_coupon_proto_rawDescData []byte
+)
+
+func file_coupon_proto_rawDescGZIP() []byte {
+	file_coupon_proto_rawDescOnce.Do(func() {
+		file_coupon_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_coupon_proto_rawDesc), len(file_coupon_proto_rawDesc)))
+	})
+	return file_coupon_proto_rawDescData
+}
+
+var file_coupon_proto_msgTypes = make([]protoimpl.MessageInfo, 5)
+var file_coupon_proto_goTypes = []any{
+	(*Coupon)(nil),                 // 0: pb.Coupon
+	(*CreateCouponRequest)(nil),    // 1: pb.CreateCouponRequest
+	(*CouponResponse)(nil),         // 2: pb.CouponResponse
+	(*ValidateCouponRequest)(nil),  // 3: pb.ValidateCouponRequest
+	(*ValidateCouponResponse)(nil), // 4: pb.ValidateCouponResponse
+}
+var file_coupon_proto_depIdxs = []int32{
+	0, // 0: pb.CouponResponse.coupon:type_name -> pb.Coupon
+	0, // 1: pb.ValidateCouponResponse.coupon:type_name -> pb.Coupon
+	2, // [2:2] is the sub-list for method output_type
+	2, // [2:2] is the sub-list for method input_type
+	2, // [2:2] is the sub-list for extension type_name
+	2, // [2:2] is the sub-list for extension extendee
+	0, // [0:2] is the sub-list for field type_name
+}
+
+func init() { file_coupon_proto_init() }
+func file_coupon_proto_init() {
+	if File_coupon_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_coupon_proto_rawDesc), len(file_coupon_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   5,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_coupon_proto_goTypes,
+		DependencyIndexes: file_coupon_proto_depIdxs,
+		MessageInfos:      file_coupon_proto_msgTypes,
+	}.Build()
+	File_coupon_proto = out.File
+	file_coupon_proto_goTypes = nil
+	file_coupon_proto_depIdxs = nil
+}
@@ -0,0 +1,303 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.10
+// 	protoc        v3.12.4
+// source: digital.proto
+
+package pb
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// DigitalAsset is the file behind a digital product. Sellers upload it
+// through /api/products/asset.
+type DigitalAsset struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	ProductId     string                 `protobuf:"bytes,2,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
+	FileName      string                 `protobuf:"bytes,3,opt,name=file_name,json=fileName,proto3" json:"file_name,omitempty"`
+	ContentType   string                 `protobuf:"bytes,4,opt,name=content_type,json=contentType,proto3" json:"content_type,omitempty"`
+	SizeBytes     int64                  `protobuf:"varint,5,opt,name=size_bytes,json=sizeBytes,proto3" json:"size_bytes,omitempty"`
+	DownloadLimit int32                  `protobuf:"varint,6,opt,name=download_limit,json=downloadLimit,proto3" json:"download_limit,omitempty"` // downloads allowed per order
+	CreatedAt     string                 `protobuf:"bytes,7,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DigitalAsset) Reset() {
+	*x = DigitalAsset{}
+	mi := &file_digital_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DigitalAsset) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DigitalAsset) ProtoMessage() {}
+
+func (x *DigitalAsset) ProtoReflect() protoreflect.Message {
+	mi := &file_digital_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DigitalAsset.ProtoReflect.Descriptor instead.
+func (*DigitalAsset) Descriptor() ([]byte, []int) {
+	return file_digital_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *DigitalAsset) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *DigitalAsset) GetProductId() string {
+	if x != nil {
+		return x.ProductId
+	}
+	return ""
+}
+
+func (x *DigitalAsset) GetFileName() string {
+	if x != nil {
+		return x.FileName
+	}
+	return ""
+}
+
+func (x *DigitalAsset) GetContentType() string {
+	if x != nil {
+		return x.ContentType
+	}
+	return ""
+}
+
+func (x *DigitalAsset) GetSizeBytes() int64 {
+	if x != nil {
+		return x.SizeBytes
+	}
+	return 0
+}
+
+func (x *DigitalAsset) GetDownloadLimit() int32 {
+	if x != nil {
+		return x.DownloadLimit
+	}
+	return 0
+}
+
+func (x *DigitalAsset) GetCreatedAt() string {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return ""
+}
+
+type GetDownloadLinkRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	OrderId       string                 `protobuf:"bytes,1,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetDownloadLinkRequest) Reset() {
+	*x = GetDownloadLinkRequest{}
+	mi := &file_digital_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetDownloadLinkRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetDownloadLinkRequest) ProtoMessage() {}
+
+func (x *GetDownloadLinkRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_digital_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetDownloadLinkRequest.ProtoReflect.Descriptor instead.
+func (*GetDownloadLinkRequest) Descriptor() ([]byte, []int) {
+	return file_digital_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *GetDownloadLinkRequest) GetOrderId() string {
+	if x != nil {
+		return x.OrderId
+	}
+	return ""
+}
+
+type GetDownloadLinkResponse struct {
+	state              protoimpl.MessageState `protogen:"open.v1"`
+	Url                string                 `protobuf:"bytes,1,opt,name=url,proto3" json:"url,omitempty"` // signed, works without an auth token until expires_at
+	ExpiresAt          string                 `protobuf:"bytes,2,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"`
+	FileName           string                 `protobuf:"bytes,3,opt,name=file_name,json=fileName,proto3" json:"file_name,omitempty"`
+	DownloadsRemaining int32                  `protobuf:"varint,4,opt,name=downloads_remaining,json=downloadsRemaining,proto3" json:"downloads_remaining,omitempty"`
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
+}
+
+func (x *GetDownloadLinkResponse) Reset() {
+	*x = GetDownloadLinkResponse{}
+	mi := &file_digital_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetDownloadLinkResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetDownloadLinkResponse) ProtoMessage() {}
+
+func (x *GetDownloadLinkResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_digital_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetDownloadLinkResponse.ProtoReflect.Descriptor instead.
+func (*GetDownloadLinkResponse) Descriptor() ([]byte, []int) {
+	return file_digital_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *GetDownloadLinkResponse) GetUrl() string {
+	if x != nil {
+		return x.Url
+	}
+	return ""
+}
+
+func (x *GetDownloadLinkResponse) GetExpiresAt() string {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return ""
+}
+
+func (x *GetDownloadLinkResponse) GetFileName() string {
+	if x != nil {
+		return x.FileName
+	}
+	return ""
+}
+
+func (x *GetDownloadLinkResponse) GetDownloadsRemaining() int32 {
+	if x != nil {
+		return x.DownloadsRemaining
+	}
+	return 0
+}
+
+var File_digital_proto protoreflect.FileDescriptor
+
+const file_digital_proto_rawDesc = "" +
+	"\n" +
+	"\rdigital.proto\x12\x02pb\"\xe2\x01\n" +
+	"\fDigitalAsset\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1d\n" +
+	"\n" +
+	"product_id\x18\x02 \x01(\tR\tproductId\x12\x1b\n" +
+	"\tfile_name\x18\x03 \x01(\tR\bfileName\x12!\n" +
+	"\fcontent_type\x18\x04 \x01(\tR\vcontentType\x12\x1d\n" +
+	"\n" +
+	"size_bytes\x18\x05 \x01(\x03R\tsizeBytes\x12%\n" +
+	"\x0edownload_limit\x18\x06 \x01(\x05R\rdownloadLimit\x12\x1d\n" +
+	"\n" +
+	"created_at\x18\a \x01(\tR\tcreatedAt\"3\n" +
+	"\x16GetDownloadLinkRequest\x12\x19\n" +
+	"\border_id\x18\x01 \x01(\tR\aorderId\"\x98\x01\n" +
+	"\x17GetDownloadLinkResponse\x12\x10\n" +
+	"\x03url\x18\x01 \x01(\tR\x03url\x12\x1d\n" +
+	"\n" +
+	"expires_at\x18\x02 \x01(\tR\texpiresAt\x12\x1b\n" +
+	"\tfile_name\x18\x03 \x01(\tR\bfileName\x12/\n" +
+	"\x13downloads_remaining\x18\x04 \x01(\x05R\x12downloadsRemainingB@Z>github.com/siddheshRajendraNimbalkar/collage-prject-backend/pbb\x06proto3"
+
+var (
+	file_digital_proto_rawDescOnce sync.Once
+	file_digital_proto_rawDescData []byte
+)
+
+func file_digital_proto_rawDescGZIP() []byte {
+	file_digital_proto_rawDescOnce.Do(func() {
+		file_digital_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_digital_proto_rawDesc), len(file_digital_proto_rawDesc)))
+	})
+	return file_digital_proto_rawDescData
+}
+
+var file_digital_proto_msgTypes = make([]protoimpl.MessageInfo, 3)
+var file_digital_proto_goTypes = []any{
+	(*DigitalAsset)(nil),            // 0: pb.DigitalAsset
+	(*GetDownloadLinkRequest)(nil),  // 1: pb.GetDownloadLinkRequest
+	(*GetDownloadLinkResponse)(nil), // 2: pb.GetDownloadLinkResponse
+}
+var file_digital_proto_depIdxs = []int32{
+	0, // [0:0] is the sub-list for method output_type
+	0, // [0:0] is the sub-list for method input_type
+	0, // [0:0] is the sub-list for extension type_name
+	0, // [0:0] is the sub-list for extension extendee
+	0, // [0:0] is the sub-list for field type_name
+}
+
+func init() { file_digital_proto_init() }
+func file_digital_proto_init() {
+	if File_digital_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_digital_proto_rawDesc), len(file_digital_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   3,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_digital_proto_goTypes,
+		DependencyIndexes: file_digital_proto_depIdxs,
+		MessageInfos:      file_digital_proto_msgTypes,
+	}.Build()
+	File_digital_proto = out.File
+	file_digital_proto_goTypes = nil
+	file_digital_proto_depIdxs = nil
+}
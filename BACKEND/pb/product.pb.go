@@ -9,6 +9,7 @@ package pb
 import (
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	fieldmaskpb "google.golang.org/protobuf/types/known/fieldmaskpb"
 	reflect "reflect"
 	sync "sync"
 	unsafe "unsafe"
@@ -22,19 +23,30 @@ const (
 )
 
 type Product struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
-	Description   string                 `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
-	Price         float64                `protobuf:"fixed64,4,opt,name=price,proto3" json:"price,omitempty"`
-	Stock         int32                  `protobuf:"varint,5,opt,name=stock,proto3" json:"stock,omitempty"`
-	CreatedBy     string                 `protobuf:"bytes,6,opt,name=created_by,json=createdBy,proto3" json:"created_by,omitempty"`
-	CreatedAt     string                 `protobuf:"bytes,7,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
-	ProductUrl    string                 `protobuf:"bytes,8,opt,name=product_url,json=productUrl,proto3" json:"product_url,omitempty"`
-	Category      string                 `protobuf:"bytes,9,opt,name=category,proto3" json:"category,omitempty"`
-	Type          string                 `protobuf:"bytes,10,opt,name=type,proto3" json:"type,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	Id                string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name              string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Description       string                 `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
+	Price             float64                `protobuf:"fixed64,4,opt,name=price,proto3" json:"price,omitempty"`
+	Stock             int32                  `protobuf:"varint,5,opt,name=stock,proto3" json:"stock,omitempty"`
+	CreatedBy         string                 `protobuf:"bytes,6,opt,name=created_by,json=createdBy,proto3" json:"created_by,omitempty"`
+	CreatedAt         string                 `protobuf:"bytes,7,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	ProductUrl        string                 `protobuf:"bytes,8,opt,name=product_url,json=productUrl,proto3" json:"product_url,omitempty"`
+	Category          string                 `protobuf:"bytes,9,opt,name=category,proto3" json:"category,omitempty"`
+	Type              string                 `protobuf:"bytes,10,opt,name=type,proto3" json:"type,omitempty"`
+	Status            string                 `protobuf:"bytes,11,opt,name=status,proto3" json:"status,omitempty"` // "draft", "pending_review", "published", "rejected", "archived"
+	CategoryId        string                 `protobuf:"bytes,12,opt,name=category_id,json=categoryId,proto3" json:"category_id,omitempty"`
+	RatingAverage     float64                `protobuf:"fixed64,13,opt,name=rating_average,json=ratingAverage,proto3" json:"rating_average,omitempty"`
+	RatingCount       int32                  `protobuf:"varint,14,opt,name=rating_count,json=ratingCount,proto3" json:"rating_count,omitempty"`
+	Version           int32                  `protobuf:"varint,15,opt,name=version,proto3" json:"version,omitempty"` // send back in UpdateProductRequest to detect concurrent edits
+	LowStockThreshold int32                  `protobuf:"varint,16,opt,name=low_stock_threshold,json=lowStockThreshold,proto3" json:"low_stock_threshold,omitempty"`
+	SalePrice         float64                `protobuf:"fixed64,17,opt,name=sale_price,json=salePrice,proto3" json:"sale_price,omitempty"` // 0 when no sale is running, price is the original price
+	SaleEndsAt        string                 `protobuf:"bytes,18,opt,name=sale_ends_at,json=saleEndsAt,proto3" json:"sale_ends_at,omitempty"`
+	EffectivePrice    float64                `protobuf:"fixed64,19,opt,name=effective_price,json=effectivePrice,proto3" json:"effective_price,omitempty"` // what an order is charged right now
+	Kind              string                 `protobuf:"bytes,20,opt,name=kind,proto3" json:"kind,omitempty"`                                             // "physical" or "bundle"
+	BundleItems       []*BundleItem          `protobuf:"bytes,21,rep,name=bundle_items,json=bundleItems,proto3" json:"bundle_items,omitempty"`            // for bundles stock is derived from these
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
 }
 
 func (x *Product) Reset() {
@@ -137,22 +149,164 @@ func (x *Product) GetType() string {
 	return ""
 }
 
-type CreateProductRequest struct {
+func (x *Product) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *Product) GetCategoryId() string {
+	if x != nil {
+		return x.CategoryId
+	}
+	return ""
+}
+
+func (x *Product) GetRatingAverage() float64 {
+	if x != nil {
+		return x.RatingAverage
+	}
+	return 0
+}
+
+func (x *Product) GetRatingCount() int32 {
+	if x != nil {
+		return x.RatingCount
+	}
+	return 0
+}
+
+func (x *Product) GetVersion() int32 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+func (x *Product) GetLowStockThreshold() int32 {
+	if x != nil {
+		return x.LowStockThreshold
+	}
+	return 0
+}
+
+func (x *Product) GetSalePrice() float64 {
+	if x != nil {
+		return x.SalePrice
+	}
+	return 0
+}
+
+func (x *Product) GetSaleEndsAt() string {
+	if x != nil {
+		return x.SaleEndsAt
+	}
+	return ""
+}
+
+func (x *Product) GetEffectivePrice() float64 {
+	if x != nil {
+		return x.EffectivePrice
+	}
+	return 0
+}
+
+func (x *Product) GetKind() string {
+	if x != nil {
+		return x.Kind
+	}
+	return ""
+}
+
+func (x *Product) GetBundleItems() []*BundleItem {
+	if x != nil {
+		return x.BundleItems
+	}
+	return nil
+}
+
+type BundleItem struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
-	Description   string                 `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
-	Price         float64                `protobuf:"fixed64,3,opt,name=price,proto3" json:"price,omitempty"`
-	Stock         int32                  `protobuf:"varint,4,opt,name=stock,proto3" json:"stock,omitempty"`
-	ProductUrl    string                 `protobuf:"bytes,5,opt,name=product_url,json=productUrl,proto3" json:"product_url,omitempty"`
-	Category      string                 `protobuf:"bytes,6,opt,name=category,proto3" json:"category,omitempty"`
-	Type          string                 `protobuf:"bytes,7,opt,name=type,proto3" json:"type,omitempty"`
+	ProductId     string                 `protobuf:"bytes,1,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
+	Quantity      int32                  `protobuf:"varint,2,opt,name=quantity,proto3" json:"quantity,omitempty"`
+	Name          string                 `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
+func (x *BundleItem) Reset() {
+	*x = BundleItem{}
+	mi := &file_product_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BundleItem) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BundleItem) ProtoMessage() {}
+
+func (x *BundleItem) ProtoReflect() protoreflect.Message {
+	mi := &file_product_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BundleItem.ProtoReflect.Descriptor instead.
+func (*BundleItem) Descriptor() ([]byte, []int) {
+	return file_product_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *BundleItem) GetProductId() string {
+	if x != nil {
+		return x.ProductId
+	}
+	return ""
+}
+
+func (x *BundleItem) GetQuantity() int32 {
+	if x != nil {
+		return x.Quantity
+	}
+	return 0
+}
+
+func (x *BundleItem) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+type CreateProductRequest struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	Name              string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Description       string                 `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+	Price             float64                `protobuf:"fixed64,3,opt,name=price,proto3" json:"price,omitempty"`
+	Stock             int32                  `protobuf:"varint,4,opt,name=stock,proto3" json:"stock,omitempty"`
+	ProductUrl        string                 `protobuf:"bytes,5,opt,name=product_url,json=productUrl,proto3" json:"product_url,omitempty"`
+	Category          string                 `protobuf:"bytes,6,opt,name=category,proto3" json:"category,omitempty"`
+	Type              string                 `protobuf:"bytes,7,opt,name=type,proto3" json:"type,omitempty"`
+	Status            string                 `protobuf:"bytes,8,opt,name=status,proto3" json:"status,omitempty"`                                                    // "draft" or "published", defaults to "published". With moderation on, "published" means "pending_review" until approved
+	CategoryId        string                 `protobuf:"bytes,9,opt,name=category_id,json=categoryId,proto3" json:"category_id,omitempty"`                          // takes precedence over category/type
+	LowStockThreshold int32                  `protobuf:"varint,10,opt,name=low_stock_threshold,json=lowStockThreshold,proto3" json:"low_stock_threshold,omitempty"` // alert the seller at or below this stock, 0 alerts when sold out
+	Kind              string                 `protobuf:"bytes,11,opt,name=kind,proto3" json:"kind,omitempty"`                                                       // "physical" (default) or "bundle"
+	BundleItems       []*BundleItem          `protobuf:"bytes,12,rep,name=bundle_items,json=bundleItems,proto3" json:"bundle_items,omitempty"`                      // components of a bundle, which has no stock of its own
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
 func (x *CreateProductRequest) Reset() {
 	*x = CreateProductRequest{}
-	mi := &file_product_proto_msgTypes[1]
+	mi := &file_product_proto_msgTypes[2]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -164,7 +318,7 @@ func (x *CreateProductRequest) String() string {
 func (*CreateProductRequest) ProtoMessage() {}
 
 func (x *CreateProductRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_product_proto_msgTypes[1]
+	mi := &file_product_proto_msgTypes[2]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -177,7 +331,7 @@ func (x *CreateProductRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CreateProductRequest.ProtoReflect.Descriptor instead.
 func (*CreateProductRequest) Descriptor() ([]byte, []int) {
-	return file_product_proto_rawDescGZIP(), []int{1}
+	return file_product_proto_rawDescGZIP(), []int{2}
 }
 
 func (x *CreateProductRequest) GetName() string {
@@ -229,6 +383,41 @@ func (x *CreateProductRequest) GetType() string {
 	return ""
 }
 
+func (x *CreateProductRequest) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *CreateProductRequest) GetCategoryId() string {
+	if x != nil {
+		return x.CategoryId
+	}
+	return ""
+}
+
+func (x *CreateProductRequest) GetLowStockThreshold() int32 {
+	if x != nil {
+		return x.LowStockThreshold
+	}
+	return 0
+}
+
+func (x *CreateProductRequest) GetKind() string {
+	if x != nil {
+		return x.Kind
+	}
+	return ""
+}
+
+func (x *CreateProductRequest) GetBundleItems() []*BundleItem {
+	if x != nil {
+		return x.BundleItems
+	}
+	return nil
+}
+
 type GetProductRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
@@ -238,7 +427,7 @@ type GetProductRequest struct {
 
 func (x *GetProductRequest) Reset() {
 	*x = GetProductRequest{}
-	mi := &file_product_proto_msgTypes[2]
+	mi := &file_product_proto_msgTypes[3]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -250,7 +439,7 @@ func (x *GetProductRequest) String() string {
 func (*GetProductRequest) ProtoMessage() {}
 
 func (x *GetProductRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_product_proto_msgTypes[2]
+	mi := &file_product_proto_msgTypes[3]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -263,7 +452,7 @@ func (x *GetProductRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetProductRequest.ProtoReflect.Descriptor instead.
 func (*GetProductRequest) Descriptor() ([]byte, []int) {
-	return file_product_proto_rawDescGZIP(), []int{2}
+	return file_product_proto_rawDescGZIP(), []int{3}
 }
 
 func (x *GetProductRequest) GetId() string {
@@ -282,7 +471,7 @@ type GetOnlyProductRequest struct {
 
 func (x *GetOnlyProductRequest) Reset() {
 	*x = GetOnlyProductRequest{}
-	mi := &file_product_proto_msgTypes[3]
+	mi := &file_product_proto_msgTypes[4]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -294,7 +483,7 @@ func (x *GetOnlyProductRequest) String() string {
 func (*GetOnlyProductRequest) ProtoMessage() {}
 
 func (x *GetOnlyProductRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_product_proto_msgTypes[3]
+	mi := &file_product_proto_msgTypes[4]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -307,7 +496,7 @@ func (x *GetOnlyProductRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetOnlyProductRequest.ProtoReflect.Descriptor instead.
 func (*GetOnlyProductRequest) Descriptor() ([]byte, []int) {
-	return file_product_proto_rawDescGZIP(), []int{3}
+	return file_product_proto_rawDescGZIP(), []int{4}
 }
 
 func (x *GetOnlyProductRequest) GetId() string {
@@ -319,15 +508,15 @@ func (x *GetOnlyProductRequest) GetId() string {
 
 type ListAllProductsRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Limit         int32                  `protobuf:"varint,1,opt,name=limit,proto3" json:"limit,omitempty"`
-	Offset        int32                  `protobuf:"varint,2,opt,name=offset,proto3" json:"offset,omitempty"`
+	Limit         int32                  `protobuf:"varint,1,opt,name=limit,proto3" json:"limit,omitempty"`                         // page size, at most 100
+	PageToken     string                 `protobuf:"bytes,3,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"` // next_page_token of the previous page
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *ListAllProductsRequest) Reset() {
 	*x = ListAllProductsRequest{}
-	mi := &file_product_proto_msgTypes[4]
+	mi := &file_product_proto_msgTypes[5]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -339,7 +528,7 @@ func (x *ListAllProductsRequest) String() string {
 func (*ListAllProductsRequest) ProtoMessage() {}
 
 func (x *ListAllProductsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_product_proto_msgTypes[4]
+	mi := &file_product_proto_msgTypes[5]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -352,7 +541,7 @@ func (x *ListAllProductsRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListAllProductsRequest.ProtoReflect.Descriptor instead.
 func (*ListAllProductsRequest) Descriptor() ([]byte, []int) {
-	return file_product_proto_rawDescGZIP(), []int{4}
+	return file_product_proto_rawDescGZIP(), []int{5}
 }
 
 func (x *ListAllProductsRequest) GetLimit() int32 {
@@ -362,23 +551,24 @@ func (x *ListAllProductsRequest) GetLimit() int32 {
 	return 0
 }
 
-func (x *ListAllProductsRequest) GetOffset() int32 {
+func (x *ListAllProductsRequest) GetPageToken() string {
 	if x != nil {
-		return x.Offset
+		return x.PageToken
 	}
-	return 0
+	return ""
 }
 
 type ListProductsResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Products      []*Product             `protobuf:"bytes,1,rep,name=products,proto3" json:"products,omitempty"`
+	NextPageToken string                 `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"` // empty on the last page
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *ListProductsResponse) Reset() {
 	*x = ListProductsResponse{}
-	mi := &file_product_proto_msgTypes[5]
+	mi := &file_product_proto_msgTypes[6]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -390,7 +580,7 @@ func (x *ListProductsResponse) String() string {
 func (*ListProductsResponse) ProtoMessage() {}
 
 func (x *ListProductsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_product_proto_msgTypes[5]
+	mi := &file_product_proto_msgTypes[6]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -403,7 +593,7 @@ func (x *ListProductsResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListProductsResponse.ProtoReflect.Descriptor instead.
 func (*ListProductsResponse) Descriptor() ([]byte, []int) {
-	return file_product_proto_rawDescGZIP(), []int{5}
+	return file_product_proto_rawDescGZIP(), []int{6}
 }
 
 func (x *ListProductsResponse) GetProducts() []*Product {
@@ -413,23 +603,35 @@ func (x *ListProductsResponse) GetProducts() []*Product {
 	return nil
 }
 
+func (x *ListProductsResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
 type UpdateProductRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
-	Description   string                 `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
-	Price         float64                `protobuf:"fixed64,4,opt,name=price,proto3" json:"price,omitempty"`
-	Stock         int32                  `protobuf:"varint,5,opt,name=stock,proto3" json:"stock,omitempty"`
-	ProductUrl    string                 `protobuf:"bytes,6,opt,name=product_url,json=productUrl,proto3" json:"product_url,omitempty"`
-	Category      string                 `protobuf:"bytes,7,opt,name=category,proto3" json:"category,omitempty"`
-	Type          string                 `protobuf:"bytes,8,opt,name=type,proto3" json:"type,omitempty"`
+	state       protoimpl.MessageState `protogen:"open.v1"`
+	Id          string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name        string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Description string                 `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
+	Price       float64                `protobuf:"fixed64,4,opt,name=price,proto3" json:"price,omitempty"`
+	Stock       int32                  `protobuf:"varint,5,opt,name=stock,proto3" json:"stock,omitempty"`
+	ProductUrl  string                 `protobuf:"bytes,6,opt,name=product_url,json=productUrl,proto3" json:"product_url,omitempty"`
+	Category    string                 `protobuf:"bytes,7,opt,name=category,proto3" json:"category,omitempty"`
+	Type        string                 `protobuf:"bytes,8,opt,name=type,proto3" json:"type,omitempty"`
+	CategoryId  string                 `protobuf:"bytes,9,opt,name=category_id,json=categoryId,proto3" json:"category_id,omitempty"` // takes precedence over category/type
+	// Only the listed fields are changed, an empty mask replaces every field.
+	// Paths: name, description, price, stock, product_url, category, type, category_id
+	UpdateMask    *fieldmaskpb.FieldMask `protobuf:"bytes,10,opt,name=update_mask,json=updateMask,proto3" json:"update_mask,omitempty"`
+	Version       int32                  `protobuf:"varint,11,opt,name=version,proto3" json:"version,omitempty"` // current product version, 0 skips the check
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *UpdateProductRequest) Reset() {
 	*x = UpdateProductRequest{}
-	mi := &file_product_proto_msgTypes[6]
+	mi := &file_product_proto_msgTypes[7]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -441,7 +643,7 @@ func (x *UpdateProductRequest) String() string {
 func (*UpdateProductRequest) ProtoMessage() {}
 
 func (x *UpdateProductRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_product_proto_msgTypes[6]
+	mi := &file_product_proto_msgTypes[7]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -454,7 +656,7 @@ func (x *UpdateProductRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UpdateProductRequest.ProtoReflect.Descriptor instead.
 func (*UpdateProductRequest) Descriptor() ([]byte, []int) {
-	return file_product_proto_rawDescGZIP(), []int{6}
+	return file_product_proto_rawDescGZIP(), []int{7}
 }
 
 func (x *UpdateProductRequest) GetId() string {
@@ -513,6 +715,27 @@ func (x *UpdateProductRequest) GetType() string {
 	return ""
 }
 
+func (x *UpdateProductRequest) GetCategoryId() string {
+	if x != nil {
+		return x.CategoryId
+	}
+	return ""
+}
+
+func (x *UpdateProductRequest) GetUpdateMask() *fieldmaskpb.FieldMask {
+	if x != nil {
+		return x.UpdateMask
+	}
+	return nil
+}
+
+func (x *UpdateProductRequest) GetVersion() int32 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
 type DeleteProductRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
@@ -522,7 +745,7 @@ type DeleteProductRequest struct {
 
 func (x *DeleteProductRequest) Reset() {
 	*x = DeleteProductRequest{}
-	mi := &file_product_proto_msgTypes[7]
+	mi := &file_product_proto_msgTypes[8]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -534,7 +757,7 @@ func (x *DeleteProductRequest) String() string {
 func (*DeleteProductRequest) ProtoMessage() {}
 
 func (x *DeleteProductRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_product_proto_msgTypes[7]
+	mi := &file_product_proto_msgTypes[8]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -547,7 +770,7 @@ func (x *DeleteProductRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DeleteProductRequest.ProtoReflect.Descriptor instead.
 func (*DeleteProductRequest) Descriptor() ([]byte, []int) {
-	return file_product_proto_rawDescGZIP(), []int{7}
+	return file_product_proto_rawDescGZIP(), []int{8}
 }
 
 func (x *DeleteProductRequest) GetId() string {
@@ -566,7 +789,7 @@ type ProductResponse struct {
 
 func (x *ProductResponse) Reset() {
 	*x = ProductResponse{}
-	mi := &file_product_proto_msgTypes[8]
+	mi := &file_product_proto_msgTypes[9]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -578,7 +801,7 @@ func (x *ProductResponse) String() string {
 func (*ProductResponse) ProtoMessage() {}
 
 func (x *ProductResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_product_proto_msgTypes[8]
+	mi := &file_product_proto_msgTypes[9]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -591,7 +814,7 @@ func (x *ProductResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ProductResponse.ProtoReflect.Descriptor instead.
 func (*ProductResponse) Descriptor() ([]byte, []int) {
-	return file_product_proto_rawDescGZIP(), []int{8}
+	return file_product_proto_rawDescGZIP(), []int{9}
 }
 
 func (x *ProductResponse) GetProduct() *Product {
@@ -610,7 +833,7 @@ type DeleteProductResponse struct {
 
 func (x *DeleteProductResponse) Reset() {
 	*x = DeleteProductResponse{}
-	mi := &file_product_proto_msgTypes[9]
+	mi := &file_product_proto_msgTypes[10]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -622,7 +845,7 @@ func (x *DeleteProductResponse) String() string {
 func (*DeleteProductResponse) ProtoMessage() {}
 
 func (x *DeleteProductResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_product_proto_msgTypes[9]
+	mi := &file_product_proto_msgTypes[10]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -635,7 +858,7 @@ func (x *DeleteProductResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DeleteProductResponse.ProtoReflect.Descriptor instead.
 func (*DeleteProductResponse) Descriptor() ([]byte, []int) {
-	return file_product_proto_rawDescGZIP(), []int{9}
+	return file_product_proto_rawDescGZIP(), []int{10}
 }
 
 func (x *DeleteProductResponse) GetMessage() string {
@@ -645,28 +868,28 @@ func (x *DeleteProductResponse) GetMessage() string {
 	return ""
 }
 
-type ListAllProductsByNameRequest struct {
+type PublishProductRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListAllProductsByNameRequest) Reset() {
-	*x = ListAllProductsByNameRequest{}
-	mi := &file_product_proto_msgTypes[10]
+func (x *PublishProductRequest) Reset() {
+	*x = PublishProductRequest{}
+	mi := &file_product_proto_msgTypes[11]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListAllProductsByNameRequest) String() string {
+func (x *PublishProductRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListAllProductsByNameRequest) ProtoMessage() {}
+func (*PublishProductRequest) ProtoMessage() {}
 
-func (x *ListAllProductsByNameRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_product_proto_msgTypes[10]
+func (x *PublishProductRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_product_proto_msgTypes[11]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -677,40 +900,40 @@ func (x *ListAllProductsByNameRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListAllProductsByNameRequest.ProtoReflect.Descriptor instead.
-func (*ListAllProductsByNameRequest) Descriptor() ([]byte, []int) {
-	return file_product_proto_rawDescGZIP(), []int{10}
+// Deprecated: Use PublishProductRequest.ProtoReflect.Descriptor instead.
+func (*PublishProductRequest) Descriptor() ([]byte, []int) {
+	return file_product_proto_rawDescGZIP(), []int{11}
 }
 
-func (x *ListAllProductsByNameRequest) GetName() string {
+func (x *PublishProductRequest) GetId() string {
 	if x != nil {
-		return x.Name
+		return x.Id
 	}
 	return ""
 }
 
-type ListAllProductsByNameResponse struct {
+type ArchiveProductRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Products      []*Product             `protobuf:"bytes,1,rep,name=products,proto3" json:"products,omitempty"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListAllProductsByNameResponse) Reset() {
-	*x = ListAllProductsByNameResponse{}
-	mi := &file_product_proto_msgTypes[11]
+func (x *ArchiveProductRequest) Reset() {
+	*x = ArchiveProductRequest{}
+	mi := &file_product_proto_msgTypes[12]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListAllProductsByNameResponse) String() string {
+func (x *ArchiveProductRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListAllProductsByNameResponse) ProtoMessage() {}
+func (*ArchiveProductRequest) ProtoMessage() {}
 
-func (x *ListAllProductsByNameResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_product_proto_msgTypes[11]
+func (x *ArchiveProductRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_product_proto_msgTypes[12]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -721,28 +944,187 @@ func (x *ListAllProductsByNameResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListAllProductsByNameResponse.ProtoReflect.Descriptor instead.
-func (*ListAllProductsByNameResponse) Descriptor() ([]byte, []int) {
-	return file_product_proto_rawDescGZIP(), []int{11}
+// Deprecated: Use ArchiveProductRequest.ProtoReflect.Descriptor instead.
+func (*ArchiveProductRequest) Descriptor() ([]byte, []int) {
+	return file_product_proto_rawDescGZIP(), []int{12}
 }
 
-func (x *ListAllProductsByNameResponse) GetProducts() []*Product {
+func (x *ArchiveProductRequest) GetId() string {
 	if x != nil {
-		return x.Products
+		return x.Id
 	}
-	return nil
+	return ""
 }
 
-type ListAllProductsByCategoryRequest struct {
+type RestoreProductRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Category      string                 `protobuf:"bytes,1,opt,name=category,proto3" json:"category,omitempty"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListAllProductsByCategoryRequest) Reset() {
-	*x = ListAllProductsByCategoryRequest{}
-	mi := &file_product_proto_msgTypes[12]
+func (x *RestoreProductRequest) Reset() {
+	*x = RestoreProductRequest{}
+	mi := &file_product_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RestoreProductRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RestoreProductRequest) ProtoMessage() {}
+
+func (x *RestoreProductRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_product_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RestoreProductRequest.ProtoReflect.Descriptor instead.
+func (*RestoreProductRequest) Descriptor() ([]byte, []int) {
+	return file_product_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *RestoreProductRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type ListAllProductsByNameRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Limit         int32                  `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`                         // page size, at most 100
+	PageToken     string                 `protobuf:"bytes,3,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"` // next_page_token of the previous page
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListAllProductsByNameRequest) Reset() {
+	*x = ListAllProductsByNameRequest{}
+	mi := &file_product_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListAllProductsByNameRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListAllProductsByNameRequest) ProtoMessage() {}
+
+func (x *ListAllProductsByNameRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_product_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListAllProductsByNameRequest.ProtoReflect.Descriptor instead.
+func (*ListAllProductsByNameRequest) Descriptor() ([]byte, []int) {
+	return file_product_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *ListAllProductsByNameRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *ListAllProductsByNameRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *ListAllProductsByNameRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+type ListAllProductsByNameResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Products      []*Product             `protobuf:"bytes,1,rep,name=products,proto3" json:"products,omitempty"`
+	NextPageToken string                 `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"` // empty on the last page
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListAllProductsByNameResponse) Reset() {
+	*x = ListAllProductsByNameResponse{}
+	mi := &file_product_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListAllProductsByNameResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListAllProductsByNameResponse) ProtoMessage() {}
+
+func (x *ListAllProductsByNameResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_product_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListAllProductsByNameResponse.ProtoReflect.Descriptor instead.
+func (*ListAllProductsByNameResponse) Descriptor() ([]byte, []int) {
+	return file_product_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *ListAllProductsByNameResponse) GetProducts() []*Product {
+	if x != nil {
+		return x.Products
+	}
+	return nil
+}
+
+func (x *ListAllProductsByNameResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+type ListAllProductsByCategoryRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Category      string                 `protobuf:"bytes,1,opt,name=category,proto3" json:"category,omitempty"`
+	CategoryId    string                 `protobuf:"bytes,2,opt,name=category_id,json=categoryId,proto3" json:"category_id,omitempty"` // includes products of every descendant category
+	Limit         int32                  `protobuf:"varint,3,opt,name=limit,proto3" json:"limit,omitempty"`
+	PageToken     string                 `protobuf:"bytes,4,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListAllProductsByCategoryRequest) Reset() {
+	*x = ListAllProductsByCategoryRequest{}
+	mi := &file_product_proto_msgTypes[16]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -751,10 +1133,405 @@ func (x *ListAllProductsByCategoryRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListAllProductsByCategoryRequest) ProtoMessage() {}
+func (*ListAllProductsByCategoryRequest) ProtoMessage() {}
+
+func (x *ListAllProductsByCategoryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_product_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListAllProductsByCategoryRequest.ProtoReflect.Descriptor instead.
+func (*ListAllProductsByCategoryRequest) Descriptor() ([]byte, []int) {
+	return file_product_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *ListAllProductsByCategoryRequest) GetCategory() string {
+	if x != nil {
+		return x.Category
+	}
+	return ""
+}
+
+func (x *ListAllProductsByCategoryRequest) GetCategoryId() string {
+	if x != nil {
+		return x.CategoryId
+	}
+	return ""
+}
+
+func (x *ListAllProductsByCategoryRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *ListAllProductsByCategoryRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+type ListAllProductsByTypeRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Type          string                 `protobuf:"bytes,1,opt,name=type,proto3" json:"type,omitempty"`
+	Category      string                 `protobuf:"bytes,2,opt,name=category,proto3" json:"category,omitempty"`
+	Limit         int32                  `protobuf:"varint,3,opt,name=limit,proto3" json:"limit,omitempty"`
+	PageToken     string                 `protobuf:"bytes,4,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListAllProductsByTypeRequest) Reset() {
+	*x = ListAllProductsByTypeRequest{}
+	mi := &file_product_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListAllProductsByTypeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListAllProductsByTypeRequest) ProtoMessage() {}
+
+func (x *ListAllProductsByTypeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_product_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListAllProductsByTypeRequest.ProtoReflect.Descriptor instead.
+func (*ListAllProductsByTypeRequest) Descriptor() ([]byte, []int) {
+	return file_product_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *ListAllProductsByTypeRequest) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *ListAllProductsByTypeRequest) GetCategory() string {
+	if x != nil {
+		return x.Category
+	}
+	return ""
+}
+
+func (x *ListAllProductsByTypeRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *ListAllProductsByTypeRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+type ListAllProductsByCategoryResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Products      []*Product             `protobuf:"bytes,1,rep,name=products,proto3" json:"products,omitempty"`
+	NextPageToken string                 `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListAllProductsByCategoryResponse) Reset() {
+	*x = ListAllProductsByCategoryResponse{}
+	mi := &file_product_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListAllProductsByCategoryResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListAllProductsByCategoryResponse) ProtoMessage() {}
+
+func (x *ListAllProductsByCategoryResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_product_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListAllProductsByCategoryResponse.ProtoReflect.Descriptor instead.
+func (*ListAllProductsByCategoryResponse) Descriptor() ([]byte, []int) {
+	return file_product_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *ListAllProductsByCategoryResponse) GetProducts() []*Product {
+	if x != nil {
+		return x.Products
+	}
+	return nil
+}
+
+func (x *ListAllProductsByCategoryResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+type ListAllProductsByCreateBy struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Limit         int32                  `protobuf:"varint,1,opt,name=limit,proto3" json:"limit,omitempty"`
+	PageToken     string                 `protobuf:"bytes,2,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListAllProductsByCreateBy) Reset() {
+	*x = ListAllProductsByCreateBy{}
+	mi := &file_product_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListAllProductsByCreateBy) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListAllProductsByCreateBy) ProtoMessage() {}
+
+func (x *ListAllProductsByCreateBy) ProtoReflect() protoreflect.Message {
+	mi := &file_product_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListAllProductsByCreateBy.ProtoReflect.Descriptor instead.
+func (*ListAllProductsByCreateBy) Descriptor() ([]byte, []int) {
+	return file_product_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *ListAllProductsByCreateBy) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *ListAllProductsByCreateBy) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+type SearchProductsRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Query string                 `protobuf:"bytes,1,opt,name=query,proto3" json:"query,omitempty"`
+	Limit int32                  `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"` // page size, at most 100
+	// text search configuration, defaults to the server's SEARCH_LANGUAGE
+	Language      string `protobuf:"bytes,4,opt,name=language,proto3" json:"language,omitempty"`
+	PageToken     string `protobuf:"bytes,5,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"` // next_page_token of the previous page
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SearchProductsRequest) Reset() {
+	*x = SearchProductsRequest{}
+	mi := &file_product_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SearchProductsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchProductsRequest) ProtoMessage() {}
+
+func (x *SearchProductsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_product_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SearchProductsRequest.ProtoReflect.Descriptor instead.
+func (*SearchProductsRequest) Descriptor() ([]byte, []int) {
+	return file_product_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *SearchProductsRequest) GetQuery() string {
+	if x != nil {
+		return x.Query
+	}
+	return ""
+}
+
+func (x *SearchProductsRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *SearchProductsRequest) GetLanguage() string {
+	if x != nil {
+		return x.Language
+	}
+	return ""
+}
+
+func (x *SearchProductsRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+// SearchHit carries the ranking and highlighted text of the product at the
+// same position in SearchProductsResponse.products, matches are wrapped in
+// <mark></mark> and everything else is HTML escaped
+type SearchHit struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ProductId     string                 `protobuf:"bytes,1,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
+	Rank          float32                `protobuf:"fixed32,2,opt,name=rank,proto3" json:"rank,omitempty"`
+	NameHighlight string                 `protobuf:"bytes,3,opt,name=name_highlight,json=nameHighlight,proto3" json:"name_highlight,omitempty"`
+	Snippet       string                 `protobuf:"bytes,4,opt,name=snippet,proto3" json:"snippet,omitempty"`
+	// set for typo tolerant matches found by name similarity
+	Fuzzy         bool `protobuf:"varint,5,opt,name=fuzzy,proto3" json:"fuzzy,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SearchHit) Reset() {
+	*x = SearchHit{}
+	mi := &file_product_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SearchHit) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchHit) ProtoMessage() {}
+
+func (x *SearchHit) ProtoReflect() protoreflect.Message {
+	mi := &file_product_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SearchHit.ProtoReflect.Descriptor instead.
+func (*SearchHit) Descriptor() ([]byte, []int) {
+	return file_product_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *SearchHit) GetProductId() string {
+	if x != nil {
+		return x.ProductId
+	}
+	return ""
+}
+
+func (x *SearchHit) GetRank() float32 {
+	if x != nil {
+		return x.Rank
+	}
+	return 0
+}
+
+func (x *SearchHit) GetNameHighlight() string {
+	if x != nil {
+		return x.NameHighlight
+	}
+	return ""
+}
+
+func (x *SearchHit) GetSnippet() string {
+	if x != nil {
+		return x.Snippet
+	}
+	return ""
+}
+
+func (x *SearchHit) GetFuzzy() bool {
+	if x != nil {
+		return x.Fuzzy
+	}
+	return false
+}
+
+type SearchProductsResponse struct {
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	Products []*Product             `protobuf:"bytes,1,rep,name=products,proto3" json:"products,omitempty"`
+	Total    int64                  `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"`
+	Hits     []*SearchHit           `protobuf:"bytes,3,rep,name=hits,proto3" json:"hits,omitempty"`
+	// the query with unknown words replaced by the closest product vocabulary,
+	// empty when every word is known or exact matches were plentiful
+	DidYouMean string `protobuf:"bytes,4,opt,name=did_you_mean,json=didYouMean,proto3" json:"did_you_mean,omitempty"`
+	// identifies this search in RecordSearchClick, set on the first page only,
+	// keep it for clicks on later pages
+	SearchId      string `protobuf:"bytes,5,opt,name=search_id,json=searchId,proto3" json:"search_id,omitempty"`
+	NextPageToken string `protobuf:"bytes,6,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"` // empty on the last page
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SearchProductsResponse) Reset() {
+	*x = SearchProductsResponse{}
+	mi := &file_product_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SearchProductsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchProductsResponse) ProtoMessage() {}
 
-func (x *ListAllProductsByCategoryRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_product_proto_msgTypes[12]
+func (x *SearchProductsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_product_proto_msgTypes[22]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -765,41 +1542,90 @@ func (x *ListAllProductsByCategoryRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListAllProductsByCategoryRequest.ProtoReflect.Descriptor instead.
-func (*ListAllProductsByCategoryRequest) Descriptor() ([]byte, []int) {
-	return file_product_proto_rawDescGZIP(), []int{12}
+// Deprecated: Use SearchProductsResponse.ProtoReflect.Descriptor instead.
+func (*SearchProductsResponse) Descriptor() ([]byte, []int) {
+	return file_product_proto_rawDescGZIP(), []int{22}
 }
 
-func (x *ListAllProductsByCategoryRequest) GetCategory() string {
+func (x *SearchProductsResponse) GetProducts() []*Product {
 	if x != nil {
-		return x.Category
+		return x.Products
+	}
+	return nil
+}
+
+func (x *SearchProductsResponse) GetTotal() int64 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+func (x *SearchProductsResponse) GetHits() []*SearchHit {
+	if x != nil {
+		return x.Hits
+	}
+	return nil
+}
+
+func (x *SearchProductsResponse) GetDidYouMean() string {
+	if x != nil {
+		return x.DidYouMean
 	}
 	return ""
 }
 
-type ListAllProductsByTypeRequest struct {
+func (x *SearchProductsResponse) GetSearchId() string {
+	if x != nil {
+		return x.SearchId
+	}
+	return ""
+}
+
+func (x *SearchProductsResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+// QueryProductsRequest lists published products. Empty or zero filters are
+// not applied.
+type QueryProductsRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Type          string                 `protobuf:"bytes,1,opt,name=type,proto3" json:"type,omitempty"`
-	Category      string                 `protobuf:"bytes,2,opt,name=category,proto3" json:"category,omitempty"`
+	Query         string                 `protobuf:"bytes,1,opt,name=query,proto3" json:"query,omitempty"` // full-text query, required for the "relevance" sort
+	Language      string                 `protobuf:"bytes,2,opt,name=language,proto3" json:"language,omitempty"`
+	Categories    []string               `protobuf:"bytes,3,rep,name=categories,proto3" json:"categories,omitempty"`
+	Types         []string               `protobuf:"bytes,4,rep,name=types,proto3" json:"types,omitempty"`
+	MinPrice      float64                `protobuf:"fixed64,5,opt,name=min_price,json=minPrice,proto3" json:"min_price,omitempty"` // compared with the effective price
+	MaxPrice      float64                `protobuf:"fixed64,6,opt,name=max_price,json=maxPrice,proto3" json:"max_price,omitempty"`
+	InStockOnly   bool                   `protobuf:"varint,7,opt,name=in_stock_only,json=inStockOnly,proto3" json:"in_stock_only,omitempty"`
+	SellerId      string                 `protobuf:"bytes,8,opt,name=seller_id,json=sellerId,proto3" json:"seller_id,omitempty"`
+	Organization  string                 `protobuf:"bytes,9,opt,name=organization,proto3" json:"organization,omitempty"`
+	MinRating     float64                `protobuf:"fixed64,10,opt,name=min_rating,json=minRating,proto3" json:"min_rating,omitempty"`
+	Sort          string                 `protobuf:"bytes,11,opt,name=sort,proto3" json:"sort,omitempty"`                                           // "newest" (default), "price_asc", "price_desc", "popularity", "rating", "relevance"
+	Limit         int32                  `protobuf:"varint,12,opt,name=limit,proto3" json:"limit,omitempty"`                                        // page size, at most 100
+	PriceBounds   []float64              `protobuf:"fixed64,14,rep,packed,name=price_bounds,json=priceBounds,proto3" json:"price_bounds,omitempty"` // ascending bucket edges for the price facet
+	PageToken     string                 `protobuf:"bytes,15,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`                // next_page_token of the previous page
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListAllProductsByTypeRequest) Reset() {
-	*x = ListAllProductsByTypeRequest{}
-	mi := &file_product_proto_msgTypes[13]
+func (x *QueryProductsRequest) Reset() {
+	*x = QueryProductsRequest{}
+	mi := &file_product_proto_msgTypes[23]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListAllProductsByTypeRequest) String() string {
+func (x *QueryProductsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListAllProductsByTypeRequest) ProtoMessage() {}
+func (*QueryProductsRequest) ProtoMessage() {}
 
-func (x *ListAllProductsByTypeRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_product_proto_msgTypes[13]
+func (x *QueryProductsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_product_proto_msgTypes[23]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -810,90 +1636,132 @@ func (x *ListAllProductsByTypeRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListAllProductsByTypeRequest.ProtoReflect.Descriptor instead.
-func (*ListAllProductsByTypeRequest) Descriptor() ([]byte, []int) {
-	return file_product_proto_rawDescGZIP(), []int{13}
+// Deprecated: Use QueryProductsRequest.ProtoReflect.Descriptor instead.
+func (*QueryProductsRequest) Descriptor() ([]byte, []int) {
+	return file_product_proto_rawDescGZIP(), []int{23}
 }
 
-func (x *ListAllProductsByTypeRequest) GetType() string {
+func (x *QueryProductsRequest) GetQuery() string {
 	if x != nil {
-		return x.Type
+		return x.Query
 	}
 	return ""
 }
 
-func (x *ListAllProductsByTypeRequest) GetCategory() string {
+func (x *QueryProductsRequest) GetLanguage() string {
 	if x != nil {
-		return x.Category
+		return x.Language
 	}
 	return ""
 }
 
-type ListAllProductsByCategoryResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Products      []*Product             `protobuf:"bytes,1,rep,name=products,proto3" json:"products,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+func (x *QueryProductsRequest) GetCategories() []string {
+	if x != nil {
+		return x.Categories
+	}
+	return nil
 }
 
-func (x *ListAllProductsByCategoryResponse) Reset() {
-	*x = ListAllProductsByCategoryResponse{}
-	mi := &file_product_proto_msgTypes[14]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
+func (x *QueryProductsRequest) GetTypes() []string {
+	if x != nil {
+		return x.Types
+	}
+	return nil
 }
 
-func (x *ListAllProductsByCategoryResponse) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *QueryProductsRequest) GetMinPrice() float64 {
+	if x != nil {
+		return x.MinPrice
+	}
+	return 0
 }
 
-func (*ListAllProductsByCategoryResponse) ProtoMessage() {}
+func (x *QueryProductsRequest) GetMaxPrice() float64 {
+	if x != nil {
+		return x.MaxPrice
+	}
+	return 0
+}
 
-func (x *ListAllProductsByCategoryResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_product_proto_msgTypes[14]
+func (x *QueryProductsRequest) GetInStockOnly() bool {
 	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+		return x.InStockOnly
 	}
-	return mi.MessageOf(x)
+	return false
 }
 
-// Deprecated: Use ListAllProductsByCategoryResponse.ProtoReflect.Descriptor instead.
-func (*ListAllProductsByCategoryResponse) Descriptor() ([]byte, []int) {
-	return file_product_proto_rawDescGZIP(), []int{14}
+func (x *QueryProductsRequest) GetSellerId() string {
+	if x != nil {
+		return x.SellerId
+	}
+	return ""
 }
 
-func (x *ListAllProductsByCategoryResponse) GetProducts() []*Product {
+func (x *QueryProductsRequest) GetOrganization() string {
 	if x != nil {
-		return x.Products
+		return x.Organization
+	}
+	return ""
+}
+
+func (x *QueryProductsRequest) GetMinRating() float64 {
+	if x != nil {
+		return x.MinRating
+	}
+	return 0
+}
+
+func (x *QueryProductsRequest) GetSort() string {
+	if x != nil {
+		return x.Sort
+	}
+	return ""
+}
+
+func (x *QueryProductsRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *QueryProductsRequest) GetPriceBounds() []float64 {
+	if x != nil {
+		return x.PriceBounds
 	}
 	return nil
 }
 
-type ListAllProductsByCreateBy struct {
+func (x *QueryProductsRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+type FacetCount struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
+	Value         string                 `protobuf:"bytes,1,opt,name=value,proto3" json:"value,omitempty"`
+	Count         int64                  `protobuf:"varint,2,opt,name=count,proto3" json:"count,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListAllProductsByCreateBy) Reset() {
-	*x = ListAllProductsByCreateBy{}
-	mi := &file_product_proto_msgTypes[15]
+func (x *FacetCount) Reset() {
+	*x = FacetCount{}
+	mi := &file_product_proto_msgTypes[24]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListAllProductsByCreateBy) String() string {
+func (x *FacetCount) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListAllProductsByCreateBy) ProtoMessage() {}
+func (*FacetCount) ProtoMessage() {}
 
-func (x *ListAllProductsByCreateBy) ProtoReflect() protoreflect.Message {
-	mi := &file_product_proto_msgTypes[15]
+func (x *FacetCount) ProtoReflect() protoreflect.Message {
+	mi := &file_product_proto_msgTypes[24]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -904,33 +1772,51 @@ func (x *ListAllProductsByCreateBy) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListAllProductsByCreateBy.ProtoReflect.Descriptor instead.
-func (*ListAllProductsByCreateBy) Descriptor() ([]byte, []int) {
-	return file_product_proto_rawDescGZIP(), []int{15}
+// Deprecated: Use FacetCount.ProtoReflect.Descriptor instead.
+func (*FacetCount) Descriptor() ([]byte, []int) {
+	return file_product_proto_rawDescGZIP(), []int{24}
 }
 
-type SearchProductsRequest struct {
+func (x *FacetCount) GetValue() string {
+	if x != nil {
+		return x.Value
+	}
+	return ""
+}
+
+func (x *FacetCount) GetCount() int64 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+// PriceBucket counts products priced from min up to, not including, max.
+// The first bucket has no min and the last no max, both are 0 then.
+type PriceBucket struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Query         string                 `protobuf:"bytes,1,opt,name=query,proto3" json:"query,omitempty"`
+	Min           float64                `protobuf:"fixed64,1,opt,name=min,proto3" json:"min,omitempty"`
+	Max           float64                `protobuf:"fixed64,2,opt,name=max,proto3" json:"max,omitempty"`
+	Count         int64                  `protobuf:"varint,3,opt,name=count,proto3" json:"count,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *SearchProductsRequest) Reset() {
-	*x = SearchProductsRequest{}
-	mi := &file_product_proto_msgTypes[16]
+func (x *PriceBucket) Reset() {
+	*x = PriceBucket{}
+	mi := &file_product_proto_msgTypes[25]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *SearchProductsRequest) String() string {
+func (x *PriceBucket) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SearchProductsRequest) ProtoMessage() {}
+func (*PriceBucket) ProtoMessage() {}
 
-func (x *SearchProductsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_product_proto_msgTypes[16]
+func (x *PriceBucket) ProtoReflect() protoreflect.Message {
+	mi := &file_product_proto_msgTypes[25]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -941,40 +1827,59 @@ func (x *SearchProductsRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SearchProductsRequest.ProtoReflect.Descriptor instead.
-func (*SearchProductsRequest) Descriptor() ([]byte, []int) {
-	return file_product_proto_rawDescGZIP(), []int{16}
+// Deprecated: Use PriceBucket.ProtoReflect.Descriptor instead.
+func (*PriceBucket) Descriptor() ([]byte, []int) {
+	return file_product_proto_rawDescGZIP(), []int{25}
 }
 
-func (x *SearchProductsRequest) GetQuery() string {
+func (x *PriceBucket) GetMin() float64 {
 	if x != nil {
-		return x.Query
+		return x.Min
 	}
-	return ""
+	return 0
 }
 
-type SearchProductsResponse struct {
+func (x *PriceBucket) GetMax() float64 {
+	if x != nil {
+		return x.Max
+	}
+	return 0
+}
+
+func (x *PriceBucket) GetCount() int64 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+type QueryProductsResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Products      []*Product             `protobuf:"bytes,1,rep,name=products,proto3" json:"products,omitempty"`
+	Total         int64                  `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"`
+	Categories    []*FacetCount          `protobuf:"bytes,3,rep,name=categories,proto3" json:"categories,omitempty"`
+	Types         []*FacetCount          `protobuf:"bytes,4,rep,name=types,proto3" json:"types,omitempty"`
+	PriceBuckets  []*PriceBucket         `protobuf:"bytes,5,rep,name=price_buckets,json=priceBuckets,proto3" json:"price_buckets,omitempty"`
+	NextPageToken string                 `protobuf:"bytes,6,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"` // empty on the last page
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *SearchProductsResponse) Reset() {
-	*x = SearchProductsResponse{}
-	mi := &file_product_proto_msgTypes[17]
+func (x *QueryProductsResponse) Reset() {
+	*x = QueryProductsResponse{}
+	mi := &file_product_proto_msgTypes[26]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *SearchProductsResponse) String() string {
+func (x *QueryProductsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SearchProductsResponse) ProtoMessage() {}
+func (*QueryProductsResponse) ProtoMessage() {}
 
-func (x *SearchProductsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_product_proto_msgTypes[17]
+func (x *QueryProductsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_product_proto_msgTypes[26]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -985,18 +1890,53 @@ func (x *SearchProductsResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SearchProductsResponse.ProtoReflect.Descriptor instead.
-func (*SearchProductsResponse) Descriptor() ([]byte, []int) {
-	return file_product_proto_rawDescGZIP(), []int{17}
+// Deprecated: Use QueryProductsResponse.ProtoReflect.Descriptor instead.
+func (*QueryProductsResponse) Descriptor() ([]byte, []int) {
+	return file_product_proto_rawDescGZIP(), []int{26}
 }
 
-func (x *SearchProductsResponse) GetProducts() []*Product {
+func (x *QueryProductsResponse) GetProducts() []*Product {
 	if x != nil {
 		return x.Products
 	}
 	return nil
 }
 
+func (x *QueryProductsResponse) GetTotal() int64 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+func (x *QueryProductsResponse) GetCategories() []*FacetCount {
+	if x != nil {
+		return x.Categories
+	}
+	return nil
+}
+
+func (x *QueryProductsResponse) GetTypes() []*FacetCount {
+	if x != nil {
+		return x.Types
+	}
+	return nil
+}
+
+func (x *QueryProductsResponse) GetPriceBuckets() []*PriceBucket {
+	if x != nil {
+		return x.PriceBuckets
+	}
+	return nil
+}
+
+func (x *QueryProductsResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
 type AutocompleteRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Query         string                 `protobuf:"bytes,1,opt,name=query,proto3" json:"query,omitempty"`
@@ -1007,7 +1947,7 @@ type AutocompleteRequest struct {
 
 func (x *AutocompleteRequest) Reset() {
 	*x = AutocompleteRequest{}
-	mi := &file_product_proto_msgTypes[18]
+	mi := &file_product_proto_msgTypes[27]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1019,7 +1959,7 @@ func (x *AutocompleteRequest) String() string {
 func (*AutocompleteRequest) ProtoMessage() {}
 
 func (x *AutocompleteRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_product_proto_msgTypes[18]
+	mi := &file_product_proto_msgTypes[27]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1032,7 +1972,7 @@ func (x *AutocompleteRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use AutocompleteRequest.ProtoReflect.Descriptor instead.
 func (*AutocompleteRequest) Descriptor() ([]byte, []int) {
-	return file_product_proto_rawDescGZIP(), []int{18}
+	return file_product_proto_rawDescGZIP(), []int{27}
 }
 
 func (x *AutocompleteRequest) GetQuery() string {
@@ -1052,13 +1992,14 @@ func (x *AutocompleteRequest) GetLimit() int32 {
 type AutocompleteResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Items         []*ProductSuggestion   `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
+	SearchId      string                 `protobuf:"bytes,2,opt,name=search_id,json=searchId,proto3" json:"search_id,omitempty"` // identifies this search in RecordSearchClick
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *AutocompleteResponse) Reset() {
 	*x = AutocompleteResponse{}
-	mi := &file_product_proto_msgTypes[19]
+	mi := &file_product_proto_msgTypes[28]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1070,7 +2011,7 @@ func (x *AutocompleteResponse) String() string {
 func (*AutocompleteResponse) ProtoMessage() {}
 
 func (x *AutocompleteResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_product_proto_msgTypes[19]
+	mi := &file_product_proto_msgTypes[28]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1083,7 +2024,7 @@ func (x *AutocompleteResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use AutocompleteResponse.ProtoReflect.Descriptor instead.
 func (*AutocompleteResponse) Descriptor() ([]byte, []int) {
-	return file_product_proto_rawDescGZIP(), []int{19}
+	return file_product_proto_rawDescGZIP(), []int{28}
 }
 
 func (x *AutocompleteResponse) GetItems() []*ProductSuggestion {
@@ -1093,6 +2034,13 @@ func (x *AutocompleteResponse) GetItems() []*ProductSuggestion {
 	return nil
 }
 
+func (x *AutocompleteResponse) GetSearchId() string {
+	if x != nil {
+		return x.SearchId
+	}
+	return ""
+}
+
 type ProductSuggestion struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
@@ -1106,7 +2054,7 @@ type ProductSuggestion struct {
 
 func (x *ProductSuggestion) Reset() {
 	*x = ProductSuggestion{}
-	mi := &file_product_proto_msgTypes[20]
+	mi := &file_product_proto_msgTypes[29]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1118,7 +2066,7 @@ func (x *ProductSuggestion) String() string {
 func (*ProductSuggestion) ProtoMessage() {}
 
 func (x *ProductSuggestion) ProtoReflect() protoreflect.Message {
-	mi := &file_product_proto_msgTypes[20]
+	mi := &file_product_proto_msgTypes[29]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1131,7 +2079,7 @@ func (x *ProductSuggestion) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ProductSuggestion.ProtoReflect.Descriptor instead.
 func (*ProductSuggestion) Descriptor() ([]byte, []int) {
-	return file_product_proto_rawDescGZIP(), []int{20}
+	return file_product_proto_rawDescGZIP(), []int{29}
 }
 
 func (x *ProductSuggestion) GetId() string {
@@ -1173,7 +2121,7 @@ var File_product_proto protoreflect.FileDescriptor
 
 const file_product_proto_rawDesc = "" +
 	"\n" +
-	"\rproduct.proto\x12\x02pb\"\x8a\x02\n" +
+	"\rproduct.proto\x12\x02pb\x1a google/protobuf/field_mask.proto\"\x88\x05\n" +
 	"\aProduct\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
 	"\x04name\x18\x02 \x01(\tR\x04name\x12 \n" +
@@ -1188,7 +2136,27 @@ const file_product_proto_rawDesc = "" +
 	"productUrl\x12\x1a\n" +
 	"\bcategory\x18\t \x01(\tR\bcategory\x12\x12\n" +
 	"\x04type\x18\n" +
-	" \x01(\tR\x04type\"\xc9\x01\n" +
+	" \x01(\tR\x04type\x12\x16\n" +
+	"\x06status\x18\v \x01(\tR\x06status\x12\x1f\n" +
+	"\vcategory_id\x18\f \x01(\tR\n" +
+	"categoryId\x12%\n" +
+	"\x0erating_average\x18\r \x01(\x01R\rratingAverage\x12!\n" +
+	"\frating_count\x18\x0e \x01(\x05R\vratingCount\x12\x18\n" +
+	"\aversion\x18\x0f \x01(\x05R\aversion\x12.\n" +
+	"\x13low_stock_threshold\x18\x10 \x01(\x05R\x11lowStockThreshold\x12\x1d\n" +
+	"\n" +
+	"sale_price\x18\x11 \x01(\x01R\tsalePrice\x12 \n" +
+	"\fsale_ends_at\x18\x12 \x01(\tR\n" +
+	"saleEndsAt\x12'\n" +
+	"\x0feffective_price\x18\x13 \x01(\x01R\x0eeffectivePrice\x12\x12\n" +
+	"\x04kind\x18\x14 \x01(\tR\x04kind\x121\n" +
+	"\fbundle_items\x18\x15 \x03(\v2\x0e.pb.BundleItemR\vbundleItems\"[\n" +
+	"\n" +
+	"BundleItem\x12\x1d\n" +
+	"\n" +
+	"product_id\x18\x01 \x01(\tR\tproductId\x12\x1a\n" +
+	"\bquantity\x18\x02 \x01(\x05R\bquantity\x12\x12\n" +
+	"\x04name\x18\x03 \x01(\tR\x04name\"\xf9\x02\n" +
 	"\x14CreateProductRequest\x12\x12\n" +
 	"\x04name\x18\x01 \x01(\tR\x04name\x12 \n" +
 	"\vdescription\x18\x02 \x01(\tR\vdescription\x12\x14\n" +
@@ -1197,16 +2165,25 @@ const file_product_proto_rawDesc = "" +
 	"\vproduct_url\x18\x05 \x01(\tR\n" +
 	"productUrl\x12\x1a\n" +
 	"\bcategory\x18\x06 \x01(\tR\bcategory\x12\x12\n" +
-	"\x04type\x18\a \x01(\tR\x04type\"#\n" +
+	"\x04type\x18\a \x01(\tR\x04type\x12\x16\n" +
+	"\x06status\x18\b \x01(\tR\x06status\x12\x1f\n" +
+	"\vcategory_id\x18\t \x01(\tR\n" +
+	"categoryId\x12.\n" +
+	"\x13low_stock_threshold\x18\n" +
+	" \x01(\x05R\x11lowStockThreshold\x12\x12\n" +
+	"\x04kind\x18\v \x01(\tR\x04kind\x121\n" +
+	"\fbundle_items\x18\f \x03(\v2\x0e.pb.BundleItemR\vbundleItems\"#\n" +
 	"\x11GetProductRequest\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\"'\n" +
 	"\x15GetOnlyProductRequest\x12\x0e\n" +
-	"\x02id\x18\x01 \x01(\tR\x02id\"F\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\"S\n" +
 	"\x16ListAllProductsRequest\x12\x14\n" +
-	"\x05limit\x18\x01 \x01(\x05R\x05limit\x12\x16\n" +
-	"\x06offset\x18\x02 \x01(\x05R\x06offset\"?\n" +
+	"\x05limit\x18\x01 \x01(\x05R\x05limit\x12\x1d\n" +
+	"\n" +
+	"page_token\x18\x03 \x01(\tR\tpageTokenJ\x04\b\x02\x10\x03\"g\n" +
 	"\x14ListProductsResponse\x12'\n" +
-	"\bproducts\x18\x01 \x03(\v2\v.pb.ProductR\bproducts\"\xd9\x01\n" +
+	"\bproducts\x18\x01 \x03(\v2\v.pb.ProductR\bproducts\x12&\n" +
+	"\x0fnext_page_token\x18\x02 \x01(\tR\rnextPageToken\"\xd1\x02\n" +
 	"\x14UpdateProductRequest\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
 	"\x04name\x18\x02 \x01(\tR\x04name\x12 \n" +
@@ -1216,34 +2193,117 @@ const file_product_proto_rawDesc = "" +
 	"\vproduct_url\x18\x06 \x01(\tR\n" +
 	"productUrl\x12\x1a\n" +
 	"\bcategory\x18\a \x01(\tR\bcategory\x12\x12\n" +
-	"\x04type\x18\b \x01(\tR\x04type\"&\n" +
+	"\x04type\x18\b \x01(\tR\x04type\x12\x1f\n" +
+	"\vcategory_id\x18\t \x01(\tR\n" +
+	"categoryId\x12;\n" +
+	"\vupdate_mask\x18\n" +
+	" \x01(\v2\x1a.google.protobuf.FieldMaskR\n" +
+	"updateMask\x12\x18\n" +
+	"\aversion\x18\v \x01(\x05R\aversion\"&\n" +
 	"\x14DeleteProductRequest\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\"8\n" +
 	"\x0fProductResponse\x12%\n" +
 	"\aproduct\x18\x01 \x01(\v2\v.pb.ProductR\aproduct\"1\n" +
 	"\x15DeleteProductResponse\x12\x18\n" +
-	"\amessage\x18\x01 \x01(\tR\amessage\"2\n" +
+	"\amessage\x18\x01 \x01(\tR\amessage\"'\n" +
+	"\x15PublishProductRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\"'\n" +
+	"\x15ArchiveProductRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\"'\n" +
+	"\x15RestoreProductRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\"g\n" +
 	"\x1cListAllProductsByNameRequest\x12\x12\n" +
-	"\x04name\x18\x01 \x01(\tR\x04name\"H\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x14\n" +
+	"\x05limit\x18\x02 \x01(\x05R\x05limit\x12\x1d\n" +
+	"\n" +
+	"page_token\x18\x03 \x01(\tR\tpageToken\"p\n" +
 	"\x1dListAllProductsByNameResponse\x12'\n" +
-	"\bproducts\x18\x01 \x03(\v2\v.pb.ProductR\bproducts\">\n" +
+	"\bproducts\x18\x01 \x03(\v2\v.pb.ProductR\bproducts\x12&\n" +
+	"\x0fnext_page_token\x18\x02 \x01(\tR\rnextPageToken\"\x94\x01\n" +
 	" ListAllProductsByCategoryRequest\x12\x1a\n" +
-	"\bcategory\x18\x01 \x01(\tR\bcategory\"N\n" +
+	"\bcategory\x18\x01 \x01(\tR\bcategory\x12\x1f\n" +
+	"\vcategory_id\x18\x02 \x01(\tR\n" +
+	"categoryId\x12\x14\n" +
+	"\x05limit\x18\x03 \x01(\x05R\x05limit\x12\x1d\n" +
+	"\n" +
+	"page_token\x18\x04 \x01(\tR\tpageToken\"\x83\x01\n" +
 	"\x1cListAllProductsByTypeRequest\x12\x12\n" +
 	"\x04type\x18\x01 \x01(\tR\x04type\x12\x1a\n" +
-	"\bcategory\x18\x02 \x01(\tR\bcategory\"L\n" +
+	"\bcategory\x18\x02 \x01(\tR\bcategory\x12\x14\n" +
+	"\x05limit\x18\x03 \x01(\x05R\x05limit\x12\x1d\n" +
+	"\n" +
+	"page_token\x18\x04 \x01(\tR\tpageToken\"t\n" +
 	"!ListAllProductsByCategoryResponse\x12'\n" +
-	"\bproducts\x18\x01 \x03(\v2\v.pb.ProductR\bproducts\"\x1b\n" +
-	"\x19ListAllProductsByCreateBy\"-\n" +
+	"\bproducts\x18\x01 \x03(\v2\v.pb.ProductR\bproducts\x12&\n" +
+	"\x0fnext_page_token\x18\x02 \x01(\tR\rnextPageToken\"P\n" +
+	"\x19ListAllProductsByCreateBy\x12\x14\n" +
+	"\x05limit\x18\x01 \x01(\x05R\x05limit\x12\x1d\n" +
+	"\n" +
+	"page_token\x18\x02 \x01(\tR\tpageToken\"\x84\x01\n" +
 	"\x15SearchProductsRequest\x12\x14\n" +
-	"\x05query\x18\x01 \x01(\tR\x05query\"A\n" +
+	"\x05query\x18\x01 \x01(\tR\x05query\x12\x14\n" +
+	"\x05limit\x18\x02 \x01(\x05R\x05limit\x12\x1a\n" +
+	"\blanguage\x18\x04 \x01(\tR\blanguage\x12\x1d\n" +
+	"\n" +
+	"page_token\x18\x05 \x01(\tR\tpageTokenJ\x04\b\x03\x10\x04\"\x95\x01\n" +
+	"\tSearchHit\x12\x1d\n" +
+	"\n" +
+	"product_id\x18\x01 \x01(\tR\tproductId\x12\x12\n" +
+	"\x04rank\x18\x02 \x01(\x02R\x04rank\x12%\n" +
+	"\x0ename_highlight\x18\x03 \x01(\tR\rnameHighlight\x12\x18\n" +
+	"\asnippet\x18\x04 \x01(\tR\asnippet\x12\x14\n" +
+	"\x05fuzzy\x18\x05 \x01(\bR\x05fuzzy\"\xe1\x01\n" +
 	"\x16SearchProductsResponse\x12'\n" +
-	"\bproducts\x18\x01 \x03(\v2\v.pb.ProductR\bproducts\"A\n" +
+	"\bproducts\x18\x01 \x03(\v2\v.pb.ProductR\bproducts\x12\x14\n" +
+	"\x05total\x18\x02 \x01(\x03R\x05total\x12!\n" +
+	"\x04hits\x18\x03 \x03(\v2\r.pb.SearchHitR\x04hits\x12 \n" +
+	"\fdid_you_mean\x18\x04 \x01(\tR\n" +
+	"didYouMean\x12\x1b\n" +
+	"\tsearch_id\x18\x05 \x01(\tR\bsearchId\x12&\n" +
+	"\x0fnext_page_token\x18\x06 \x01(\tR\rnextPageToken\"\xae\x03\n" +
+	"\x14QueryProductsRequest\x12\x14\n" +
+	"\x05query\x18\x01 \x01(\tR\x05query\x12\x1a\n" +
+	"\blanguage\x18\x02 \x01(\tR\blanguage\x12\x1e\n" +
+	"\n" +
+	"categories\x18\x03 \x03(\tR\n" +
+	"categories\x12\x14\n" +
+	"\x05types\x18\x04 \x03(\tR\x05types\x12\x1b\n" +
+	"\tmin_price\x18\x05 \x01(\x01R\bminPrice\x12\x1b\n" +
+	"\tmax_price\x18\x06 \x01(\x01R\bmaxPrice\x12\"\n" +
+	"\rin_stock_only\x18\a \x01(\bR\vinStockOnly\x12\x1b\n" +
+	"\tseller_id\x18\b \x01(\tR\bsellerId\x12\"\n" +
+	"\forganization\x18\t \x01(\tR\forganization\x12\x1d\n" +
+	"\n" +
+	"min_rating\x18\n" +
+	" \x01(\x01R\tminRating\x12\x12\n" +
+	"\x04sort\x18\v \x01(\tR\x04sort\x12\x14\n" +
+	"\x05limit\x18\f \x01(\x05R\x05limit\x12!\n" +
+	"\fprice_bounds\x18\x0e \x03(\x01R\vpriceBounds\x12\x1d\n" +
+	"\n" +
+	"page_token\x18\x0f \x01(\tR\tpageTokenJ\x04\b\r\x10\x0e\"8\n" +
+	"\n" +
+	"FacetCount\x12\x14\n" +
+	"\x05value\x18\x01 \x01(\tR\x05value\x12\x14\n" +
+	"\x05count\x18\x02 \x01(\x03R\x05count\"G\n" +
+	"\vPriceBucket\x12\x10\n" +
+	"\x03min\x18\x01 \x01(\x01R\x03min\x12\x10\n" +
+	"\x03max\x18\x02 \x01(\x01R\x03max\x12\x14\n" +
+	"\x05count\x18\x03 \x01(\x03R\x05count\"\x8a\x02\n" +
+	"\x15QueryProductsResponse\x12'\n" +
+	"\bproducts\x18\x01 \x03(\v2\v.pb.ProductR\bproducts\x12\x14\n" +
+	"\x05total\x18\x02 \x01(\x03R\x05total\x12.\n" +
+	"\n" +
+	"categories\x18\x03 \x03(\v2\x0e.pb.FacetCountR\n" +
+	"categories\x12$\n" +
+	"\x05types\x18\x04 \x03(\v2\x0e.pb.FacetCountR\x05types\x124\n" +
+	"\rprice_buckets\x18\x05 \x03(\v2\x0f.pb.PriceBucketR\fpriceBuckets\x12&\n" +
+	"\x0fnext_page_token\x18\x06 \x01(\tR\rnextPageToken\"A\n" +
 	"\x13AutocompleteRequest\x12\x14\n" +
 	"\x05query\x18\x01 \x01(\tR\x05query\x12\x14\n" +
-	"\x05limit\x18\x02 \x01(\x05R\x05limit\"C\n" +
+	"\x05limit\x18\x02 \x01(\x05R\x05limit\"`\n" +
 	"\x14AutocompleteResponse\x12+\n" +
-	"\x05items\x18\x01 \x03(\v2\x15.pb.ProductSuggestionR\x05items\"\x84\x01\n" +
+	"\x05items\x18\x01 \x03(\v2\x15.pb.ProductSuggestionR\x05items\x12\x1b\n" +
+	"\tsearch_id\x18\x02 \x01(\tR\bsearchId\"\x84\x01\n" +
 	"\x11ProductSuggestion\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
 	"\x04name\x18\x02 \x01(\tR\x04name\x12\x1b\n" +
@@ -1263,42 +2323,60 @@ func file_product_proto_rawDescGZIP() []byte {
 	return file_product_proto_rawDescData
 }
 
-var file_product_proto_msgTypes = make([]protoimpl.MessageInfo, 21)
+var file_product_proto_msgTypes = make([]protoimpl.MessageInfo, 30)
 var file_product_proto_goTypes = []any{
 	(*Product)(nil),                           // 0: pb.Product
-	(*CreateProductRequest)(nil),              // 1: pb.CreateProductRequest
-	(*GetProductRequest)(nil),                 // 2: pb.GetProductRequest
-	(*GetOnlyProductRequest)(nil),             // 3: pb.GetOnlyProductRequest
-	(*ListAllProductsRequest)(nil),            // 4: pb.ListAllProductsRequest
-	(*ListProductsResponse)(nil),              // 5: pb.ListProductsResponse
-	(*UpdateProductRequest)(nil),              // 6: pb.UpdateProductRequest
-	(*DeleteProductRequest)(nil),              // 7: pb.DeleteProductRequest
-	(*ProductResponse)(nil),                   // 8: pb.ProductResponse
-	(*DeleteProductResponse)(nil),             // 9: pb.DeleteProductResponse
-	(*ListAllProductsByNameRequest)(nil),      // 10: pb.ListAllProductsByNameRequest
-	(*ListAllProductsByNameResponse)(nil),     // 11: pb.ListAllProductsByNameResponse
-	(*ListAllProductsByCategoryRequest)(nil),  // 12: pb.ListAllProductsByCategoryRequest
-	(*ListAllProductsByTypeRequest)(nil),      // 13: pb.ListAllProductsByTypeRequest
-	(*ListAllProductsByCategoryResponse)(nil), // 14: pb.ListAllProductsByCategoryResponse
-	(*ListAllProductsByCreateBy)(nil),         // 15: pb.ListAllProductsByCreateBy
-	(*SearchProductsRequest)(nil),             // 16: pb.SearchProductsRequest
-	(*SearchProductsResponse)(nil),            // 17: pb.SearchProductsResponse
-	(*AutocompleteRequest)(nil),               // 18: pb.AutocompleteRequest
-	(*AutocompleteResponse)(nil),              // 19: pb.AutocompleteResponse
-	(*ProductSuggestion)(nil),                 // 20: pb.ProductSuggestion
+	(*BundleItem)(nil),                        // 1: pb.BundleItem
+	(*CreateProductRequest)(nil),              // 2: pb.CreateProductRequest
+	(*GetProductRequest)(nil),                 // 3: pb.GetProductRequest
+	(*GetOnlyProductRequest)(nil),             // 4: pb.GetOnlyProductRequest
+	(*ListAllProductsRequest)(nil),            // 5: pb.ListAllProductsRequest
+	(*ListProductsResponse)(nil),              // 6: pb.ListProductsResponse
+	(*UpdateProductRequest)(nil),              // 7: pb.UpdateProductRequest
+	(*DeleteProductRequest)(nil),              // 8: pb.DeleteProductRequest
+	(*ProductResponse)(nil),                   // 9: pb.ProductResponse
+	(*DeleteProductResponse)(nil),             // 10: pb.DeleteProductResponse
+	(*PublishProductRequest)(nil),             // 11: pb.PublishProductRequest
+	(*ArchiveProductRequest)(nil),             // 12: pb.ArchiveProductRequest
+	(*RestoreProductRequest)(nil),             // 13: pb.RestoreProductRequest
+	(*ListAllProductsByNameRequest)(nil),      // 14: pb.ListAllProductsByNameRequest
+	(*ListAllProductsByNameResponse)(nil),     // 15: pb.ListAllProductsByNameResponse
+	(*ListAllProductsByCategoryRequest)(nil),  // 16: pb.ListAllProductsByCategoryRequest
+	(*ListAllProductsByTypeRequest)(nil),      // 17: pb.ListAllProductsByTypeRequest
+	(*ListAllProductsByCategoryResponse)(nil), // 18: pb.ListAllProductsByCategoryResponse
+	(*ListAllProductsByCreateBy)(nil),         // 19: pb.ListAllProductsByCreateBy
+	(*SearchProductsRequest)(nil),             // 20: pb.SearchProductsRequest
+	(*SearchHit)(nil),                         // 21: pb.SearchHit
+	(*SearchProductsResponse)(nil),            // 22: pb.SearchProductsResponse
+	(*QueryProductsRequest)(nil),              // 23: pb.QueryProductsRequest
+	(*FacetCount)(nil),                        // 24: pb.FacetCount
+	(*PriceBucket)(nil),                       // 25: pb.PriceBucket
+	(*QueryProductsResponse)(nil),             // 26: pb.QueryProductsResponse
+	(*AutocompleteRequest)(nil),               // 27: pb.AutocompleteRequest
+	(*AutocompleteResponse)(nil),              // 28: pb.AutocompleteResponse
+	(*ProductSuggestion)(nil),                 // 29: pb.ProductSuggestion
+	(*fieldmaskpb.FieldMask)(nil),             // 30: google.protobuf.FieldMask
 }
 var file_product_proto_depIdxs = []int32{
-	0,  // 0: pb.ListProductsResponse.products:type_name -> pb.Product
-	0,  // 1: pb.ProductResponse.product:type_name -> pb.Product
-	0,  // 2: pb.ListAllProductsByNameResponse.products:type_name -> pb.Product
-	0,  // 3: pb.ListAllProductsByCategoryResponse.products:type_name -> pb.Product
-	0,  // 4: pb.SearchProductsResponse.products:type_name -> pb.Product
-	20, // 5: pb.AutocompleteResponse.items:type_name -> pb.ProductSuggestion
-	6,  // [6:6] is the sub-list for method output_type
-	6,  // [6:6] is the sub-list for method input_type
-	6,  // [6:6] is the sub-list for extension type_name
-	6,  // [6:6] is the sub-list for extension extendee
-	0,  // [0:6] is the sub-list for field type_name
+	1,  // 0: pb.Product.bundle_items:type_name -> pb.BundleItem
+	1,  // 1: pb.CreateProductRequest.bundle_items:type_name -> pb.BundleItem
+	0,  // 2: pb.ListProductsResponse.products:type_name -> pb.Product
+	30, // 3: pb.UpdateProductRequest.update_mask:type_name -> google.protobuf.FieldMask
+	0,  // 4: pb.ProductResponse.product:type_name -> pb.Product
+	0,  // 5: pb.ListAllProductsByNameResponse.products:type_name -> pb.Product
+	0,  // 6: pb.ListAllProductsByCategoryResponse.products:type_name -> pb.Product
+	0,  // 7: pb.SearchProductsResponse.products:type_name -> pb.Product
+	21, // 8: pb.SearchProductsResponse.hits:type_name -> pb.SearchHit
+	0,  // 9: pb.QueryProductsResponse.products:type_name -> pb.Product
+	24, // 10: pb.QueryProductsResponse.categories:type_name -> pb.FacetCount
+	24, // 11: pb.QueryProductsResponse.types:type_name -> pb.FacetCount
+	25, // 12: pb.QueryProductsResponse.price_buckets:type_name -> pb.PriceBucket
+	29, // 13: pb.AutocompleteResponse.items:type_name -> pb.ProductSuggestion
+	14, // [14:14] is the sub-list for method output_type
+	14, // [14:14] is the sub-list for method input_type
+	14, // [14:14] is the sub-list for extension type_name
+	14, // [14:14] is the sub-list for extension extendee
+	0,  // [0:14] is the sub-list for field type_name
 }
 
 func init() { file_product_proto_init() }
@@ -1312,7 +2390,7 @@ func file_product_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_product_proto_rawDesc), len(file_product_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   21,
+			NumMessages:   30,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
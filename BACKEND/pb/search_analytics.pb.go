@@ -0,0 +1,585 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.10
+// 	protoc        v3.12.4
+// source: search_analytics.proto
+
+package pb
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type RecordSearchClickRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	SearchId      string                 `protobuf:"bytes,1,opt,name=search_id,json=searchId,proto3" json:"search_id,omitempty"` // from SearchProductsResponse or AutocompleteResponse
+	ProductId     string                 `protobuf:"bytes,2,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
+	Position      int32                  `protobuf:"varint,3,opt,name=position,proto3" json:"position,omitempty"` // zero based position of the product in the results
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RecordSearchClickRequest) Reset() {
+	*x = RecordSearchClickRequest{}
+	mi := &file_search_analytics_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RecordSearchClickRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RecordSearchClickRequest) ProtoMessage() {}
+
+func (x *RecordSearchClickRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_search_analytics_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RecordSearchClickRequest.ProtoReflect.Descriptor instead.
+func (*RecordSearchClickRequest) Descriptor() ([]byte, []int) {
+	return file_search_analytics_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *RecordSearchClickRequest) GetSearchId() string {
+	if x != nil {
+		return x.SearchId
+	}
+	return ""
+}
+
+func (x *RecordSearchClickRequest) GetProductId() string {
+	if x != nil {
+		return x.ProductId
+	}
+	return ""
+}
+
+func (x *RecordSearchClickRequest) GetPosition() int32 {
+	if x != nil {
+		return x.Position
+	}
+	return 0
+}
+
+type RecordSearchClickResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Message       string                 `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RecordSearchClickResponse) Reset() {
+	*x = RecordSearchClickResponse{}
+	mi := &file_search_analytics_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RecordSearchClickResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RecordSearchClickResponse) ProtoMessage() {}
+
+func (x *RecordSearchClickResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_search_analytics_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RecordSearchClickResponse.ProtoReflect.Descriptor instead.
+func (*RecordSearchClickResponse) Descriptor() ([]byte, []int) {
+	return file_search_analytics_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *RecordSearchClickResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+// SearchAnalyticsRequest selects the searches of a period. Dates are
+// "2006-01-02", to is inclusive, the default is the last 30 days.
+type SearchAnalyticsRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	From  string                 `protobuf:"bytes,1,opt,name=from,proto3" json:"from,omitempty"`
+	To    string                 `protobuf:"bytes,2,opt,name=to,proto3" json:"to,omitempty"`
+	// "search" (default), "autocomplete" or "all". Autocomplete logs every
+	// keystroke's prefix, so it is left out unless asked for
+	Source        string `protobuf:"bytes,3,opt,name=source,proto3" json:"source,omitempty"`
+	Limit         int32  `protobuf:"varint,4,opt,name=limit,proto3" json:"limit,omitempty"` // defaults to 20, at most 100
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SearchAnalyticsRequest) Reset() {
+	*x = SearchAnalyticsRequest{}
+	mi := &file_search_analytics_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SearchAnalyticsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchAnalyticsRequest) ProtoMessage() {}
+
+func (x *SearchAnalyticsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_search_analytics_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SearchAnalyticsRequest.ProtoReflect.Descriptor instead.
+func (*SearchAnalyticsRequest) Descriptor() ([]byte, []int) {
+	return file_search_analytics_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *SearchAnalyticsRequest) GetFrom() string {
+	if x != nil {
+		return x.From
+	}
+	return ""
+}
+
+func (x *SearchAnalyticsRequest) GetTo() string {
+	if x != nil {
+		return x.To
+	}
+	return ""
+}
+
+func (x *SearchAnalyticsRequest) GetSource() string {
+	if x != nil {
+		return x.Source
+	}
+	return ""
+}
+
+func (x *SearchAnalyticsRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+type SearchQueryStat struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	Query           string                 `protobuf:"bytes,1,opt,name=query,proto3" json:"query,omitempty"`
+	Searches        int64                  `protobuf:"varint,2,opt,name=searches,proto3" json:"searches,omitempty"`
+	AvgResults      float64                `protobuf:"fixed64,3,opt,name=avg_results,json=avgResults,proto3" json:"avg_results,omitempty"`
+	ClickedSearches int64                  `protobuf:"varint,4,opt,name=clicked_searches,json=clickedSearches,proto3" json:"clicked_searches,omitempty"`
+	Ctr             float64                `protobuf:"fixed64,5,opt,name=ctr,proto3" json:"ctr,omitempty"` // clicked_searches / searches
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *SearchQueryStat) Reset() {
+	*x = SearchQueryStat{}
+	mi := &file_search_analytics_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SearchQueryStat) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchQueryStat) ProtoMessage() {}
+
+func (x *SearchQueryStat) ProtoReflect() protoreflect.Message {
+	mi := &file_search_analytics_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SearchQueryStat.ProtoReflect.Descriptor instead.
+func (*SearchQueryStat) Descriptor() ([]byte, []int) {
+	return file_search_analytics_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *SearchQueryStat) GetQuery() string {
+	if x != nil {
+		return x.Query
+	}
+	return ""
+}
+
+func (x *SearchQueryStat) GetSearches() int64 {
+	if x != nil {
+		return x.Searches
+	}
+	return 0
+}
+
+func (x *SearchQueryStat) GetAvgResults() float64 {
+	if x != nil {
+		return x.AvgResults
+	}
+	return 0
+}
+
+func (x *SearchQueryStat) GetClickedSearches() int64 {
+	if x != nil {
+		return x.ClickedSearches
+	}
+	return 0
+}
+
+func (x *SearchQueryStat) GetCtr() float64 {
+	if x != nil {
+		return x.Ctr
+	}
+	return 0
+}
+
+type TopQueriesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Queries       []*SearchQueryStat     `protobuf:"bytes,1,rep,name=queries,proto3" json:"queries,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TopQueriesResponse) Reset() {
+	*x = TopQueriesResponse{}
+	mi := &file_search_analytics_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TopQueriesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TopQueriesResponse) ProtoMessage() {}
+
+func (x *TopQueriesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_search_analytics_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TopQueriesResponse.ProtoReflect.Descriptor instead.
+func (*TopQueriesResponse) Descriptor() ([]byte, []int) {
+	return file_search_analytics_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *TopQueriesResponse) GetQueries() []*SearchQueryStat {
+	if x != nil {
+		return x.Queries
+	}
+	return nil
+}
+
+type ZeroResultQuery struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Query          string                 `protobuf:"bytes,1,opt,name=query,proto3" json:"query,omitempty"`
+	Searches       int64                  `protobuf:"varint,2,opt,name=searches,proto3" json:"searches,omitempty"`
+	LastSearchedAt string                 `protobuf:"bytes,3,opt,name=last_searched_at,json=lastSearchedAt,proto3" json:"last_searched_at,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *ZeroResultQuery) Reset() {
+	*x = ZeroResultQuery{}
+	mi := &file_search_analytics_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ZeroResultQuery) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ZeroResultQuery) ProtoMessage() {}
+
+func (x *ZeroResultQuery) ProtoReflect() protoreflect.Message {
+	mi := &file_search_analytics_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ZeroResultQuery.ProtoReflect.Descriptor instead.
+func (*ZeroResultQuery) Descriptor() ([]byte, []int) {
+	return file_search_analytics_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *ZeroResultQuery) GetQuery() string {
+	if x != nil {
+		return x.Query
+	}
+	return ""
+}
+
+func (x *ZeroResultQuery) GetSearches() int64 {
+	if x != nil {
+		return x.Searches
+	}
+	return 0
+}
+
+func (x *ZeroResultQuery) GetLastSearchedAt() string {
+	if x != nil {
+		return x.LastSearchedAt
+	}
+	return ""
+}
+
+type ZeroResultQueriesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Queries       []*ZeroResultQuery     `protobuf:"bytes,1,rep,name=queries,proto3" json:"queries,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ZeroResultQueriesResponse) Reset() {
+	*x = ZeroResultQueriesResponse{}
+	mi := &file_search_analytics_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ZeroResultQueriesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ZeroResultQueriesResponse) ProtoMessage() {}
+
+func (x *ZeroResultQueriesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_search_analytics_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ZeroResultQueriesResponse.ProtoReflect.Descriptor instead.
+func (*ZeroResultQueriesResponse) Descriptor() ([]byte, []int) {
+	return file_search_analytics_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *ZeroResultQueriesResponse) GetQueries() []*ZeroResultQuery {
+	if x != nil {
+		return x.Queries
+	}
+	return nil
+}
+
+type SearchCTRResponse struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	Searches        int64                  `protobuf:"varint,1,opt,name=searches,proto3" json:"searches,omitempty"`
+	ClickedSearches int64                  `protobuf:"varint,2,opt,name=clicked_searches,json=clickedSearches,proto3" json:"clicked_searches,omitempty"` // searches with at least one click
+	Clicks          int64                  `protobuf:"varint,3,opt,name=clicks,proto3" json:"clicks,omitempty"`
+	Ctr             float64                `protobuf:"fixed64,4,opt,name=ctr,proto3" json:"ctr,omitempty"` // clicked_searches / searches
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *SearchCTRResponse) Reset() {
+	*x = SearchCTRResponse{}
+	mi := &file_search_analytics_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SearchCTRResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchCTRResponse) ProtoMessage() {}
+
+func (x *SearchCTRResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_search_analytics_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SearchCTRResponse.ProtoReflect.Descriptor instead.
+func (*SearchCTRResponse) Descriptor() ([]byte, []int) {
+	return file_search_analytics_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *SearchCTRResponse) GetSearches() int64 {
+	if x != nil {
+		return x.Searches
+	}
+	return 0
+}
+
+func (x *SearchCTRResponse) GetClickedSearches() int64 {
+	if x != nil {
+		return x.ClickedSearches
+	}
+	return 0
+}
+
+func (x *SearchCTRResponse) GetClicks() int64 {
+	if x != nil {
+		return x.Clicks
+	}
+	return 0
+}
+
+func (x *SearchCTRResponse) GetCtr() float64 {
+	if x != nil {
+		return x.Ctr
+	}
+	return 0
+}
+
+var File_search_analytics_proto protoreflect.FileDescriptor
+
+const file_search_analytics_proto_rawDesc = "" +
+	"\n" +
+	"\x16search_analytics.proto\x12\x02pb\"r\n" +
+	"\x18RecordSearchClickRequest\x12\x1b\n" +
+	"\tsearch_id\x18\x01 \x01(\tR\bsearchId\x12\x1d\n" +
+	"\n" +
+	"product_id\x18\x02 \x01(\tR\tproductId\x12\x1a\n" +
+	"\bposition\x18\x03 \x01(\x05R\bposition\"5\n" +
+	"\x19RecordSearchClickResponse\x12\x18\n" +
+	"\amessage\x18\x01 \x01(\tR\amessage\"j\n" +
+	"\x16SearchAnalyticsRequest\x12\x12\n" +
+	"\x04from\x18\x01 \x01(\tR\x04from\x12\x0e\n" +
+	"\x02to\x18\x02 \x01(\tR\x02to\x12\x16\n" +
+	"\x06source\x18\x03 \x01(\tR\x06source\x12\x14\n" +
+	"\x05limit\x18\x04 \x01(\x05R\x05limit\"\xa1\x01\n" +
+	"\x0fSearchQueryStat\x12\x14\n" +
+	"\x05query\x18\x01 \x01(\tR\x05query\x12\x1a\n" +
+	"\bsearches\x18\x02 \x01(\x03R\bsearches\x12\x1f\n" +
+	"\vavg_results\x18\x03 \x01(\x01R\n" +
+	"avgResults\x12)\n" +
+	"\x10clicked_searches\x18\x04 \x01(\x03R\x0fclickedSearches\x12\x10\n" +
+	"\x03ctr\x18\x05 \x01(\x01R\x03ctr\"C\n" +
+	"\x12TopQueriesResponse\x12-\n" +
+	"\aqueries\x18\x01 \x03(\v2\x13.pb.SearchQueryStatR\aqueries\"m\n" +
+	"\x0fZeroResultQuery\x12\x14\n" +
+	"\x05query\x18\x01 \x01(\tR\x05query\x12\x1a\n" +
+	"\bsearches\x18\x02 \x01(\x03R\bsearches\x12(\n" +
+	"\x10last_searched_at\x18\x03 \x01(\tR\x0elastSearchedAt\"J\n" +
+	"\x19ZeroResultQueriesResponse\x12-\n" +
+	"\aqueries\x18\x01 \x03(\v2\x13.pb.ZeroResultQueryR\aqueries\"\x84\x01\n" +
+	"\x11SearchCTRResponse\x12\x1a\n" +
+	"\bsearches\x18\x01 \x01(\x03R\bsearches\x12)\n" +
+	"\x10clicked_searches\x18\x02 \x01(\x03R\x0fclickedSearches\x12\x16\n" +
+	"\x06clicks\x18\x03 \x01(\x03R\x06clicks\x12\x10\n" +
+	"\x03ctr\x18\x04 \x01(\x01R\x03ctrB@Z>github.com/siddheshRajendraNimbalkar/collage-prject-backend/pbb\x06proto3"
+
+var (
+	file_search_analytics_proto_rawDescOnce sync.Once
+	file_search_analytics_proto_rawDescData []byte
+)
+
+func file_search_analytics_proto_rawDescGZIP() []byte {
+	file_search_analytics_proto_rawDescOnce.Do(func() {
+		file_search_analytics_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_search_analytics_proto_rawDesc), len(file_search_analytics_proto_rawDesc)))
+	})
+	return file_search_analytics_proto_rawDescData
+}
+
+var file_search_analytics_proto_msgTypes = make([]protoimpl.MessageInfo, 8)
+var file_search_analytics_proto_goTypes = []any{
+	(*RecordSearchClickRequest)(nil),  // 0: pb.RecordSearchClickRequest
+	(*RecordSearchClickResponse)(nil), // 1: pb.RecordSearchClickResponse
+	(*SearchAnalyticsRequest)(nil),    // 2: pb.SearchAnalyticsRequest
+	(*SearchQueryStat)(nil),           // 3: pb.SearchQueryStat
+	(*TopQueriesResponse)(nil),        // 4: pb.TopQueriesResponse
+	(*ZeroResultQuery)(nil),           // 5: pb.ZeroResultQuery
+	(*ZeroResultQueriesResponse)(nil), // 6: pb.ZeroResultQueriesResponse
+	(*SearchCTRResponse)(nil),         // 7: pb.SearchCTRResponse
+}
+var file_search_analytics_proto_depIdxs = []int32{
+	3, // 0: pb.TopQueriesResponse.queries:type_name -> pb.SearchQueryStat
+	5, // 1: pb.ZeroResultQueriesResponse.queries:type_name -> pb.ZeroResultQuery
+	2, // [2:2] is the sub-list for method output_type
+	2, // [2:2] is the sub-list for method input_type
+	2, // [2:2] is the sub-list for extension type_name
+	2, // [2:2] is the sub-list for extension extendee
+	0, // [0:2] is the sub-list for field type_name
+}
+
+func init() { file_search_analytics_proto_init() }
+func file_search_analytics_proto_init() {
+	if File_search_analytics_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_search_analytics_proto_rawDesc), len(file_search_analytics_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   8,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_search_analytics_proto_goTypes,
+		DependencyIndexes: file_search_analytics_proto_depIdxs,
+		MessageInfos:      file_search_analytics_proto_msgTypes,
+	}.Build()
+	File_search_analytics_proto = out.File
+	file_search_analytics_proto_goTypes = nil
+	file_search_analytics_proto_depIdxs = nil
+}
@@ -0,0 +1,483 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.10
+// 	protoc        v3.12.4
+// source: search_dictionary.proto
+
+package pb
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type SynonymGroup struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Terms         []string               `protobuf:"bytes,2,rep,name=terms,proto3" json:"terms,omitempty"` // normalized, a term may be a phrase
+	CreatedBy     string                 `protobuf:"bytes,3,opt,name=created_by,json=createdBy,proto3" json:"created_by,omitempty"`
+	CreatedAt     string                 `protobuf:"bytes,4,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SynonymGroup) Reset() {
+	*x = SynonymGroup{}
+	mi := &file_search_dictionary_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SynonymGroup) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SynonymGroup) ProtoMessage() {}
+
+func (x *SynonymGroup) ProtoReflect() protoreflect.Message {
+	mi := &file_search_dictionary_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SynonymGroup.ProtoReflect.Descriptor instead.
+func (*SynonymGroup) Descriptor() ([]byte, []int) {
+	return file_search_dictionary_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *SynonymGroup) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *SynonymGroup) GetTerms() []string {
+	if x != nil {
+		return x.Terms
+	}
+	return nil
+}
+
+func (x *SynonymGroup) GetCreatedBy() string {
+	if x != nil {
+		return x.CreatedBy
+	}
+	return ""
+}
+
+func (x *SynonymGroup) GetCreatedAt() string {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return ""
+}
+
+type GetSearchDictionaryRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetSearchDictionaryRequest) Reset() {
+	*x = GetSearchDictionaryRequest{}
+	mi := &file_search_dictionary_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetSearchDictionaryRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetSearchDictionaryRequest) ProtoMessage() {}
+
+func (x *GetSearchDictionaryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_search_dictionary_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetSearchDictionaryRequest.ProtoReflect.Descriptor instead.
+func (*GetSearchDictionaryRequest) Descriptor() ([]byte, []int) {
+	return file_search_dictionary_proto_rawDescGZIP(), []int{1}
+}
+
+type SearchDictionaryResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	SynonymGroups []*SynonymGroup        `protobuf:"bytes,1,rep,name=synonym_groups,json=synonymGroups,proto3" json:"synonym_groups,omitempty"`
+	StopWords     []string               `protobuf:"bytes,2,rep,name=stop_words,json=stopWords,proto3" json:"stop_words,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SearchDictionaryResponse) Reset() {
+	*x = SearchDictionaryResponse{}
+	mi := &file_search_dictionary_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SearchDictionaryResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchDictionaryResponse) ProtoMessage() {}
+
+func (x *SearchDictionaryResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_search_dictionary_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SearchDictionaryResponse.ProtoReflect.Descriptor instead.
+func (*SearchDictionaryResponse) Descriptor() ([]byte, []int) {
+	return file_search_dictionary_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *SearchDictionaryResponse) GetSynonymGroups() []*SynonymGroup {
+	if x != nil {
+		return x.SynonymGroups
+	}
+	return nil
+}
+
+func (x *SearchDictionaryResponse) GetStopWords() []string {
+	if x != nil {
+		return x.StopWords
+	}
+	return nil
+}
+
+type CreateSynonymGroupRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Terms         []string               `protobuf:"bytes,1,rep,name=terms,proto3" json:"terms,omitempty"` // at least two, all searched as one another
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateSynonymGroupRequest) Reset() {
+	*x = CreateSynonymGroupRequest{}
+	mi := &file_search_dictionary_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateSynonymGroupRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateSynonymGroupRequest) ProtoMessage() {}
+
+func (x *CreateSynonymGroupRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_search_dictionary_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateSynonymGroupRequest.ProtoReflect.Descriptor instead.
+func (*CreateSynonymGroupRequest) Descriptor() ([]byte, []int) {
+	return file_search_dictionary_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *CreateSynonymGroupRequest) GetTerms() []string {
+	if x != nil {
+		return x.Terms
+	}
+	return nil
+}
+
+type SynonymGroupResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	SynonymGroup  *SynonymGroup          `protobuf:"bytes,1,opt,name=synonym_group,json=synonymGroup,proto3" json:"synonym_group,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SynonymGroupResponse) Reset() {
+	*x = SynonymGroupResponse{}
+	mi := &file_search_dictionary_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SynonymGroupResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SynonymGroupResponse) ProtoMessage() {}
+
+func (x *SynonymGroupResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_search_dictionary_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SynonymGroupResponse.ProtoReflect.Descriptor instead.
+func (*SynonymGroupResponse) Descriptor() ([]byte, []int) {
+	return file_search_dictionary_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *SynonymGroupResponse) GetSynonymGroup() *SynonymGroup {
+	if x != nil {
+		return x.SynonymGroup
+	}
+	return nil
+}
+
+type DeleteSynonymGroupRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteSynonymGroupRequest) Reset() {
+	*x = DeleteSynonymGroupRequest{}
+	mi := &file_search_dictionary_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteSynonymGroupRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteSynonymGroupRequest) ProtoMessage() {}
+
+func (x *DeleteSynonymGroupRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_search_dictionary_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteSynonymGroupRequest.ProtoReflect.Descriptor instead.
+func (*DeleteSynonymGroupRequest) Descriptor() ([]byte, []int) {
+	return file_search_dictionary_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *DeleteSynonymGroupRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type StopWordRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Word          string                 `protobuf:"bytes,1,opt,name=word,proto3" json:"word,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *StopWordRequest) Reset() {
+	*x = StopWordRequest{}
+	mi := &file_search_dictionary_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StopWordRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StopWordRequest) ProtoMessage() {}
+
+func (x *StopWordRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_search_dictionary_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StopWordRequest.ProtoReflect.Descriptor instead.
+func (*StopWordRequest) Descriptor() ([]byte, []int) {
+	return file_search_dictionary_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *StopWordRequest) GetWord() string {
+	if x != nil {
+		return x.Word
+	}
+	return ""
+}
+
+type SearchDictionaryMessageResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Message       string                 `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SearchDictionaryMessageResponse) Reset() {
+	*x = SearchDictionaryMessageResponse{}
+	mi := &file_search_dictionary_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SearchDictionaryMessageResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchDictionaryMessageResponse) ProtoMessage() {}
+
+func (x *SearchDictionaryMessageResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_search_dictionary_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SearchDictionaryMessageResponse.ProtoReflect.Descriptor instead.
+func (*SearchDictionaryMessageResponse) Descriptor() ([]byte, []int) {
+	return file_search_dictionary_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *SearchDictionaryMessageResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+var File_search_dictionary_proto protoreflect.FileDescriptor
+
+const file_search_dictionary_proto_rawDesc = "" +
+	"\n" +
+	"\x17search_dictionary.proto\x12\x02pb\"r\n" +
+	"\fSynonymGroup\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x14\n" +
+	"\x05terms\x18\x02 \x03(\tR\x05terms\x12\x1d\n" +
+	"\n" +
+	"created_by\x18\x03 \x01(\tR\tcreatedBy\x12\x1d\n" +
+	"\n" +
+	"created_at\x18\x04 \x01(\tR\tcreatedAt\"\x1c\n" +
+	"\x1aGetSearchDictionaryRequest\"r\n" +
+	"\x18SearchDictionaryResponse\x127\n" +
+	"\x0esynonym_groups\x18\x01 \x03(\v2\x10.pb.SynonymGroupR\rsynonymGroups\x12\x1d\n" +
+	"\n" +
+	"stop_words\x18\x02 \x03(\tR\tstopWords\"1\n" +
+	"\x19CreateSynonymGroupRequest\x12\x14\n" +
+	"\x05terms\x18\x01 \x03(\tR\x05terms\"M\n" +
+	"\x14SynonymGroupResponse\x125\n" +
+	"\rsynonym_group\x18\x01 \x01(\v2\x10.pb.SynonymGroupR\fsynonymGroup\"+\n" +
+	"\x19DeleteSynonymGroupRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\"%\n" +
+	"\x0fStopWordRequest\x12\x12\n" +
+	"\x04word\x18\x01 \x01(\tR\x04word\";\n" +
+	"\x1fSearchDictionaryMessageResponse\x12\x18\n" +
+	"\amessage\x18\x01 \x01(\tR\amessageB@Z>github.com/siddheshRajendraNimbalkar/collage-prject-backend/pbb\x06proto3"
+
+var (
+	file_search_dictionary_proto_rawDescOnce sync.Once
+	file_search_dictionary_proto_rawDescData []byte
+)
+
+func file_search_dictionary_proto_rawDescGZIP() []byte {
+	file_search_dictionary_proto_rawDescOnce.Do(func() {
+		file_search_dictionary_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_search_dictionary_proto_rawDesc), len(file_search_dictionary_proto_rawDesc)))
+	})
+	return file_search_dictionary_proto_rawDescData
+}
+
+var file_search_dictionary_proto_msgTypes = make([]protoimpl.MessageInfo, 8)
+var file_search_dictionary_proto_goTypes = []any{
+	(*SynonymGroup)(nil),                    // 0: pb.SynonymGroup
+	(*GetSearchDictionaryRequest)(nil),      // 1: pb.GetSearchDictionaryRequest
+	(*SearchDictionaryResponse)(nil),        // 2: pb.SearchDictionaryResponse
+	(*CreateSynonymGroupRequest)(nil),       // 3: pb.CreateSynonymGroupRequest
+	(*SynonymGroupResponse)(nil),            // 4: pb.SynonymGroupResponse
+	(*DeleteSynonymGroupRequest)(nil),       // 5: pb.DeleteSynonymGroupRequest
+	(*StopWordRequest)(nil),                 // 6: pb.StopWordRequest
+	(*SearchDictionaryMessageResponse)(nil), // 7: pb.SearchDictionaryMessageResponse
+}
+var file_search_dictionary_proto_depIdxs = []int32{
+	0, // 0: pb.SearchDictionaryResponse.synonym_groups:type_name -> pb.SynonymGroup
+	0, // 1: pb.SynonymGroupResponse.synonym_group:type_name -> pb.SynonymGroup
+	2, // [2:2] is the sub-list for method output_type
+	2, // [2:2] is the sub-list for method input_type
+	2, // [2:2] is the sub-list for extension type_name
+	2, // [2:2] is the sub-list for extension extendee
+	0, // [0:2] is the sub-list for field type_name
+}
+
+func init() { file_search_dictionary_proto_init() }
+func file_search_dictionary_proto_init() {
+	if File_search_dictionary_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_search_dictionary_proto_rawDesc), len(file_search_dictionary_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   8,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_search_dictionary_proto_goTypes,
+		DependencyIndexes: file_search_dictionary_proto_depIdxs,
+		MessageInfos:      file_search_dictionary_proto_msgTypes,
+	}.Build()
+	File_search_dictionary_proto = out.File
+	file_search_dictionary_proto_goTypes = nil
+	file_search_dictionary_proto_depIdxs = nil
+}
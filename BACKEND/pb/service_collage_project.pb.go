@@ -27,7 +27,7 @@ const file_service_collage_project_proto_rawDesc = "" +
 	"\n" +
 	"\x1dservice_collage_project.proto\x12\x02pb\x1a\n" +
 	"user.proto\x1a\rproduct.proto\x1a\vorder.proto\x1a\n" +
-	"cart.proto\x1a\x1cgoogle/api/annotations.proto2\xa9\x16\n" +
+	"cart.proto\x1a\x0ecategory.proto\x1a\freview.proto\x1a\x0ewishlist.proto\x1a\x12notification.proto\x1a\x14product_import.proto\x1a\vstock.proto\x1a\vprice.proto\x1a\fcoupon.proto\x1a\rdigital.proto\x1a\x0equestion.proto\x1a\x10moderation.proto\x1a\x17search_dictionary.proto\x1a\x16search_analytics.proto\x1a\x1cgoogle/api/annotations.proto2\xbd?\n" +
 	"\x0eCollageProject\x12M\n" +
 	"\n" +
 	"SignUpUser\x12\x11.pb.SignUpRequest\x1a\x10.pb.AuthResponse\"\x1a\x82\xd3\xe4\x93\x02\x14:\x01*\"\x0f/v1/api/sign-in\x12I\n" +
@@ -45,13 +45,65 @@ const file_service_collage_project_proto_rawDesc = "" +
 	"\x12GetProductByUserID\x12\x1d.pb.ListAllProductsByCreateBy\x1a!.pb.ListAllProductsByNameResponse\" \x82\xd3\xe4\x93\x02\x1a:\x01*\"\x15/v1/api/productByUser\x12d\n" +
 	"\fListProducts\x12\x1a.pb.ListAllProductsRequest\x1a\x18.pb.ListProductsResponse\"\x1e\x82\xd3\xe4\x93\x02\x18:\x01*\"\x13/v1/api/listProduct\x12`\n" +
 	"\rUpdateProduct\x12\x18.pb.UpdateProductRequest\x1a\x13.pb.ProductResponse\" \x82\xd3\xe4\x93\x02\x1a:\x01*\"\x15/v1/api/updateProduct\x12f\n" +
-	"\rDeleteProduct\x12\x18.pb.DeleteProductRequest\x1a\x19.pb.DeleteProductResponse\" \x82\xd3\xe4\x93\x02\x1a:\x01*\"\x15/v1/api/deleteProduct\x12|\n" +
+	"\rDeleteProduct\x12\x18.pb.DeleteProductRequest\x1a\x19.pb.DeleteProductResponse\" \x82\xd3\xe4\x93\x02\x1a:\x01*\"\x15/v1/api/deleteProduct\x12c\n" +
+	"\x0ePublishProduct\x12\x19.pb.PublishProductRequest\x1a\x13.pb.ProductResponse\"!\x82\xd3\xe4\x93\x02\x1b:\x01*\"\x16/v1/api/publishProduct\x12c\n" +
+	"\x0eArchiveProduct\x12\x19.pb.ArchiveProductRequest\x1a\x13.pb.ProductResponse\"!\x82\xd3\xe4\x93\x02\x1b:\x01*\"\x16/v1/api/archiveProduct\x12c\n" +
+	"\x0eRestoreProduct\x12\x19.pb.RestoreProductRequest\x1a\x13.pb.ProductResponse\"!\x82\xd3\xe4\x93\x02\x1b:\x01*\"\x16/v1/api/restoreProduct\x12I\n" +
+	"\x0eImportProducts\x12\x19.pb.ImportProductsRequest\x1a\x1a.pb.ImportProductsResponse(\x01\x12I\n" +
+	"\x0eExportProducts\x12\x19.pb.ExportProductsRequest\x1a\x1a.pb.ExportProductsResponse0\x01\x12|\n" +
 	"\x12ListProductsByName\x12 .pb.ListAllProductsByNameRequest\x1a!.pb.ListAllProductsByNameResponse\"!\x82\xd3\xe4\x93\x02\x1b:\x01*\"\x16/v1/api/getProductName\x12\x8c\x01\n" +
 	"\x16ListProductsByCategory\x12$.pb.ListAllProductsByCategoryRequest\x1a%.pb.ListAllProductsByCategoryResponse\"%\x82\xd3\xe4\x93\x02\x1f:\x01*\"\x1a/v1/api/getProductCategory\x12\x80\x01\n" +
 	"\x12ListProductsByType\x12 .pb.ListAllProductsByTypeRequest\x1a%.pb.ListAllProductsByCategoryResponse\"!\x82\xd3\xe4\x93\x02\x1b:\x01*\"\x16/v1/api/getProductType\x12^\n" +
 	"\x0eSearchProducts\x12\x19.pb.SearchProductsRequest\x1a\x1a.pb.SearchProductsResponse\"\x15\x82\xd3\xe4\x93\x02\x0f:\x01*\"\n" +
 	"/v1/search\x12a\n" +
-	"\x12AutocompleteSearch\x12\x17.pb.AutocompleteRequest\x1a\x18.pb.AutocompleteResponse\"\x18\x82\xd3\xe4\x93\x02\x12\x12\x10/v1/autocomplete\x12X\n" +
+	"\x12AutocompleteSearch\x12\x17.pb.AutocompleteRequest\x1a\x18.pb.AutocompleteResponse\"\x18\x82\xd3\xe4\x93\x02\x12\x12\x10/v1/autocomplete\x12f\n" +
+	"\rQueryProducts\x12\x18.pb.QueryProductsRequest\x1a\x19.pb.QueryProductsResponse\" \x82\xd3\xe4\x93\x02\x1a:\x01*\"\x15/v1/api/queryProducts\x12d\n" +
+	"\x0eCreateCategory\x12\x19.pb.CreateCategoryRequest\x1a\x14.pb.CategoryResponse\"!\x82\xd3\xe4\x93\x02\x1b:\x01*\"\x16/v1/api/createCategory\x12d\n" +
+	"\x0eUpdateCategory\x12\x19.pb.UpdateCategoryRequest\x1a\x14.pb.CategoryResponse\"!\x82\xd3\xe4\x93\x02\x1b:\x01*\"\x16/v1/api/updateCategory\x12j\n" +
+	"\x0eDeleteCategory\x12\x19.pb.DeleteCategoryRequest\x1a\x1a.pb.DeleteCategoryResponse\"!\x82\xd3\xe4\x93\x02\x1b:\x01*\"\x16/v1/api/deleteCategory\x12h\n" +
+	"\x0fGetCategoryTree\x12\x1a.pb.GetCategoryTreeRequest\x1a\x1b.pb.GetCategoryTreeResponse\"\x1c\x82\xd3\xe4\x93\x02\x16\x12\x14/v1/api/categoryTree\x12\\\n" +
+	"\fCreateReview\x12\x17.pb.CreateReviewRequest\x1a\x12.pb.ReviewResponse\"\x1f\x82\xd3\xe4\x93\x02\x19:\x01*\"\x14/v1/api/createReview\x12v\n" +
+	"\x12ListProductReviews\x12\x1d.pb.ListProductReviewsRequest\x1a\x1e.pb.ListProductReviewsResponse\"!\x82\xd3\xe4\x93\x02\x1b:\x01*\"\x16/v1/api/productReviews\x12g\n" +
+	"\x11MarkReviewHelpful\x12\x1c.pb.MarkReviewHelpfulRequest\x1a\x12.pb.ReviewResponse\" \x82\xd3\xe4\x93\x02\x1a:\x01*\"\x15/v1/api/reviewHelpful\x12]\n" +
+	"\rReplyToReview\x12\x18.pb.ReplyToReviewRequest\x1a\x12.pb.ReviewResponse\"\x1e\x82\xd3\xe4\x93\x02\x18:\x01*\"\x13/v1/api/replyReview\x12^\n" +
+	"\vAdjustStock\x12\x16.pb.AdjustStockRequest\x1a\x17.pb.AdjustStockResponse\"\x1e\x82\xd3\xe4\x93\x02\x18:\x01*\"\x13/v1/api/adjustStock\x12v\n" +
+	"\x12ListStockMovements\x12\x1d.pb.ListStockMovementsRequest\x1a\x1e.pb.ListStockMovementsResponse\"!\x82\xd3\xe4\x93\x02\x1b:\x01*\"\x16/v1/api/stockMovements\x12u\n" +
+	"\x14SetLowStockThreshold\x12\x1f.pb.SetLowStockThresholdRequest\x1a\x13.pb.ProductResponse\"'\x82\xd3\xe4\x93\x02!:\x01*\"\x1c/v1/api/setLowStockThreshold\x12~\n" +
+	"\x14ListLowStockProducts\x12\x1f.pb.ListLowStockProductsRequest\x1a .pb.ListLowStockProductsResponse\"#\x82\xd3\xe4\x93\x02\x1d:\x01*\"\x18/v1/api/lowStockProducts\x12b\n" +
+	"\fScheduleSale\x12\x17.pb.ScheduleSaleRequest\x1a\x18.pb.ScheduleSaleResponse\"\x1f\x82\xd3\xe4\x93\x02\x19:\x01*\"\x14/v1/api/scheduleSale\x12n\n" +
+	"\x10ListPriceHistory\x12\x1b.pb.ListPriceHistoryRequest\x1a\x1c.pb.ListPriceHistoryResponse\"\x1f\x82\xd3\xe4\x93\x02\x19:\x01*\"\x14/v1/api/priceHistory\x12\\\n" +
+	"\fCreateCoupon\x12\x17.pb.CreateCouponRequest\x1a\x12.pb.CouponResponse\"\x1f\x82\xd3\xe4\x93\x02\x19:\x01*\"\x14/v1/api/createCoupon\x12j\n" +
+	"\x0eValidateCoupon\x12\x19.pb.ValidateCouponRequest\x1a\x1a.pb.ValidateCouponResponse\"!\x82\xd3\xe4\x93\x02\x1b:\x01*\"\x16/v1/api/validateCoupon\x12k\n" +
+	"\x0fGetDownloadLink\x12\x1a.pb.GetDownloadLinkRequest\x1a\x1b.pb.GetDownloadLinkResponse\"\x1f\x82\xd3\xe4\x93\x02\x19:\x01*\"\x14/v1/api/downloadLink\x12[\n" +
+	"\vAskQuestion\x12\x16.pb.AskQuestionRequest\x1a\x14.pb.QuestionResponse\"\x1e\x82\xd3\xe4\x93\x02\x18:\x01*\"\x13/v1/api/askQuestion\x12b\n" +
+	"\x0eAnswerQuestion\x12\x19.pb.AnswerQuestionRequest\x1a\x12.pb.AnswerResponse\"!\x82\xd3\xe4\x93\x02\x1b:\x01*\"\x16/v1/api/answerQuestion\x12~\n" +
+	"\x14ListProductQuestions\x12\x1f.pb.ListProductQuestionsRequest\x1a .pb.ListProductQuestionsResponse\"#\x82\xd3\xe4\x93\x02\x1d:\x01*\"\x18/v1/api/productQuestions\x12d\n" +
+	"\x0eUpvoteQuestion\x12\x19.pb.UpvoteQuestionRequest\x1a\x14.pb.QuestionResponse\"!\x82\xd3\xe4\x93\x02\x1b:\x01*\"\x16/v1/api/upvoteQuestion\x12\\\n" +
+	"\fUpvoteAnswer\x12\x17.pb.UpvoteAnswerRequest\x1a\x12.pb.AnswerResponse\"\x1f\x82\xd3\xe4\x93\x02\x19:\x01*\"\x14/v1/api/upvoteAnswer\x12Z\n" +
+	"\fFlagQuestion\x12\x17.pb.FlagQuestionRequest\x1a\x10.pb.FlagResponse\"\x1f\x82\xd3\xe4\x93\x02\x19:\x01*\"\x14/v1/api/flagQuestion\x12T\n" +
+	"\n" +
+	"FlagAnswer\x12\x15.pb.FlagAnswerRequest\x1a\x10.pb.FlagResponse\"\x1d\x82\xd3\xe4\x93\x02\x17:\x01*\"\x12/v1/api/flagAnswer\x12j\n" +
+	"\x10ModerateQuestion\x12\x1b.pb.ModerateQuestionRequest\x1a\x14.pb.QuestionResponse\"#\x82\xd3\xe4\x93\x02\x1d:\x01*\"\x18/v1/api/moderateQuestion\x12b\n" +
+	"\x0eModerateAnswer\x12\x19.pb.ModerateAnswerRequest\x1a\x12.pb.AnswerResponse\"!\x82\xd3\xe4\x93\x02\x1b:\x01*\"\x16/v1/api/moderateAnswer\x12z\n" +
+	"\x13ListPendingProducts\x12\x1e.pb.ListPendingProductsRequest\x1a\x1f.pb.ListPendingProductsResponse\"\"\x82\xd3\xe4\x93\x02\x1c:\x01*\"\x17/v1/api/pendingProducts\x12c\n" +
+	"\x0eApproveProduct\x12\x19.pb.ApproveProductRequest\x1a\x13.pb.ProductResponse\"!\x82\xd3\xe4\x93\x02\x1b:\x01*\"\x16/v1/api/approveProduct\x12`\n" +
+	"\rRejectProduct\x12\x18.pb.RejectProductRequest\x1a\x13.pb.ProductResponse\" \x82\xd3\xe4\x93\x02\x1a:\x01*\"\x15/v1/api/rejectProduct\x12r\n" +
+	"\x11ListModerationLog\x12\x1c.pb.ListModerationLogRequest\x1a\x1d.pb.ListModerationLogResponse\" \x82\xd3\xe4\x93\x02\x1a:\x01*\"\x15/v1/api/moderationLog\x12x\n" +
+	"\x13GetSearchDictionary\x12\x1e.pb.GetSearchDictionaryRequest\x1a\x1c.pb.SearchDictionaryResponse\"#\x82\xd3\xe4\x93\x02\x1d:\x01*\"\x18/v1/api/searchDictionary\x12t\n" +
+	"\x12CreateSynonymGroup\x12\x1d.pb.CreateSynonymGroupRequest\x1a\x18.pb.SynonymGroupResponse\"%\x82\xd3\xe4\x93\x02\x1f:\x01*\"\x1a/v1/api/createSynonymGroup\x12\x7f\n" +
+	"\x12DeleteSynonymGroup\x12\x1d.pb.DeleteSynonymGroupRequest\x1a#.pb.SearchDictionaryMessageResponse\"%\x82\xd3\xe4\x93\x02\x1f:\x01*\"\x1a/v1/api/deleteSynonymGroup\x12g\n" +
+	"\vAddStopWord\x12\x13.pb.StopWordRequest\x1a#.pb.SearchDictionaryMessageResponse\"\x1e\x82\xd3\xe4\x93\x02\x18:\x01*\"\x13/v1/api/addStopWord\x12m\n" +
+	"\x0eRemoveStopWord\x12\x13.pb.StopWordRequest\x1a#.pb.SearchDictionaryMessageResponse\"!\x82\xd3\xe4\x93\x02\x1b:\x01*\"\x16/v1/api/removeStopWord\x12p\n" +
+	"\x11RecordSearchClick\x12\x1c.pb.RecordSearchClickRequest\x1a\x1d.pb.RecordSearchClickResponse\"\x1e\x82\xd3\xe4\x93\x02\x18:\x01*\"\x13/v1/api/searchClick\x12_\n" +
+	"\n" +
+	"TopQueries\x12\x1a.pb.SearchAnalyticsRequest\x1a\x16.pb.TopQueriesResponse\"\x1d\x82\xd3\xe4\x93\x02\x17:\x01*\"\x12/v1/api/topQueries\x12t\n" +
+	"\x11ZeroResultQueries\x12\x1a.pb.SearchAnalyticsRequest\x1a\x1d.pb.ZeroResultQueriesResponse\"$\x82\xd3\xe4\x93\x02\x1e:\x01*\"\x19/v1/api/zeroResultQueries\x12\\\n" +
+	"\tSearchCTR\x12\x1a.pb.SearchAnalyticsRequest\x1a\x15.pb.SearchCTRResponse\"\x1c\x82\xd3\xe4\x93\x02\x16:\x01*\"\x11/v1/api/searchCTR\x12_\n" +
+	"\rAddToWishlist\x12\x18.pb.AddToWishlistRequest\x1a\x14.pb.WishlistResponse\"\x1e\x82\xd3\xe4\x93\x02\x18:\x01*\"\x13/v1/api/addWishlist\x12l\n" +
+	"\x12RemoveFromWishlist\x12\x1d.pb.RemoveFromWishlistRequest\x1a\x14.pb.WishlistResponse\"!\x82\xd3\xe4\x93\x02\x1b:\x01*\"\x16/v1/api/removeWishlist\x12b\n" +
+	"\fListWishlist\x12\x17.pb.ListWishlistRequest\x1a\x18.pb.ListWishlistResponse\"\x1f\x82\xd3\xe4\x93\x02\x19:\x01*\"\x14/v1/api/userWishlist\x12r\n" +
+	"\x11ListNotifications\x12\x1c.pb.ListNotificationsRequest\x1a\x1d.pb.ListNotificationsResponse\" \x82\xd3\xe4\x93\x02\x1a:\x01*\"\x15/v1/api/notifications\x12v\n" +
+	"\x14MarkNotificationRead\x12\x1f.pb.MarkNotificationReadRequest\x1a\x18.pb.NotificationResponse\"#\x82\xd3\xe4\x93\x02\x1d:\x01*\"\x18/v1/api/readNotification\x12X\n" +
 	"\vCreateOrder\x12\x16.pb.CreateOrderRequest\x1a\x11.pb.OrderResponse\"\x1e\x82\xd3\xe4\x93\x02\x18:\x01*\"\x13/v1/api/createOrder\x12R\n" +
 	"\fGetOrderByID\x12\x13.pb.GetOrderRequest\x1a\x11.pb.OrderResponse\"\x1a\x82\xd3\xe4\x93\x02\x14:\x01*\"\x0f/v1/api/orderId\x12_\n" +
 	"\n" +
@@ -78,102 +130,282 @@ var file_service_collage_project_proto_goTypes = []any{
 	(*ListAllProductsRequest)(nil),            // 10: pb.ListAllProductsRequest
 	(*UpdateProductRequest)(nil),              // 11: pb.UpdateProductRequest
 	(*DeleteProductRequest)(nil),              // 12: pb.DeleteProductRequest
-	(*ListAllProductsByNameRequest)(nil),      // 13: pb.ListAllProductsByNameRequest
-	(*ListAllProductsByCategoryRequest)(nil),  // 14: pb.ListAllProductsByCategoryRequest
-	(*ListAllProductsByTypeRequest)(nil),      // 15: pb.ListAllProductsByTypeRequest
-	(*SearchProductsRequest)(nil),             // 16: pb.SearchProductsRequest
-	(*AutocompleteRequest)(nil),               // 17: pb.AutocompleteRequest
-	(*CreateOrderRequest)(nil),                // 18: pb.CreateOrderRequest
-	(*GetOrderRequest)(nil),                   // 19: pb.GetOrderRequest
-	(*ListOrdersByUserRequest)(nil),           // 20: pb.ListOrdersByUserRequest
-	(*UpdateOrderStatusRequest)(nil),          // 21: pb.UpdateOrderStatusRequest
-	(*DeleteOrderRequest)(nil),                // 22: pb.DeleteOrderRequest
-	(*AddToCartRequest)(nil),                  // 23: pb.AddToCartRequest
-	(*GetCartRequest)(nil),                    // 24: pb.GetCartRequest
-	(*UpdateCartQuantityRequest)(nil),         // 25: pb.UpdateCartQuantityRequest
-	(*RemoveFromCartRequest)(nil),             // 26: pb.RemoveFromCartRequest
-	(*ClearCartRequest)(nil),                  // 27: pb.ClearCartRequest
-	(*AuthResponse)(nil),                      // 28: pb.AuthResponse
-	(*UserResponse)(nil),                      // 29: pb.UserResponse
-	(*DeleteUserResponse)(nil),                // 30: pb.DeleteUserResponse
-	(*RefreshTokenResponse)(nil),              // 31: pb.RefreshTokenResponse
-	(*ProductResponse)(nil),                   // 32: pb.ProductResponse
-	(*ListAllProductsByNameResponse)(nil),     // 33: pb.ListAllProductsByNameResponse
-	(*ListProductsResponse)(nil),              // 34: pb.ListProductsResponse
-	(*DeleteProductResponse)(nil),             // 35: pb.DeleteProductResponse
-	(*ListAllProductsByCategoryResponse)(nil), // 36: pb.ListAllProductsByCategoryResponse
-	(*SearchProductsResponse)(nil),            // 37: pb.SearchProductsResponse
-	(*AutocompleteResponse)(nil),              // 38: pb.AutocompleteResponse
-	(*OrderResponse)(nil),                     // 39: pb.OrderResponse
-	(*ListOrdersResponse)(nil),                // 40: pb.ListOrdersResponse
-	(*DeleteOrderResponse)(nil),               // 41: pb.DeleteOrderResponse
-	(*CartResponse)(nil),                      // 42: pb.CartResponse
-	(*CartListResponse)(nil),                  // 43: pb.CartListResponse
+	(*PublishProductRequest)(nil),             // 13: pb.PublishProductRequest
+	(*ArchiveProductRequest)(nil),             // 14: pb.ArchiveProductRequest
+	(*RestoreProductRequest)(nil),             // 15: pb.RestoreProductRequest
+	(*ImportProductsRequest)(nil),             // 16: pb.ImportProductsRequest
+	(*ExportProductsRequest)(nil),             // 17: pb.ExportProductsRequest
+	(*ListAllProductsByNameRequest)(nil),      // 18: pb.ListAllProductsByNameRequest
+	(*ListAllProductsByCategoryRequest)(nil),  // 19: pb.ListAllProductsByCategoryRequest
+	(*ListAllProductsByTypeRequest)(nil),      // 20: pb.ListAllProductsByTypeRequest
+	(*SearchProductsRequest)(nil),             // 21: pb.SearchProductsRequest
+	(*AutocompleteRequest)(nil),               // 22: pb.AutocompleteRequest
+	(*QueryProductsRequest)(nil),              // 23: pb.QueryProductsRequest
+	(*CreateCategoryRequest)(nil),             // 24: pb.CreateCategoryRequest
+	(*UpdateCategoryRequest)(nil),             // 25: pb.UpdateCategoryRequest
+	(*DeleteCategoryRequest)(nil),             // 26: pb.DeleteCategoryRequest
+	(*GetCategoryTreeRequest)(nil),            // 27: pb.GetCategoryTreeRequest
+	(*CreateReviewRequest)(nil),               // 28: pb.CreateReviewRequest
+	(*ListProductReviewsRequest)(nil),         // 29: pb.ListProductReviewsRequest
+	(*MarkReviewHelpfulRequest)(nil),          // 30: pb.MarkReviewHelpfulRequest
+	(*ReplyToReviewRequest)(nil),              // 31: pb.ReplyToReviewRequest
+	(*AdjustStockRequest)(nil),                // 32: pb.AdjustStockRequest
+	(*ListStockMovementsRequest)(nil),         // 33: pb.ListStockMovementsRequest
+	(*SetLowStockThresholdRequest)(nil),       // 34: pb.SetLowStockThresholdRequest
+	(*ListLowStockProductsRequest)(nil),       // 35: pb.ListLowStockProductsRequest
+	(*ScheduleSaleRequest)(nil),               // 36: pb.ScheduleSaleRequest
+	(*ListPriceHistoryRequest)(nil),           // 37: pb.ListPriceHistoryRequest
+	(*CreateCouponRequest)(nil),               // 38: pb.CreateCouponRequest
+	(*ValidateCouponRequest)(nil),             // 39: pb.ValidateCouponRequest
+	(*GetDownloadLinkRequest)(nil),            // 40: pb.GetDownloadLinkRequest
+	(*AskQuestionRequest)(nil),                // 41: pb.AskQuestionRequest
+	(*AnswerQuestionRequest)(nil),             // 42: pb.AnswerQuestionRequest
+	(*ListProductQuestionsRequest)(nil),       // 43: pb.ListProductQuestionsRequest
+	(*UpvoteQuestionRequest)(nil),             // 44: pb.UpvoteQuestionRequest
+	(*UpvoteAnswerRequest)(nil),               // 45: pb.UpvoteAnswerRequest
+	(*FlagQuestionRequest)(nil),               // 46: pb.FlagQuestionRequest
+	(*FlagAnswerRequest)(nil),                 // 47: pb.FlagAnswerRequest
+	(*ModerateQuestionRequest)(nil),           // 48: pb.ModerateQuestionRequest
+	(*ModerateAnswerRequest)(nil),             // 49: pb.ModerateAnswerRequest
+	(*ListPendingProductsRequest)(nil),        // 50: pb.ListPendingProductsRequest
+	(*ApproveProductRequest)(nil),             // 51: pb.ApproveProductRequest
+	(*RejectProductRequest)(nil),              // 52: pb.RejectProductRequest
+	(*ListModerationLogRequest)(nil),          // 53: pb.ListModerationLogRequest
+	(*GetSearchDictionaryRequest)(nil),        // 54: pb.GetSearchDictionaryRequest
+	(*CreateSynonymGroupRequest)(nil),         // 55: pb.CreateSynonymGroupRequest
+	(*DeleteSynonymGroupRequest)(nil),         // 56: pb.DeleteSynonymGroupRequest
+	(*StopWordRequest)(nil),                   // 57: pb.StopWordRequest
+	(*RecordSearchClickRequest)(nil),          // 58: pb.RecordSearchClickRequest
+	(*SearchAnalyticsRequest)(nil),            // 59: pb.SearchAnalyticsRequest
+	(*AddToWishlistRequest)(nil),              // 60: pb.AddToWishlistRequest
+	(*RemoveFromWishlistRequest)(nil),         // 61: pb.RemoveFromWishlistRequest
+	(*ListWishlistRequest)(nil),               // 62: pb.ListWishlistRequest
+	(*ListNotificationsRequest)(nil),          // 63: pb.ListNotificationsRequest
+	(*MarkNotificationReadRequest)(nil),       // 64: pb.MarkNotificationReadRequest
+	(*CreateOrderRequest)(nil),                // 65: pb.CreateOrderRequest
+	(*GetOrderRequest)(nil),                   // 66: pb.GetOrderRequest
+	(*ListOrdersByUserRequest)(nil),           // 67: pb.ListOrdersByUserRequest
+	(*UpdateOrderStatusRequest)(nil),          // 68: pb.UpdateOrderStatusRequest
+	(*DeleteOrderRequest)(nil),                // 69: pb.DeleteOrderRequest
+	(*AddToCartRequest)(nil),                  // 70: pb.AddToCartRequest
+	(*GetCartRequest)(nil),                    // 71: pb.GetCartRequest
+	(*UpdateCartQuantityRequest)(nil),         // 72: pb.UpdateCartQuantityRequest
+	(*RemoveFromCartRequest)(nil),             // 73: pb.RemoveFromCartRequest
+	(*ClearCartRequest)(nil),                  // 74: pb.ClearCartRequest
+	(*AuthResponse)(nil),                      // 75: pb.AuthResponse
+	(*UserResponse)(nil),                      // 76: pb.UserResponse
+	(*DeleteUserResponse)(nil),                // 77: pb.DeleteUserResponse
+	(*RefreshTokenResponse)(nil),              // 78: pb.RefreshTokenResponse
+	(*ProductResponse)(nil),                   // 79: pb.ProductResponse
+	(*ListAllProductsByNameResponse)(nil),     // 80: pb.ListAllProductsByNameResponse
+	(*ListProductsResponse)(nil),              // 81: pb.ListProductsResponse
+	(*DeleteProductResponse)(nil),             // 82: pb.DeleteProductResponse
+	(*ImportProductsResponse)(nil),            // 83: pb.ImportProductsResponse
+	(*ExportProductsResponse)(nil),            // 84: pb.ExportProductsResponse
+	(*ListAllProductsByCategoryResponse)(nil), // 85: pb.ListAllProductsByCategoryResponse
+	(*SearchProductsResponse)(nil),            // 86: pb.SearchProductsResponse
+	(*AutocompleteResponse)(nil),              // 87: pb.AutocompleteResponse
+	(*QueryProductsResponse)(nil),             // 88: pb.QueryProductsResponse
+	(*CategoryResponse)(nil),                  // 89: pb.CategoryResponse
+	(*DeleteCategoryResponse)(nil),            // 90: pb.DeleteCategoryResponse
+	(*GetCategoryTreeResponse)(nil),           // 91: pb.GetCategoryTreeResponse
+	(*ReviewResponse)(nil),                    // 92: pb.ReviewResponse
+	(*ListProductReviewsResponse)(nil),        // 93: pb.ListProductReviewsResponse
+	(*AdjustStockResponse)(nil),               // 94: pb.AdjustStockResponse
+	(*ListStockMovementsResponse)(nil),        // 95: pb.ListStockMovementsResponse
+	(*ListLowStockProductsResponse)(nil),      // 96: pb.ListLowStockProductsResponse
+	(*ScheduleSaleResponse)(nil),              // 97: pb.ScheduleSaleResponse
+	(*ListPriceHistoryResponse)(nil),          // 98: pb.ListPriceHistoryResponse
+	(*CouponResponse)(nil),                    // 99: pb.CouponResponse
+	(*ValidateCouponResponse)(nil),            // 100: pb.ValidateCouponResponse
+	(*GetDownloadLinkResponse)(nil),           // 101: pb.GetDownloadLinkResponse
+	(*QuestionResponse)(nil),                  // 102: pb.QuestionResponse
+	(*AnswerResponse)(nil),                    // 103: pb.AnswerResponse
+	(*ListProductQuestionsResponse)(nil),      // 104: pb.ListProductQuestionsResponse
+	(*FlagResponse)(nil),                      // 105: pb.FlagResponse
+	(*ListPendingProductsResponse)(nil),       // 106: pb.ListPendingProductsResponse
+	(*ListModerationLogResponse)(nil),         // 107: pb.ListModerationLogResponse
+	(*SearchDictionaryResponse)(nil),          // 108: pb.SearchDictionaryResponse
+	(*SynonymGroupResponse)(nil),              // 109: pb.SynonymGroupResponse
+	(*SearchDictionaryMessageResponse)(nil),   // 110: pb.SearchDictionaryMessageResponse
+	(*RecordSearchClickResponse)(nil),         // 111: pb.RecordSearchClickResponse
+	(*TopQueriesResponse)(nil),                // 112: pb.TopQueriesResponse
+	(*ZeroResultQueriesResponse)(nil),         // 113: pb.ZeroResultQueriesResponse
+	(*SearchCTRResponse)(nil),                 // 114: pb.SearchCTRResponse
+	(*WishlistResponse)(nil),                  // 115: pb.WishlistResponse
+	(*ListWishlistResponse)(nil),              // 116: pb.ListWishlistResponse
+	(*ListNotificationsResponse)(nil),         // 117: pb.ListNotificationsResponse
+	(*NotificationResponse)(nil),              // 118: pb.NotificationResponse
+	(*OrderResponse)(nil),                     // 119: pb.OrderResponse
+	(*ListOrdersResponse)(nil),                // 120: pb.ListOrdersResponse
+	(*DeleteOrderResponse)(nil),               // 121: pb.DeleteOrderResponse
+	(*CartResponse)(nil),                      // 122: pb.CartResponse
+	(*CartListResponse)(nil),                  // 123: pb.CartListResponse
 }
 var file_service_collage_project_proto_depIdxs = []int32{
-	0,  // 0: pb.CollageProject.SignUpUser:input_type -> pb.SignUpRequest
-	1,  // 1: pb.CollageProject.LoginUser:input_type -> pb.LoginRequest
-	2,  // 2: pb.CollageProject.GetUserByID:input_type -> pb.GetUserRequest
-	3,  // 3: pb.CollageProject.GetUserByEmail:input_type -> pb.GetUserByEmailRequest
-	4,  // 4: pb.CollageProject.UpdateUser:input_type -> pb.UpdateUserRequest
-	5,  // 5: pb.CollageProject.DeleteUser:input_type -> pb.DeleteUserRequest
-	6,  // 6: pb.CollageProject.RefreshToken:input_type -> pb.RefreshTokenRequest
-	7,  // 7: pb.CollageProject.CreateProduct:input_type -> pb.CreateProductRequest
-	8,  // 8: pb.CollageProject.GetProductByID:input_type -> pb.GetProductRequest
-	8,  // 9: pb.CollageProject.GetOnlyProductRequest:input_type -> pb.GetProductRequest
-	9,  // 10: pb.CollageProject.GetProductByUserID:input_type -> pb.ListAllProductsByCreateBy
-	10, // 11: pb.CollageProject.ListProducts:input_type -> pb.ListAllProductsRequest
-	11, // 12: pb.CollageProject.UpdateProduct:input_type -> pb.UpdateProductRequest
-	12, // 13: pb.CollageProject.DeleteProduct:input_type -> pb.DeleteProductRequest
-	13, // 14: pb.CollageProject.ListProductsByName:input_type -> pb.ListAllProductsByNameRequest
-	14, // 15: pb.CollageProject.ListProductsByCategory:input_type -> pb.ListAllProductsByCategoryRequest
-	15, // 16: pb.CollageProject.ListProductsByType:input_type -> pb.ListAllProductsByTypeRequest
-	16, // 17: pb.CollageProject.SearchProducts:input_type -> pb.SearchProductsRequest
-	17, // 18: pb.CollageProject.AutocompleteSearch:input_type -> pb.AutocompleteRequest
-	18, // 19: pb.CollageProject.CreateOrder:input_type -> pb.CreateOrderRequest
-	19, // 20: pb.CollageProject.GetOrderByID:input_type -> pb.GetOrderRequest
-	20, // 21: pb.CollageProject.ListOrders:input_type -> pb.ListOrdersByUserRequest
-	21, // 22: pb.CollageProject.UpdateOrderStatus:input_type -> pb.UpdateOrderStatusRequest
-	22, // 23: pb.CollageProject.DeleteOrder:input_type -> pb.DeleteOrderRequest
-	23, // 24: pb.CollageProject.AddToCart:input_type -> pb.AddToCartRequest
-	24, // 25: pb.CollageProject.GetCartByUser:input_type -> pb.GetCartRequest
-	25, // 26: pb.CollageProject.UpdateCartQuantity:input_type -> pb.UpdateCartQuantityRequest
-	26, // 27: pb.CollageProject.RemoveFromCart:input_type -> pb.RemoveFromCartRequest
-	27, // 28: pb.CollageProject.ClearCart:input_type -> pb.ClearCartRequest
-	28, // 29: pb.CollageProject.SignUpUser:output_type -> pb.AuthResponse
-	28, // 30: pb.CollageProject.LoginUser:output_type -> pb.AuthResponse
-	29, // 31: pb.CollageProject.GetUserByID:output_type -> pb.UserResponse
-	29, // 32: pb.CollageProject.GetUserByEmail:output_type -> pb.UserResponse
-	29, // 33: pb.CollageProject.UpdateUser:output_type -> pb.UserResponse
-	30, // 34: pb.CollageProject.DeleteUser:output_type -> pb.DeleteUserResponse
-	31, // 35: pb.CollageProject.RefreshToken:output_type -> pb.RefreshTokenResponse
-	32, // 36: pb.CollageProject.CreateProduct:output_type -> pb.ProductResponse
-	32, // 37: pb.CollageProject.GetProductByID:output_type -> pb.ProductResponse
-	32, // 38: pb.CollageProject.GetOnlyProductRequest:output_type -> pb.ProductResponse
-	33, // 39: pb.CollageProject.GetProductByUserID:output_type -> pb.ListAllProductsByNameResponse
-	34, // 40: pb.CollageProject.ListProducts:output_type -> pb.ListProductsResponse
-	32, // 41: pb.CollageProject.UpdateProduct:output_type -> pb.ProductResponse
-	35, // 42: pb.CollageProject.DeleteProduct:output_type -> pb.DeleteProductResponse
-	33, // 43: pb.CollageProject.ListProductsByName:output_type -> pb.ListAllProductsByNameResponse
-	36, // 44: pb.CollageProject.ListProductsByCategory:output_type -> pb.ListAllProductsByCategoryResponse
-	36, // 45: pb.CollageProject.ListProductsByType:output_type -> pb.ListAllProductsByCategoryResponse
-	37, // 46: pb.CollageProject.SearchProducts:output_type -> pb.SearchProductsResponse
-	38, // 47: pb.CollageProject.AutocompleteSearch:output_type -> pb.AutocompleteResponse
-	39, // 48: pb.CollageProject.CreateOrder:output_type -> pb.OrderResponse
-	39, // 49: pb.CollageProject.GetOrderByID:output_type -> pb.OrderResponse
-	40, // 50: pb.CollageProject.ListOrders:output_type -> pb.ListOrdersResponse
-	39, // 51: pb.CollageProject.UpdateOrderStatus:output_type -> pb.OrderResponse
-	41, // 52: pb.CollageProject.DeleteOrder:output_type -> pb.DeleteOrderResponse
-	42, // 53: pb.CollageProject.AddToCart:output_type -> pb.CartResponse
-	43, // 54: pb.CollageProject.GetCartByUser:output_type -> pb.CartListResponse
-	42, // 55: pb.CollageProject.UpdateCartQuantity:output_type -> pb.CartResponse
-	42, // 56: pb.CollageProject.RemoveFromCart:output_type -> pb.CartResponse
-	42, // 57: pb.CollageProject.ClearCart:output_type -> pb.CartResponse
-	29, // [29:58] is the sub-list for method output_type
-	0,  // [0:29] is the sub-list for method input_type
-	0,  // [0:0] is the sub-list for extension type_name
-	0,  // [0:0] is the sub-list for extension extendee
-	0,  // [0:0] is the sub-list for field type_name
+	0,   // 0: pb.CollageProject.SignUpUser:input_type -> pb.SignUpRequest
+	1,   // 1: pb.CollageProject.LoginUser:input_type -> pb.LoginRequest
+	2,   // 2: pb.CollageProject.GetUserByID:input_type -> pb.GetUserRequest
+	3,   // 3: pb.CollageProject.GetUserByEmail:input_type -> pb.GetUserByEmailRequest
+	4,   // 4: pb.CollageProject.UpdateUser:input_type -> pb.UpdateUserRequest
+	5,   // 5: pb.CollageProject.DeleteUser:input_type -> pb.DeleteUserRequest
+	6,   // 6: pb.CollageProject.RefreshToken:input_type -> pb.RefreshTokenRequest
+	7,   // 7: pb.CollageProject.CreateProduct:input_type -> pb.CreateProductRequest
+	8,   // 8: pb.CollageProject.GetProductByID:input_type -> pb.GetProductRequest
+	8,   // 9: pb.CollageProject.GetOnlyProductRequest:input_type -> pb.GetProductRequest
+	9,   // 10: pb.CollageProject.GetProductByUserID:input_type -> pb.ListAllProductsByCreateBy
+	10,  // 11: pb.CollageProject.ListProducts:input_type -> pb.ListAllProductsRequest
+	11,  // 12: pb.CollageProject.UpdateProduct:input_type -> pb.UpdateProductRequest
+	12,  // 13: pb.CollageProject.DeleteProduct:input_type -> pb.DeleteProductRequest
+	13,  // 14: pb.CollageProject.PublishProduct:input_type -> pb.PublishProductRequest
+	14,  // 15: pb.CollageProject.ArchiveProduct:input_type -> pb.ArchiveProductRequest
+	15,  // 16: pb.CollageProject.RestoreProduct:input_type -> pb.RestoreProductRequest
+	16,  // 17: pb.CollageProject.ImportProducts:input_type -> pb.ImportProductsRequest
+	17,  // 18: pb.CollageProject.ExportProducts:input_type -> pb.ExportProductsRequest
+	18,  // 19: pb.CollageProject.ListProductsByName:input_type -> pb.ListAllProductsByNameRequest
+	19,  // 20: pb.CollageProject.ListProductsByCategory:input_type -> pb.ListAllProductsByCategoryRequest
+	20,  // 21: pb.CollageProject.ListProductsByType:input_type -> pb.ListAllProductsByTypeRequest
+	21,  // 22: pb.CollageProject.SearchProducts:input_type -> pb.SearchProductsRequest
+	22,  // 23: pb.CollageProject.AutocompleteSearch:input_type -> pb.AutocompleteRequest
+	23,  // 24: pb.CollageProject.QueryProducts:input_type -> pb.QueryProductsRequest
+	24,  // 25: pb.CollageProject.CreateCategory:input_type -> pb.CreateCategoryRequest
+	25,  // 26: pb.CollageProject.UpdateCategory:input_type -> pb.UpdateCategoryRequest
+	26,  // 27: pb.CollageProject.DeleteCategory:input_type -> pb.DeleteCategoryRequest
+	27,  // 28: pb.CollageProject.GetCategoryTree:input_type -> pb.GetCategoryTreeRequest
+	28,  // 29: pb.CollageProject.CreateReview:input_type -> pb.CreateReviewRequest
+	29,  // 30: pb.CollageProject.ListProductReviews:input_type -> pb.ListProductReviewsRequest
+	30,  // 31: pb.CollageProject.MarkReviewHelpful:input_type -> pb.MarkReviewHelpfulRequest
+	31,  // 32: pb.CollageProject.ReplyToReview:input_type -> pb.ReplyToReviewRequest
+	32,  // 33: pb.CollageProject.AdjustStock:input_type -> pb.AdjustStockRequest
+	33,  // 34: pb.CollageProject.ListStockMovements:input_type -> pb.ListStockMovementsRequest
+	34,  // 35: pb.CollageProject.SetLowStockThreshold:input_type -> pb.SetLowStockThresholdRequest
+	35,  // 36: pb.CollageProject.ListLowStockProducts:input_type -> pb.ListLowStockProductsRequest
+	36,  // 37: pb.CollageProject.ScheduleSale:input_type -> pb.ScheduleSaleRequest
+	37,  // 38: pb.CollageProject.ListPriceHistory:input_type -> pb.ListPriceHistoryRequest
+	38,  // 39: pb.CollageProject.CreateCoupon:input_type -> pb.CreateCouponRequest
+	39,  // 40: pb.CollageProject.ValidateCoupon:input_type -> pb.ValidateCouponRequest
+	40,  // 41: pb.CollageProject.GetDownloadLink:input_type -> pb.GetDownloadLinkRequest
+	41,  // 42: pb.CollageProject.AskQuestion:input_type -> pb.AskQuestionRequest
+	42,  // 43: pb.CollageProject.AnswerQuestion:input_type -> pb.AnswerQuestionRequest
+	43,  // 44: pb.CollageProject.ListProductQuestions:input_type -> pb.ListProductQuestionsRequest
+	44,  // 45: pb.CollageProject.UpvoteQuestion:input_type -> pb.UpvoteQuestionRequest
+	45,  // 46: pb.CollageProject.UpvoteAnswer:input_type -> pb.UpvoteAnswerRequest
+	46,  // 47: pb.CollageProject.FlagQuestion:input_type -> pb.FlagQuestionRequest
+	47,  // 48: pb.CollageProject.FlagAnswer:input_type -> pb.FlagAnswerRequest
+	48,  // 49: pb.CollageProject.ModerateQuestion:input_type -> pb.ModerateQuestionRequest
+	49,  // 50: pb.CollageProject.ModerateAnswer:input_type -> pb.ModerateAnswerRequest
+	50,  // 51: pb.CollageProject.ListPendingProducts:input_type -> pb.ListPendingProductsRequest
+	51,  // 52: pb.CollageProject.ApproveProduct:input_type -> pb.ApproveProductRequest
+	52,  // 53: pb.CollageProject.RejectProduct:input_type -> pb.RejectProductRequest
+	53,  // 54: pb.CollageProject.ListModerationLog:input_type -> pb.ListModerationLogRequest
+	54,  // 55: pb.CollageProject.GetSearchDictionary:input_type -> pb.GetSearchDictionaryRequest
+	55,  // 56: pb.CollageProject.CreateSynonymGroup:input_type -> pb.CreateSynonymGroupRequest
+	56,  // 57: pb.CollageProject.DeleteSynonymGroup:input_type -> pb.DeleteSynonymGroupRequest
+	57,  // 58: pb.CollageProject.AddStopWord:input_type -> pb.StopWordRequest
+	57,  // 59: pb.CollageProject.RemoveStopWord:input_type -> pb.StopWordRequest
+	58,  // 60: pb.CollageProject.RecordSearchClick:input_type -> pb.RecordSearchClickRequest
+	59,  // 61: pb.CollageProject.TopQueries:input_type -> pb.SearchAnalyticsRequest
+	59,  // 62: pb.CollageProject.ZeroResultQueries:input_type -> pb.SearchAnalyticsRequest
+	59,  // 63: pb.CollageProject.SearchCTR:input_type -> pb.SearchAnalyticsRequest
+	60,  // 64: pb.CollageProject.AddToWishlist:input_type -> pb.AddToWishlistRequest
+	61,  // 65: pb.CollageProject.RemoveFromWishlist:input_type -> pb.RemoveFromWishlistRequest
+	62,  // 66: pb.CollageProject.ListWishlist:input_type -> pb.ListWishlistRequest
+	63,  // 67: pb.CollageProject.ListNotifications:input_type -> pb.ListNotificationsRequest
+	64,  // 68: pb.CollageProject.MarkNotificationRead:input_type -> pb.MarkNotificationReadRequest
+	65,  // 69: pb.CollageProject.CreateOrder:input_type -> pb.CreateOrderRequest
+	66,  // 70: pb.CollageProject.GetOrderByID:input_type -> pb.GetOrderRequest
+	67,  // 71: pb.CollageProject.ListOrders:input_type -> pb.ListOrdersByUserRequest
+	68,  // 72: pb.CollageProject.UpdateOrderStatus:input_type -> pb.UpdateOrderStatusRequest
+	69,  // 73: pb.CollageProject.DeleteOrder:input_type -> pb.DeleteOrderRequest
+	70,  // 74: pb.CollageProject.AddToCart:input_type -> pb.AddToCartRequest
+	71,  // 75: pb.CollageProject.GetCartByUser:input_type -> pb.GetCartRequest
+	72,  // 76: pb.CollageProject.UpdateCartQuantity:input_type -> pb.UpdateCartQuantityRequest
+	73,  // 77: pb.CollageProject.RemoveFromCart:input_type -> pb.RemoveFromCartRequest
+	74,  // 78: pb.CollageProject.ClearCart:input_type -> pb.ClearCartRequest
+	75,  // 79: pb.CollageProject.SignUpUser:output_type -> pb.AuthResponse
+	75,  // 80: pb.CollageProject.LoginUser:output_type -> pb.AuthResponse
+	76,  // 81: pb.CollageProject.GetUserByID:output_type -> pb.UserResponse
+	76,  // 82: pb.CollageProject.GetUserByEmail:output_type -> pb.UserResponse
+	76,  // 83: pb.CollageProject.UpdateUser:output_type -> pb.UserResponse
+	77,  // 84: pb.CollageProject.DeleteUser:output_type -> pb.DeleteUserResponse
+	78,  // 85: pb.CollageProject.RefreshToken:output_type -> pb.RefreshTokenResponse
+	79,  // 86: pb.CollageProject.CreateProduct:output_type -> pb.ProductResponse
+	79,  // 87: pb.CollageProject.GetProductByID:output_type -> pb.ProductResponse
+	79,  // 88: pb.CollageProject.GetOnlyProductRequest:output_type -> pb.ProductResponse
+	80,  // 89: pb.CollageProject.GetProductByUserID:output_type -> pb.ListAllProductsByNameResponse
+	81,  // 90: pb.CollageProject.ListProducts:output_type -> pb.ListProductsResponse
+	79,  // 91: pb.CollageProject.UpdateProduct:output_type -> pb.ProductResponse
+	82,  // 92: pb.CollageProject.DeleteProduct:output_type -> pb.DeleteProductResponse
+	79,  // 93: pb.CollageProject.PublishProduct:output_type -> pb.ProductResponse
+	79,  // 94: pb.CollageProject.ArchiveProduct:output_type -> pb.ProductResponse
+	79,  // 95: pb.CollageProject.RestoreProduct:output_type -> pb.ProductResponse
+	83,  // 96: pb.CollageProject.ImportProducts:output_type -> pb.ImportProductsResponse
+	84,  // 97: pb.CollageProject.ExportProducts:output_type -> pb.ExportProductsResponse
+	80,  // 98: pb.CollageProject.ListProductsByName:output_type -> pb.ListAllProductsByNameResponse
+	85,  // 99: pb.CollageProject.ListProductsByCategory:output_type -> pb.ListAllProductsByCategoryResponse
+	85,  // 100: pb.CollageProject.ListProductsByType:output_type -> pb.ListAllProductsByCategoryResponse
+	86,  // 101: pb.CollageProject.SearchProducts:output_type -> pb.SearchProductsResponse
+	87,  // 102: pb.CollageProject.AutocompleteSearch:output_type -> pb.AutocompleteResponse
+	88,  // 103: pb.CollageProject.QueryProducts:output_type -> pb.QueryProductsResponse
+	89,  // 104: pb.CollageProject.CreateCategory:output_type -> pb.CategoryResponse
+	89,  // 105: pb.CollageProject.UpdateCategory:output_type -> pb.CategoryResponse
+	90,  // 106: pb.CollageProject.DeleteCategory:output_type -> pb.DeleteCategoryResponse
+	91,  // 107: pb.CollageProject.GetCategoryTree:output_type -> pb.GetCategoryTreeResponse
+	92,  // 108: pb.CollageProject.CreateReview:output_type -> pb.ReviewResponse
+	93,  // 109: pb.CollageProject.ListProductReviews:output_type -> pb.ListProductReviewsResponse
+	92,  // 110: pb.CollageProject.MarkReviewHelpful:output_type -> pb.ReviewResponse
+	92,  // 111: pb.CollageProject.ReplyToReview:output_type -> pb.ReviewResponse
+	94,  // 112: pb.CollageProject.AdjustStock:output_type -> pb.AdjustStockResponse
+	95,  // 113: pb.CollageProject.ListStockMovements:output_type -> pb.ListStockMovementsResponse
+	79,  // 114: pb.CollageProject.SetLowStockThreshold:output_type -> pb.ProductResponse
+	96,  // 115: pb.CollageProject.ListLowStockProducts:output_type -> pb.ListLowStockProductsResponse
+	97,  // 116: pb.CollageProject.ScheduleSale:output_type -> pb.ScheduleSaleResponse
+	98,  // 117: pb.CollageProject.ListPriceHistory:output_type -> pb.ListPriceHistoryResponse
+	99,  // 118: pb.CollageProject.CreateCoupon:output_type -> pb.CouponResponse
+	100, // 119: pb.CollageProject.ValidateCoupon:output_type -> pb.ValidateCouponResponse
+	101, // 120: pb.CollageProject.GetDownloadLink:output_type -> pb.GetDownloadLinkResponse
+	102, // 121: pb.CollageProject.AskQuestion:output_type -> pb.QuestionResponse
+	103, // 122: pb.CollageProject.AnswerQuestion:output_type -> pb.AnswerResponse
+	104, // 123: pb.CollageProject.ListProductQuestions:output_type -> pb.ListProductQuestionsResponse
+	102, // 124: pb.CollageProject.UpvoteQuestion:output_type -> pb.QuestionResponse
+	103, // 125: pb.CollageProject.UpvoteAnswer:output_type -> pb.AnswerResponse
+	105, // 126: pb.CollageProject.FlagQuestion:output_type -> pb.FlagResponse
+	105, // 127: pb.CollageProject.FlagAnswer:output_type -> pb.FlagResponse
+	102, // 128: pb.CollageProject.ModerateQuestion:output_type -> pb.QuestionResponse
+	103, // 129: pb.CollageProject.ModerateAnswer:output_type -> pb.AnswerResponse
+	106, // 130: pb.CollageProject.ListPendingProducts:output_type -> pb.ListPendingProductsResponse
+	79,  // 131: pb.CollageProject.ApproveProduct:output_type -> pb.ProductResponse
+	79,  // 132: pb.CollageProject.RejectProduct:output_type -> pb.ProductResponse
+	107, // 133: pb.CollageProject.ListModerationLog:output_type -> pb.ListModerationLogResponse
+	108, // 134: pb.CollageProject.GetSearchDictionary:output_type -> pb.SearchDictionaryResponse
+	109, // 135: pb.CollageProject.CreateSynonymGroup:output_type -> pb.SynonymGroupResponse
+	110, // 136: pb.CollageProject.DeleteSynonymGroup:output_type -> pb.SearchDictionaryMessageResponse
+	110, // 137: pb.CollageProject.AddStopWord:output_type -> pb.SearchDictionaryMessageResponse
+	110, // 138: pb.CollageProject.RemoveStopWord:output_type -> pb.SearchDictionaryMessageResponse
+	111, // 139: pb.CollageProject.RecordSearchClick:output_type -> pb.RecordSearchClickResponse
+	112, // 140: pb.CollageProject.TopQueries:output_type -> pb.TopQueriesResponse
+	113, // 141: pb.CollageProject.ZeroResultQueries:output_type -> pb.ZeroResultQueriesResponse
+	114, // 142: pb.CollageProject.SearchCTR:output_type -> pb.SearchCTRResponse
+	115, // 143: pb.CollageProject.AddToWishlist:output_type -> pb.WishlistResponse
+	115, // 144: pb.CollageProject.RemoveFromWishlist:output_type -> pb.WishlistResponse
+	116, // 145: pb.CollageProject.ListWishlist:output_type -> pb.ListWishlistResponse
+	117, // 146: pb.CollageProject.ListNotifications:output_type -> pb.ListNotificationsResponse
+	118, // 147: pb.CollageProject.MarkNotificationRead:output_type -> pb.NotificationResponse
+	119, // 148: pb.CollageProject.CreateOrder:output_type -> pb.OrderResponse
+	119, // 149: pb.CollageProject.GetOrderByID:output_type -> pb.OrderResponse
+	120, // 150: pb.CollageProject.ListOrders:output_type -> pb.ListOrdersResponse
+	119, // 151: pb.CollageProject.UpdateOrderStatus:output_type -> pb.OrderResponse
+	121, // 152: pb.CollageProject.DeleteOrder:output_type -> pb.DeleteOrderResponse
+	122, // 153: pb.CollageProject.AddToCart:output_type -> pb.CartResponse
+	123, // 154: pb.CollageProject.GetCartByUser:output_type -> pb.CartListResponse
+	122, // 155: pb.CollageProject.UpdateCartQuantity:output_type -> pb.CartResponse
+	122, // 156: pb.CollageProject.RemoveFromCart:output_type -> pb.CartResponse
+	122, // 157: pb.CollageProject.ClearCart:output_type -> pb.CartResponse
+	79,  // [79:158] is the sub-list for method output_type
+	0,   // [0:79] is the sub-list for method input_type
+	0,   // [0:0] is the sub-list for extension type_name
+	0,   // [0:0] is the sub-list for extension extendee
+	0,   // [0:0] is the sub-list for field type_name
 }
 
 func init() { file_service_collage_project_proto_init() }
@@ -185,6 +417,19 @@ func file_service_collage_project_proto_init() {
 	file_product_proto_init()
 	file_order_proto_init()
 	file_cart_proto_init()
+	file_category_proto_init()
+	file_review_proto_init()
+	file_wishlist_proto_init()
+	file_notification_proto_init()
+	file_product_import_proto_init()
+	file_stock_proto_init()
+	file_price_proto_init()
+	file_coupon_proto_init()
+	file_digital_proto_init()
+	file_question_proto_init()
+	file_moderation_proto_init()
+	file_search_dictionary_proto_init()
+	file_search_analytics_proto_init()
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
@@ -33,11 +33,61 @@ const (
 	CollageProject_ListProducts_FullMethodName           = "/pb.CollageProject/ListProducts"
 	CollageProject_UpdateProduct_FullMethodName          = "/pb.CollageProject/UpdateProduct"
 	CollageProject_DeleteProduct_FullMethodName          = "/pb.CollageProject/DeleteProduct"
+	CollageProject_PublishProduct_FullMethodName         = "/pb.CollageProject/PublishProduct"
+	CollageProject_ArchiveProduct_FullMethodName         = "/pb.CollageProject/ArchiveProduct"
+	CollageProject_RestoreProduct_FullMethodName         = "/pb.CollageProject/RestoreProduct"
+	CollageProject_ImportProducts_FullMethodName         = "/pb.CollageProject/ImportProducts"
+	CollageProject_ExportProducts_FullMethodName         = "/pb.CollageProject/ExportProducts"
 	CollageProject_ListProductsByName_FullMethodName     = "/pb.CollageProject/ListProductsByName"
 	CollageProject_ListProductsByCategory_FullMethodName = "/pb.CollageProject/ListProductsByCategory"
 	CollageProject_ListProductsByType_FullMethodName     = "/pb.CollageProject/ListProductsByType"
 	CollageProject_SearchProducts_FullMethodName         = "/pb.CollageProject/SearchProducts"
 	CollageProject_AutocompleteSearch_FullMethodName     = "/pb.CollageProject/AutocompleteSearch"
+	CollageProject_QueryProducts_FullMethodName          = "/pb.CollageProject/QueryProducts"
+	CollageProject_CreateCategory_FullMethodName         = "/pb.CollageProject/CreateCategory"
+	CollageProject_UpdateCategory_FullMethodName         = "/pb.CollageProject/UpdateCategory"
+	CollageProject_DeleteCategory_FullMethodName         = "/pb.CollageProject/DeleteCategory"
+	CollageProject_GetCategoryTree_FullMethodName        = "/pb.CollageProject/GetCategoryTree"
+	CollageProject_CreateReview_FullMethodName           = "/pb.CollageProject/CreateReview"
+	CollageProject_ListProductReviews_FullMethodName     = "/pb.CollageProject/ListProductReviews"
+	CollageProject_MarkReviewHelpful_FullMethodName      = "/pb.CollageProject/MarkReviewHelpful"
+	CollageProject_ReplyToReview_FullMethodName          = "/pb.CollageProject/ReplyToReview"
+	CollageProject_AdjustStock_FullMethodName            = "/pb.CollageProject/AdjustStock"
+	CollageProject_ListStockMovements_FullMethodName     = "/pb.CollageProject/ListStockMovements"
+	CollageProject_SetLowStockThreshold_FullMethodName   = "/pb.CollageProject/SetLowStockThreshold"
+	CollageProject_ListLowStockProducts_FullMethodName   = "/pb.CollageProject/ListLowStockProducts"
+	CollageProject_ScheduleSale_FullMethodName           = "/pb.CollageProject/ScheduleSale"
+	CollageProject_ListPriceHistory_FullMethodName       = "/pb.CollageProject/ListPriceHistory"
+	CollageProject_CreateCoupon_FullMethodName           = "/pb.CollageProject/CreateCoupon"
+	CollageProject_ValidateCoupon_FullMethodName         = "/pb.CollageProject/ValidateCoupon"
+	CollageProject_GetDownloadLink_FullMethodName        = "/pb.CollageProject/GetDownloadLink"
+	CollageProject_AskQuestion_FullMethodName            = "/pb.CollageProject/AskQuestion"
+	CollageProject_AnswerQuestion_FullMethodName         = "/pb.CollageProject/AnswerQuestion"
+	CollageProject_ListProductQuestions_FullMethodName   = "/pb.CollageProject/ListProductQuestions"
+	CollageProject_UpvoteQuestion_FullMethodName         = "/pb.CollageProject/UpvoteQuestion"
+	CollageProject_UpvoteAnswer_FullMethodName           = "/pb.CollageProject/UpvoteAnswer"
+	CollageProject_FlagQuestion_FullMethodName           = "/pb.CollageProject/FlagQuestion"
+	CollageProject_FlagAnswer_FullMethodName             = "/pb.CollageProject/FlagAnswer"
+	CollageProject_ModerateQuestion_FullMethodName       = "/pb.CollageProject/ModerateQuestion"
+	CollageProject_ModerateAnswer_FullMethodName         = "/pb.CollageProject/ModerateAnswer"
+	CollageProject_ListPendingProducts_FullMethodName    = "/pb.CollageProject/ListPendingProducts"
+	CollageProject_ApproveProduct_FullMethodName         = "/pb.CollageProject/ApproveProduct"
+	CollageProject_RejectProduct_FullMethodName          = "/pb.CollageProject/RejectProduct"
+	CollageProject_ListModerationLog_FullMethodName      = "/pb.CollageProject/ListModerationLog"
+	CollageProject_GetSearchDictionary_FullMethodName    = "/pb.CollageProject/GetSearchDictionary"
+	CollageProject_CreateSynonymGroup_FullMethodName     = "/pb.CollageProject/CreateSynonymGroup"
+	CollageProject_DeleteSynonymGroup_FullMethodName     = "/pb.CollageProject/DeleteSynonymGroup"
+	CollageProject_AddStopWord_FullMethodName            = "/pb.CollageProject/AddStopWord"
+	CollageProject_RemoveStopWord_FullMethodName         = "/pb.CollageProject/RemoveStopWord"
+	CollageProject_RecordSearchClick_FullMethodName      = "/pb.CollageProject/RecordSearchClick"
+	CollageProject_TopQueries_FullMethodName             = "/pb.CollageProject/TopQueries"
+	CollageProject_ZeroResultQueries_FullMethodName      = "/pb.CollageProject/ZeroResultQueries"
+	CollageProject_SearchCTR_FullMethodName              = "/pb.CollageProject/SearchCTR"
+	CollageProject_AddToWishlist_FullMethodName          = "/pb.CollageProject/AddToWishlist"
+	CollageProject_RemoveFromWishlist_FullMethodName     = "/pb.CollageProject/RemoveFromWishlist"
+	CollageProject_ListWishlist_FullMethodName           = "/pb.CollageProject/ListWishlist"
+	CollageProject_ListNotifications_FullMethodName      = "/pb.CollageProject/ListNotifications"
+	CollageProject_MarkNotificationRead_FullMethodName   = "/pb.CollageProject/MarkNotificationRead"
 	CollageProject_CreateOrder_FullMethodName            = "/pb.CollageProject/CreateOrder"
 	CollageProject_GetOrderByID_FullMethodName           = "/pb.CollageProject/GetOrderByID"
 	CollageProject_ListOrders_FullMethodName             = "/pb.CollageProject/ListOrders"
@@ -70,11 +120,76 @@ type CollageProjectClient interface {
 	ListProducts(ctx context.Context, in *ListAllProductsRequest, opts ...grpc.CallOption) (*ListProductsResponse, error)
 	UpdateProduct(ctx context.Context, in *UpdateProductRequest, opts ...grpc.CallOption) (*ProductResponse, error)
 	DeleteProduct(ctx context.Context, in *DeleteProductRequest, opts ...grpc.CallOption) (*DeleteProductResponse, error)
+	PublishProduct(ctx context.Context, in *PublishProductRequest, opts ...grpc.CallOption) (*ProductResponse, error)
+	ArchiveProduct(ctx context.Context, in *ArchiveProductRequest, opts ...grpc.CallOption) (*ProductResponse, error)
+	RestoreProduct(ctx context.Context, in *RestoreProductRequest, opts ...grpc.CallOption) (*ProductResponse, error)
+	// Streaming calls are not served by the gateway, HTTP clients use
+	// /api/products/import and /api/products/export instead
+	ImportProducts(ctx context.Context, opts ...grpc.CallOption) (grpc.ClientStreamingClient[ImportProductsRequest, ImportProductsResponse], error)
+	ExportProducts(ctx context.Context, in *ExportProductsRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[ExportProductsResponse], error)
 	ListProductsByName(ctx context.Context, in *ListAllProductsByNameRequest, opts ...grpc.CallOption) (*ListAllProductsByNameResponse, error)
 	ListProductsByCategory(ctx context.Context, in *ListAllProductsByCategoryRequest, opts ...grpc.CallOption) (*ListAllProductsByCategoryResponse, error)
 	ListProductsByType(ctx context.Context, in *ListAllProductsByTypeRequest, opts ...grpc.CallOption) (*ListAllProductsByCategoryResponse, error)
 	SearchProducts(ctx context.Context, in *SearchProductsRequest, opts ...grpc.CallOption) (*SearchProductsResponse, error)
 	AutocompleteSearch(ctx context.Context, in *AutocompleteRequest, opts ...grpc.CallOption) (*AutocompleteResponse, error)
+	QueryProducts(ctx context.Context, in *QueryProductsRequest, opts ...grpc.CallOption) (*QueryProductsResponse, error)
+	// CATEGORY
+	CreateCategory(ctx context.Context, in *CreateCategoryRequest, opts ...grpc.CallOption) (*CategoryResponse, error)
+	UpdateCategory(ctx context.Context, in *UpdateCategoryRequest, opts ...grpc.CallOption) (*CategoryResponse, error)
+	DeleteCategory(ctx context.Context, in *DeleteCategoryRequest, opts ...grpc.CallOption) (*DeleteCategoryResponse, error)
+	GetCategoryTree(ctx context.Context, in *GetCategoryTreeRequest, opts ...grpc.CallOption) (*GetCategoryTreeResponse, error)
+	// REVIEW
+	CreateReview(ctx context.Context, in *CreateReviewRequest, opts ...grpc.CallOption) (*ReviewResponse, error)
+	ListProductReviews(ctx context.Context, in *ListProductReviewsRequest, opts ...grpc.CallOption) (*ListProductReviewsResponse, error)
+	MarkReviewHelpful(ctx context.Context, in *MarkReviewHelpfulRequest, opts ...grpc.CallOption) (*ReviewResponse, error)
+	ReplyToReview(ctx context.Context, in *ReplyToReviewRequest, opts ...grpc.CallOption) (*ReviewResponse, error)
+	// STOCK
+	AdjustStock(ctx context.Context, in *AdjustStockRequest, opts ...grpc.CallOption) (*AdjustStockResponse, error)
+	ListStockMovements(ctx context.Context, in *ListStockMovementsRequest, opts ...grpc.CallOption) (*ListStockMovementsResponse, error)
+	SetLowStockThreshold(ctx context.Context, in *SetLowStockThresholdRequest, opts ...grpc.CallOption) (*ProductResponse, error)
+	ListLowStockProducts(ctx context.Context, in *ListLowStockProductsRequest, opts ...grpc.CallOption) (*ListLowStockProductsResponse, error)
+	// PRICE
+	ScheduleSale(ctx context.Context, in *ScheduleSaleRequest, opts ...grpc.CallOption) (*ScheduleSaleResponse, error)
+	ListPriceHistory(ctx context.Context, in *ListPriceHistoryRequest, opts ...grpc.CallOption) (*ListPriceHistoryResponse, error)
+	// COUPON
+	CreateCoupon(ctx context.Context, in *CreateCouponRequest, opts ...grpc.CallOption) (*CouponResponse, error)
+	ValidateCoupon(ctx context.Context, in *ValidateCouponRequest, opts ...grpc.CallOption) (*ValidateCouponResponse, error)
+	// DIGITAL
+	// Assets are uploaded with a multipart POST to /api/products/asset and
+	// the signed links point at /api/downloads
+	GetDownloadLink(ctx context.Context, in *GetDownloadLinkRequest, opts ...grpc.CallOption) (*GetDownloadLinkResponse, error)
+	// QUESTION
+	AskQuestion(ctx context.Context, in *AskQuestionRequest, opts ...grpc.CallOption) (*QuestionResponse, error)
+	AnswerQuestion(ctx context.Context, in *AnswerQuestionRequest, opts ...grpc.CallOption) (*AnswerResponse, error)
+	ListProductQuestions(ctx context.Context, in *ListProductQuestionsRequest, opts ...grpc.CallOption) (*ListProductQuestionsResponse, error)
+	UpvoteQuestion(ctx context.Context, in *UpvoteQuestionRequest, opts ...grpc.CallOption) (*QuestionResponse, error)
+	UpvoteAnswer(ctx context.Context, in *UpvoteAnswerRequest, opts ...grpc.CallOption) (*AnswerResponse, error)
+	FlagQuestion(ctx context.Context, in *FlagQuestionRequest, opts ...grpc.CallOption) (*FlagResponse, error)
+	FlagAnswer(ctx context.Context, in *FlagAnswerRequest, opts ...grpc.CallOption) (*FlagResponse, error)
+	ModerateQuestion(ctx context.Context, in *ModerateQuestionRequest, opts ...grpc.CallOption) (*QuestionResponse, error)
+	ModerateAnswer(ctx context.Context, in *ModerateAnswerRequest, opts ...grpc.CallOption) (*AnswerResponse, error)
+	// MODERATION
+	ListPendingProducts(ctx context.Context, in *ListPendingProductsRequest, opts ...grpc.CallOption) (*ListPendingProductsResponse, error)
+	ApproveProduct(ctx context.Context, in *ApproveProductRequest, opts ...grpc.CallOption) (*ProductResponse, error)
+	RejectProduct(ctx context.Context, in *RejectProductRequest, opts ...grpc.CallOption) (*ProductResponse, error)
+	ListModerationLog(ctx context.Context, in *ListModerationLogRequest, opts ...grpc.CallOption) (*ListModerationLogResponse, error)
+	// SEARCH
+	GetSearchDictionary(ctx context.Context, in *GetSearchDictionaryRequest, opts ...grpc.CallOption) (*SearchDictionaryResponse, error)
+	CreateSynonymGroup(ctx context.Context, in *CreateSynonymGroupRequest, opts ...grpc.CallOption) (*SynonymGroupResponse, error)
+	DeleteSynonymGroup(ctx context.Context, in *DeleteSynonymGroupRequest, opts ...grpc.CallOption) (*SearchDictionaryMessageResponse, error)
+	AddStopWord(ctx context.Context, in *StopWordRequest, opts ...grpc.CallOption) (*SearchDictionaryMessageResponse, error)
+	RemoveStopWord(ctx context.Context, in *StopWordRequest, opts ...grpc.CallOption) (*SearchDictionaryMessageResponse, error)
+	RecordSearchClick(ctx context.Context, in *RecordSearchClickRequest, opts ...grpc.CallOption) (*RecordSearchClickResponse, error)
+	TopQueries(ctx context.Context, in *SearchAnalyticsRequest, opts ...grpc.CallOption) (*TopQueriesResponse, error)
+	ZeroResultQueries(ctx context.Context, in *SearchAnalyticsRequest, opts ...grpc.CallOption) (*ZeroResultQueriesResponse, error)
+	SearchCTR(ctx context.Context, in *SearchAnalyticsRequest, opts ...grpc.CallOption) (*SearchCTRResponse, error)
+	// WISHLIST
+	AddToWishlist(ctx context.Context, in *AddToWishlistRequest, opts ...grpc.CallOption) (*WishlistResponse, error)
+	RemoveFromWishlist(ctx context.Context, in *RemoveFromWishlistRequest, opts ...grpc.CallOption) (*WishlistResponse, error)
+	ListWishlist(ctx context.Context, in *ListWishlistRequest, opts ...grpc.CallOption) (*ListWishlistResponse, error)
+	// NOTIFICATION
+	ListNotifications(ctx context.Context, in *ListNotificationsRequest, opts ...grpc.CallOption) (*ListNotificationsResponse, error)
+	MarkNotificationRead(ctx context.Context, in *MarkNotificationReadRequest, opts ...grpc.CallOption) (*NotificationResponse, error)
 	// ORDER
 	CreateOrder(ctx context.Context, in *CreateOrderRequest, opts ...grpc.CallOption) (*OrderResponse, error)
 	GetOrderByID(ctx context.Context, in *GetOrderRequest, opts ...grpc.CallOption) (*OrderResponse, error)
@@ -237,6 +352,68 @@ func (c *collageProjectClient) DeleteProduct(ctx context.Context, in *DeleteProd
 	return out, nil
 }
 
+func (c *collageProjectClient) PublishProduct(ctx context.Context, in *PublishProductRequest, opts ...grpc.CallOption) (*ProductResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ProductResponse)
+	err := c.cc.Invoke(ctx, CollageProject_PublishProduct_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *collageProjectClient) ArchiveProduct(ctx context.Context, in *ArchiveProductRequest, opts ...grpc.CallOption) (*ProductResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ProductResponse)
+	err := c.cc.Invoke(ctx, CollageProject_ArchiveProduct_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *collageProjectClient) RestoreProduct(ctx context.Context, in *RestoreProductRequest, opts ...grpc.CallOption) (*ProductResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ProductResponse)
+	err := c.cc.Invoke(ctx, CollageProject_RestoreProduct_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *collageProjectClient) ImportProducts(ctx context.Context, opts ...grpc.CallOption) (grpc.ClientStreamingClient[ImportProductsRequest, ImportProductsResponse], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &CollageProject_ServiceDesc.Streams[0], CollageProject_ImportProducts_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[ImportProductsRequest, ImportProductsResponse]{ClientStream: stream}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type CollageProject_ImportProductsClient = grpc.ClientStreamingClient[ImportProductsRequest, ImportProductsResponse]
+
+func (c *collageProjectClient) ExportProducts(ctx context.Context, in *ExportProductsRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[ExportProductsResponse], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &CollageProject_ServiceDesc.Streams[1], CollageProject_ExportProducts_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[ExportProductsRequest, ExportProductsResponse]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type CollageProject_ExportProductsClient = grpc.ServerStreamingClient[ExportProductsResponse]
+
 func (c *collageProjectClient) ListProductsByName(ctx context.Context, in *ListAllProductsByNameRequest, opts ...grpc.CallOption) (*ListAllProductsByNameResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(ListAllProductsByNameResponse)
@@ -287,599 +464,2146 @@ func (c *collageProjectClient) AutocompleteSearch(ctx context.Context, in *Autoc
 	return out, nil
 }
 
-func (c *collageProjectClient) CreateOrder(ctx context.Context, in *CreateOrderRequest, opts ...grpc.CallOption) (*OrderResponse, error) {
+func (c *collageProjectClient) QueryProducts(ctx context.Context, in *QueryProductsRequest, opts ...grpc.CallOption) (*QueryProductsResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
-	out := new(OrderResponse)
-	err := c.cc.Invoke(ctx, CollageProject_CreateOrder_FullMethodName, in, out, cOpts...)
+	out := new(QueryProductsResponse)
+	err := c.cc.Invoke(ctx, CollageProject_QueryProducts_FullMethodName, in, out, cOpts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *collageProjectClient) GetOrderByID(ctx context.Context, in *GetOrderRequest, opts ...grpc.CallOption) (*OrderResponse, error) {
+func (c *collageProjectClient) CreateCategory(ctx context.Context, in *CreateCategoryRequest, opts ...grpc.CallOption) (*CategoryResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
-	out := new(OrderResponse)
-	err := c.cc.Invoke(ctx, CollageProject_GetOrderByID_FullMethodName, in, out, cOpts...)
+	out := new(CategoryResponse)
+	err := c.cc.Invoke(ctx, CollageProject_CreateCategory_FullMethodName, in, out, cOpts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *collageProjectClient) ListOrders(ctx context.Context, in *ListOrdersByUserRequest, opts ...grpc.CallOption) (*ListOrdersResponse, error) {
+func (c *collageProjectClient) UpdateCategory(ctx context.Context, in *UpdateCategoryRequest, opts ...grpc.CallOption) (*CategoryResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
-	out := new(ListOrdersResponse)
-	err := c.cc.Invoke(ctx, CollageProject_ListOrders_FullMethodName, in, out, cOpts...)
+	out := new(CategoryResponse)
+	err := c.cc.Invoke(ctx, CollageProject_UpdateCategory_FullMethodName, in, out, cOpts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *collageProjectClient) UpdateOrderStatus(ctx context.Context, in *UpdateOrderStatusRequest, opts ...grpc.CallOption) (*OrderResponse, error) {
+func (c *collageProjectClient) DeleteCategory(ctx context.Context, in *DeleteCategoryRequest, opts ...grpc.CallOption) (*DeleteCategoryResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
-	out := new(OrderResponse)
-	err := c.cc.Invoke(ctx, CollageProject_UpdateOrderStatus_FullMethodName, in, out, cOpts...)
+	out := new(DeleteCategoryResponse)
+	err := c.cc.Invoke(ctx, CollageProject_DeleteCategory_FullMethodName, in, out, cOpts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *collageProjectClient) DeleteOrder(ctx context.Context, in *DeleteOrderRequest, opts ...grpc.CallOption) (*DeleteOrderResponse, error) {
+func (c *collageProjectClient) GetCategoryTree(ctx context.Context, in *GetCategoryTreeRequest, opts ...grpc.CallOption) (*GetCategoryTreeResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
-	out := new(DeleteOrderResponse)
-	err := c.cc.Invoke(ctx, CollageProject_DeleteOrder_FullMethodName, in, out, cOpts...)
+	out := new(GetCategoryTreeResponse)
+	err := c.cc.Invoke(ctx, CollageProject_GetCategoryTree_FullMethodName, in, out, cOpts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *collageProjectClient) AddToCart(ctx context.Context, in *AddToCartRequest, opts ...grpc.CallOption) (*CartResponse, error) {
+func (c *collageProjectClient) CreateReview(ctx context.Context, in *CreateReviewRequest, opts ...grpc.CallOption) (*ReviewResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
-	out := new(CartResponse)
-	err := c.cc.Invoke(ctx, CollageProject_AddToCart_FullMethodName, in, out, cOpts...)
+	out := new(ReviewResponse)
+	err := c.cc.Invoke(ctx, CollageProject_CreateReview_FullMethodName, in, out, cOpts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *collageProjectClient) GetCartByUser(ctx context.Context, in *GetCartRequest, opts ...grpc.CallOption) (*CartListResponse, error) {
+func (c *collageProjectClient) ListProductReviews(ctx context.Context, in *ListProductReviewsRequest, opts ...grpc.CallOption) (*ListProductReviewsResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
-	out := new(CartListResponse)
-	err := c.cc.Invoke(ctx, CollageProject_GetCartByUser_FullMethodName, in, out, cOpts...)
+	out := new(ListProductReviewsResponse)
+	err := c.cc.Invoke(ctx, CollageProject_ListProductReviews_FullMethodName, in, out, cOpts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *collageProjectClient) UpdateCartQuantity(ctx context.Context, in *UpdateCartQuantityRequest, opts ...grpc.CallOption) (*CartResponse, error) {
+func (c *collageProjectClient) MarkReviewHelpful(ctx context.Context, in *MarkReviewHelpfulRequest, opts ...grpc.CallOption) (*ReviewResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
-	out := new(CartResponse)
-	err := c.cc.Invoke(ctx, CollageProject_UpdateCartQuantity_FullMethodName, in, out, cOpts...)
+	out := new(ReviewResponse)
+	err := c.cc.Invoke(ctx, CollageProject_MarkReviewHelpful_FullMethodName, in, out, cOpts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *collageProjectClient) RemoveFromCart(ctx context.Context, in *RemoveFromCartRequest, opts ...grpc.CallOption) (*CartResponse, error) {
+func (c *collageProjectClient) ReplyToReview(ctx context.Context, in *ReplyToReviewRequest, opts ...grpc.CallOption) (*ReviewResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
-	out := new(CartResponse)
-	err := c.cc.Invoke(ctx, CollageProject_RemoveFromCart_FullMethodName, in, out, cOpts...)
+	out := new(ReviewResponse)
+	err := c.cc.Invoke(ctx, CollageProject_ReplyToReview_FullMethodName, in, out, cOpts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *collageProjectClient) ClearCart(ctx context.Context, in *ClearCartRequest, opts ...grpc.CallOption) (*CartResponse, error) {
+func (c *collageProjectClient) AdjustStock(ctx context.Context, in *AdjustStockRequest, opts ...grpc.CallOption) (*AdjustStockResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
-	out := new(CartResponse)
-	err := c.cc.Invoke(ctx, CollageProject_ClearCart_FullMethodName, in, out, cOpts...)
+	out := new(AdjustStockResponse)
+	err := c.cc.Invoke(ctx, CollageProject_AdjustStock_FullMethodName, in, out, cOpts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-// CollageProjectServer is the server API for CollageProject service.
-// All implementations must embed UnimplementedCollageProjectServer
-// for forward compatibility.
-type CollageProjectServer interface {
-	// USER
-	SignUpUser(context.Context, *SignUpRequest) (*AuthResponse, error)
-	LoginUser(context.Context, *LoginRequest) (*AuthResponse, error)
-	GetUserByID(context.Context, *GetUserRequest) (*UserResponse, error)
-	GetUserByEmail(context.Context, *GetUserByEmailRequest) (*UserResponse, error)
-	UpdateUser(context.Context, *UpdateUserRequest) (*UserResponse, error)
-	DeleteUser(context.Context, *DeleteUserRequest) (*DeleteUserResponse, error)
-	RefreshToken(context.Context, *RefreshTokenRequest) (*RefreshTokenResponse, error)
-	// Product
-	CreateProduct(context.Context, *CreateProductRequest) (*ProductResponse, error)
-	GetProductByID(context.Context, *GetProductRequest) (*ProductResponse, error)
-	GetOnlyProductRequest(context.Context, *GetProductRequest) (*ProductResponse, error)
-	GetProductByUserID(context.Context, *ListAllProductsByCreateBy) (*ListAllProductsByNameResponse, error)
-	ListProducts(context.Context, *ListAllProductsRequest) (*ListProductsResponse, error)
-	UpdateProduct(context.Context, *UpdateProductRequest) (*ProductResponse, error)
-	DeleteProduct(context.Context, *DeleteProductRequest) (*DeleteProductResponse, error)
-	ListProductsByName(context.Context, *ListAllProductsByNameRequest) (*ListAllProductsByNameResponse, error)
-	ListProductsByCategory(context.Context, *ListAllProductsByCategoryRequest) (*ListAllProductsByCategoryResponse, error)
-	ListProductsByType(context.Context, *ListAllProductsByTypeRequest) (*ListAllProductsByCategoryResponse, error)
-	SearchProducts(context.Context, *SearchProductsRequest) (*SearchProductsResponse, error)
-	AutocompleteSearch(context.Context, *AutocompleteRequest) (*AutocompleteResponse, error)
-	// ORDER
-	CreateOrder(context.Context, *CreateOrderRequest) (*OrderResponse, error)
-	GetOrderByID(context.Context, *GetOrderRequest) (*OrderResponse, error)
-	ListOrders(context.Context, *ListOrdersByUserRequest) (*ListOrdersResponse, error)
-	UpdateOrderStatus(context.Context, *UpdateOrderStatusRequest) (*OrderResponse, error)
-	DeleteOrder(context.Context, *DeleteOrderRequest) (*DeleteOrderResponse, error)
-	// CART
-	AddToCart(context.Context, *AddToCartRequest) (*CartResponse, error)
-	GetCartByUser(context.Context, *GetCartRequest) (*CartListResponse, error)
-	UpdateCartQuantity(context.Context, *UpdateCartQuantityRequest) (*CartResponse, error)
-	RemoveFromCart(context.Context, *RemoveFromCartRequest) (*CartResponse, error)
-	ClearCart(context.Context, *ClearCartRequest) (*CartResponse, error)
-	mustEmbedUnimplementedCollageProjectServer()
+func (c *collageProjectClient) ListStockMovements(ctx context.Context, in *ListStockMovementsRequest, opts ...grpc.CallOption) (*ListStockMovementsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListStockMovementsResponse)
+	err := c.cc.Invoke(ctx, CollageProject_ListStockMovements_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-// UnimplementedCollageProjectServer must be embedded to have
-// forward compatible implementations.
-//
-// NOTE: this should be embedded by value instead of pointer to avoid a nil
-// pointer dereference when methods are called.
-type UnimplementedCollageProjectServer struct{}
+func (c *collageProjectClient) SetLowStockThreshold(ctx context.Context, in *SetLowStockThresholdRequest, opts ...grpc.CallOption) (*ProductResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ProductResponse)
+	err := c.cc.Invoke(ctx, CollageProject_SetLowStockThreshold_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
 
-func (UnimplementedCollageProjectServer) SignUpUser(context.Context, *SignUpRequest) (*AuthResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method SignUpUser not implemented")
+func (c *collageProjectClient) ListLowStockProducts(ctx context.Context, in *ListLowStockProductsRequest, opts ...grpc.CallOption) (*ListLowStockProductsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListLowStockProductsResponse)
+	err := c.cc.Invoke(ctx, CollageProject_ListLowStockProducts_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedCollageProjectServer) LoginUser(context.Context, *LoginRequest) (*AuthResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method LoginUser not implemented")
+
+func (c *collageProjectClient) ScheduleSale(ctx context.Context, in *ScheduleSaleRequest, opts ...grpc.CallOption) (*ScheduleSaleResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ScheduleSaleResponse)
+	err := c.cc.Invoke(ctx, CollageProject_ScheduleSale_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedCollageProjectServer) GetUserByID(context.Context, *GetUserRequest) (*UserResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetUserByID not implemented")
+
+func (c *collageProjectClient) ListPriceHistory(ctx context.Context, in *ListPriceHistoryRequest, opts ...grpc.CallOption) (*ListPriceHistoryResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListPriceHistoryResponse)
+	err := c.cc.Invoke(ctx, CollageProject_ListPriceHistory_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedCollageProjectServer) GetUserByEmail(context.Context, *GetUserByEmailRequest) (*UserResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetUserByEmail not implemented")
+
+func (c *collageProjectClient) CreateCoupon(ctx context.Context, in *CreateCouponRequest, opts ...grpc.CallOption) (*CouponResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CouponResponse)
+	err := c.cc.Invoke(ctx, CollageProject_CreateCoupon_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedCollageProjectServer) UpdateUser(context.Context, *UpdateUserRequest) (*UserResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method UpdateUser not implemented")
+
+func (c *collageProjectClient) ValidateCoupon(ctx context.Context, in *ValidateCouponRequest, opts ...grpc.CallOption) (*ValidateCouponResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ValidateCouponResponse)
+	err := c.cc.Invoke(ctx, CollageProject_ValidateCoupon_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedCollageProjectServer) DeleteUser(context.Context, *DeleteUserRequest) (*DeleteUserResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method DeleteUser not implemented")
+
+func (c *collageProjectClient) GetDownloadLink(ctx context.Context, in *GetDownloadLinkRequest, opts ...grpc.CallOption) (*GetDownloadLinkResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetDownloadLinkResponse)
+	err := c.cc.Invoke(ctx, CollageProject_GetDownloadLink_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedCollageProjectServer) RefreshToken(context.Context, *RefreshTokenRequest) (*RefreshTokenResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method RefreshToken not implemented")
+
+func (c *collageProjectClient) AskQuestion(ctx context.Context, in *AskQuestionRequest, opts ...grpc.CallOption) (*QuestionResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(QuestionResponse)
+	err := c.cc.Invoke(ctx, CollageProject_AskQuestion_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedCollageProjectServer) CreateProduct(context.Context, *CreateProductRequest) (*ProductResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method CreateProduct not implemented")
+
+func (c *collageProjectClient) AnswerQuestion(ctx context.Context, in *AnswerQuestionRequest, opts ...grpc.CallOption) (*AnswerResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AnswerResponse)
+	err := c.cc.Invoke(ctx, CollageProject_AnswerQuestion_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedCollageProjectServer) GetProductByID(context.Context, *GetProductRequest) (*ProductResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetProductByID not implemented")
+
+func (c *collageProjectClient) ListProductQuestions(ctx context.Context, in *ListProductQuestionsRequest, opts ...grpc.CallOption) (*ListProductQuestionsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListProductQuestionsResponse)
+	err := c.cc.Invoke(ctx, CollageProject_ListProductQuestions_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedCollageProjectServer) GetOnlyProductRequest(context.Context, *GetProductRequest) (*ProductResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetOnlyProductRequest not implemented")
+
+func (c *collageProjectClient) UpvoteQuestion(ctx context.Context, in *UpvoteQuestionRequest, opts ...grpc.CallOption) (*QuestionResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(QuestionResponse)
+	err := c.cc.Invoke(ctx, CollageProject_UpvoteQuestion_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedCollageProjectServer) GetProductByUserID(context.Context, *ListAllProductsByCreateBy) (*ListAllProductsByNameResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetProductByUserID not implemented")
+
+func (c *collageProjectClient) UpvoteAnswer(ctx context.Context, in *UpvoteAnswerRequest, opts ...grpc.CallOption) (*AnswerResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AnswerResponse)
+	err := c.cc.Invoke(ctx, CollageProject_UpvoteAnswer_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedCollageProjectServer) ListProducts(context.Context, *ListAllProductsRequest) (*ListProductsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ListProducts not implemented")
+
+func (c *collageProjectClient) FlagQuestion(ctx context.Context, in *FlagQuestionRequest, opts ...grpc.CallOption) (*FlagResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(FlagResponse)
+	err := c.cc.Invoke(ctx, CollageProject_FlagQuestion_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedCollageProjectServer) UpdateProduct(context.Context, *UpdateProductRequest) (*ProductResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method UpdateProduct not implemented")
+
+func (c *collageProjectClient) FlagAnswer(ctx context.Context, in *FlagAnswerRequest, opts ...grpc.CallOption) (*FlagResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(FlagResponse)
+	err := c.cc.Invoke(ctx, CollageProject_FlagAnswer_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedCollageProjectServer) DeleteProduct(context.Context, *DeleteProductRequest) (*DeleteProductResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method DeleteProduct not implemented")
+
+func (c *collageProjectClient) ModerateQuestion(ctx context.Context, in *ModerateQuestionRequest, opts ...grpc.CallOption) (*QuestionResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(QuestionResponse)
+	err := c.cc.Invoke(ctx, CollageProject_ModerateQuestion_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedCollageProjectServer) ListProductsByName(context.Context, *ListAllProductsByNameRequest) (*ListAllProductsByNameResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ListProductsByName not implemented")
+
+func (c *collageProjectClient) ModerateAnswer(ctx context.Context, in *ModerateAnswerRequest, opts ...grpc.CallOption) (*AnswerResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AnswerResponse)
+	err := c.cc.Invoke(ctx, CollageProject_ModerateAnswer_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedCollageProjectServer) ListProductsByCategory(context.Context, *ListAllProductsByCategoryRequest) (*ListAllProductsByCategoryResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ListProductsByCategory not implemented")
+
+func (c *collageProjectClient) ListPendingProducts(ctx context.Context, in *ListPendingProductsRequest, opts ...grpc.CallOption) (*ListPendingProductsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListPendingProductsResponse)
+	err := c.cc.Invoke(ctx, CollageProject_ListPendingProducts_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedCollageProjectServer) ListProductsByType(context.Context, *ListAllProductsByTypeRequest) (*ListAllProductsByCategoryResponse, error) {
+
+func (c *collageProjectClient) ApproveProduct(ctx context.Context, in *ApproveProductRequest, opts ...grpc.CallOption) (*ProductResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ProductResponse)
+	err := c.cc.Invoke(ctx, CollageProject_ApproveProduct_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *collageProjectClient) RejectProduct(ctx context.Context, in *RejectProductRequest, opts ...grpc.CallOption) (*ProductResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ProductResponse)
+	err := c.cc.Invoke(ctx, CollageProject_RejectProduct_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *collageProjectClient) ListModerationLog(ctx context.Context, in *ListModerationLogRequest, opts ...grpc.CallOption) (*ListModerationLogResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListModerationLogResponse)
+	err := c.cc.Invoke(ctx, CollageProject_ListModerationLog_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *collageProjectClient) GetSearchDictionary(ctx context.Context, in *GetSearchDictionaryRequest, opts ...grpc.CallOption) (*SearchDictionaryResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SearchDictionaryResponse)
+	err := c.cc.Invoke(ctx, CollageProject_GetSearchDictionary_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *collageProjectClient) CreateSynonymGroup(ctx context.Context, in *CreateSynonymGroupRequest, opts ...grpc.CallOption) (*SynonymGroupResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SynonymGroupResponse)
+	err := c.cc.Invoke(ctx, CollageProject_CreateSynonymGroup_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *collageProjectClient) DeleteSynonymGroup(ctx context.Context, in *DeleteSynonymGroupRequest, opts ...grpc.CallOption) (*SearchDictionaryMessageResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SearchDictionaryMessageResponse)
+	err := c.cc.Invoke(ctx, CollageProject_DeleteSynonymGroup_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *collageProjectClient) AddStopWord(ctx context.Context, in *StopWordRequest, opts ...grpc.CallOption) (*SearchDictionaryMessageResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SearchDictionaryMessageResponse)
+	err := c.cc.Invoke(ctx, CollageProject_AddStopWord_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *collageProjectClient) RemoveStopWord(ctx context.Context, in *StopWordRequest, opts ...grpc.CallOption) (*SearchDictionaryMessageResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SearchDictionaryMessageResponse)
+	err := c.cc.Invoke(ctx, CollageProject_RemoveStopWord_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *collageProjectClient) RecordSearchClick(ctx context.Context, in *RecordSearchClickRequest, opts ...grpc.CallOption) (*RecordSearchClickResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RecordSearchClickResponse)
+	err := c.cc.Invoke(ctx, CollageProject_RecordSearchClick_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *collageProjectClient) TopQueries(ctx context.Context, in *SearchAnalyticsRequest, opts ...grpc.CallOption) (*TopQueriesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(TopQueriesResponse)
+	err := c.cc.Invoke(ctx, CollageProject_TopQueries_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *collageProjectClient) ZeroResultQueries(ctx context.Context, in *SearchAnalyticsRequest, opts ...grpc.CallOption) (*ZeroResultQueriesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ZeroResultQueriesResponse)
+	err := c.cc.Invoke(ctx, CollageProject_ZeroResultQueries_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *collageProjectClient) SearchCTR(ctx context.Context, in *SearchAnalyticsRequest, opts ...grpc.CallOption) (*SearchCTRResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SearchCTRResponse)
+	err := c.cc.Invoke(ctx, CollageProject_SearchCTR_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *collageProjectClient) AddToWishlist(ctx context.Context, in *AddToWishlistRequest, opts ...grpc.CallOption) (*WishlistResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(WishlistResponse)
+	err := c.cc.Invoke(ctx, CollageProject_AddToWishlist_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *collageProjectClient) RemoveFromWishlist(ctx context.Context, in *RemoveFromWishlistRequest, opts ...grpc.CallOption) (*WishlistResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(WishlistResponse)
+	err := c.cc.Invoke(ctx, CollageProject_RemoveFromWishlist_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *collageProjectClient) ListWishlist(ctx context.Context, in *ListWishlistRequest, opts ...grpc.CallOption) (*ListWishlistResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListWishlistResponse)
+	err := c.cc.Invoke(ctx, CollageProject_ListWishlist_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *collageProjectClient) ListNotifications(ctx context.Context, in *ListNotificationsRequest, opts ...grpc.CallOption) (*ListNotificationsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListNotificationsResponse)
+	err := c.cc.Invoke(ctx, CollageProject_ListNotifications_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *collageProjectClient) MarkNotificationRead(ctx context.Context, in *MarkNotificationReadRequest, opts ...grpc.CallOption) (*NotificationResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(NotificationResponse)
+	err := c.cc.Invoke(ctx, CollageProject_MarkNotificationRead_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *collageProjectClient) CreateOrder(ctx context.Context, in *CreateOrderRequest, opts ...grpc.CallOption) (*OrderResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(OrderResponse)
+	err := c.cc.Invoke(ctx, CollageProject_CreateOrder_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *collageProjectClient) GetOrderByID(ctx context.Context, in *GetOrderRequest, opts ...grpc.CallOption) (*OrderResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(OrderResponse)
+	err := c.cc.Invoke(ctx, CollageProject_GetOrderByID_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *collageProjectClient) ListOrders(ctx context.Context, in *ListOrdersByUserRequest, opts ...grpc.CallOption) (*ListOrdersResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListOrdersResponse)
+	err := c.cc.Invoke(ctx, CollageProject_ListOrders_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *collageProjectClient) UpdateOrderStatus(ctx context.Context, in *UpdateOrderStatusRequest, opts ...grpc.CallOption) (*OrderResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(OrderResponse)
+	err := c.cc.Invoke(ctx, CollageProject_UpdateOrderStatus_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *collageProjectClient) DeleteOrder(ctx context.Context, in *DeleteOrderRequest, opts ...grpc.CallOption) (*DeleteOrderResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeleteOrderResponse)
+	err := c.cc.Invoke(ctx, CollageProject_DeleteOrder_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *collageProjectClient) AddToCart(ctx context.Context, in *AddToCartRequest, opts ...grpc.CallOption) (*CartResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CartResponse)
+	err := c.cc.Invoke(ctx, CollageProject_AddToCart_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *collageProjectClient) GetCartByUser(ctx context.Context, in *GetCartRequest, opts ...grpc.CallOption) (*CartListResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CartListResponse)
+	err := c.cc.Invoke(ctx, CollageProject_GetCartByUser_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *collageProjectClient) UpdateCartQuantity(ctx context.Context, in *UpdateCartQuantityRequest, opts ...grpc.CallOption) (*CartResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CartResponse)
+	err := c.cc.Invoke(ctx, CollageProject_UpdateCartQuantity_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *collageProjectClient) RemoveFromCart(ctx context.Context, in *RemoveFromCartRequest, opts ...grpc.CallOption) (*CartResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CartResponse)
+	err := c.cc.Invoke(ctx, CollageProject_RemoveFromCart_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *collageProjectClient) ClearCart(ctx context.Context, in *ClearCartRequest, opts ...grpc.CallOption) (*CartResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CartResponse)
+	err := c.cc.Invoke(ctx, CollageProject_ClearCart_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// CollageProjectServer is the server API for CollageProject service.
+// All implementations must embed UnimplementedCollageProjectServer
+// for forward compatibility.
+type CollageProjectServer interface {
+	// USER
+	SignUpUser(context.Context, *SignUpRequest) (*AuthResponse, error)
+	LoginUser(context.Context, *LoginRequest) (*AuthResponse, error)
+	GetUserByID(context.Context, *GetUserRequest) (*UserResponse, error)
+	GetUserByEmail(context.Context, *GetUserByEmailRequest) (*UserResponse, error)
+	UpdateUser(context.Context, *UpdateUserRequest) (*UserResponse, error)
+	DeleteUser(context.Context, *DeleteUserRequest) (*DeleteUserResponse, error)
+	RefreshToken(context.Context, *RefreshTokenRequest) (*RefreshTokenResponse, error)
+	// Product
+	CreateProduct(context.Context, *CreateProductRequest) (*ProductResponse, error)
+	GetProductByID(context.Context, *GetProductRequest) (*ProductResponse, error)
+	GetOnlyProductRequest(context.Context, *GetProductRequest) (*ProductResponse, error)
+	GetProductByUserID(context.Context, *ListAllProductsByCreateBy) (*ListAllProductsByNameResponse, error)
+	ListProducts(context.Context, *ListAllProductsRequest) (*ListProductsResponse, error)
+	UpdateProduct(context.Context, *UpdateProductRequest) (*ProductResponse, error)
+	DeleteProduct(context.Context, *DeleteProductRequest) (*DeleteProductResponse, error)
+	PublishProduct(context.Context, *PublishProductRequest) (*ProductResponse, error)
+	ArchiveProduct(context.Context, *ArchiveProductRequest) (*ProductResponse, error)
+	RestoreProduct(context.Context, *RestoreProductRequest) (*ProductResponse, error)
+	// Streaming calls are not served by the gateway, HTTP clients use
+	// /api/products/import and /api/products/export instead
+	ImportProducts(grpc.ClientStreamingServer[ImportProductsRequest, ImportProductsResponse]) error
+	ExportProducts(*ExportProductsRequest, grpc.ServerStreamingServer[ExportProductsResponse]) error
+	ListProductsByName(context.Context, *ListAllProductsByNameRequest) (*ListAllProductsByNameResponse, error)
+	ListProductsByCategory(context.Context, *ListAllProductsByCategoryRequest) (*ListAllProductsByCategoryResponse, error)
+	ListProductsByType(context.Context, *ListAllProductsByTypeRequest) (*ListAllProductsByCategoryResponse, error)
+	SearchProducts(context.Context, *SearchProductsRequest) (*SearchProductsResponse, error)
+	AutocompleteSearch(context.Context, *AutocompleteRequest) (*AutocompleteResponse, error)
+	QueryProducts(context.Context, *QueryProductsRequest) (*QueryProductsResponse, error)
+	// CATEGORY
+	CreateCategory(context.Context, *CreateCategoryRequest) (*CategoryResponse, error)
+	UpdateCategory(context.Context, *UpdateCategoryRequest) (*CategoryResponse, error)
+	DeleteCategory(context.Context, *DeleteCategoryRequest) (*DeleteCategoryResponse, error)
+	GetCategoryTree(context.Context, *GetCategoryTreeRequest) (*GetCategoryTreeResponse, error)
+	// REVIEW
+	CreateReview(context.Context, *CreateReviewRequest) (*ReviewResponse, error)
+	ListProductReviews(context.Context, *ListProductReviewsRequest) (*ListProductReviewsResponse, error)
+	MarkReviewHelpful(context.Context, *MarkReviewHelpfulRequest) (*ReviewResponse, error)
+	ReplyToReview(context.Context, *ReplyToReviewRequest) (*ReviewResponse, error)
+	// STOCK
+	AdjustStock(context.Context, *AdjustStockRequest) (*AdjustStockResponse, error)
+	ListStockMovements(context.Context, *ListStockMovementsRequest) (*ListStockMovementsResponse, error)
+	SetLowStockThreshold(context.Context, *SetLowStockThresholdRequest) (*ProductResponse, error)
+	ListLowStockProducts(context.Context, *ListLowStockProductsRequest) (*ListLowStockProductsResponse, error)
+	// PRICE
+	ScheduleSale(context.Context, *ScheduleSaleRequest) (*ScheduleSaleResponse, error)
+	ListPriceHistory(context.Context, *ListPriceHistoryRequest) (*ListPriceHistoryResponse, error)
+	// COUPON
+	CreateCoupon(context.Context, *CreateCouponRequest) (*CouponResponse, error)
+	ValidateCoupon(context.Context, *ValidateCouponRequest) (*ValidateCouponResponse, error)
+	// DIGITAL
+	// Assets are uploaded with a multipart POST to /api/products/asset and
+	// the signed links point at /api/downloads
+	GetDownloadLink(context.Context, *GetDownloadLinkRequest) (*GetDownloadLinkResponse, error)
+	// QUESTION
+	AskQuestion(context.Context, *AskQuestionRequest) (*QuestionResponse, error)
+	AnswerQuestion(context.Context, *AnswerQuestionRequest) (*AnswerResponse, error)
+	ListProductQuestions(context.Context, *ListProductQuestionsRequest) (*ListProductQuestionsResponse, error)
+	UpvoteQuestion(context.Context, *UpvoteQuestionRequest) (*QuestionResponse, error)
+	UpvoteAnswer(context.Context, *UpvoteAnswerRequest) (*AnswerResponse, error)
+	FlagQuestion(context.Context, *FlagQuestionRequest) (*FlagResponse, error)
+	FlagAnswer(context.Context, *FlagAnswerRequest) (*FlagResponse, error)
+	ModerateQuestion(context.Context, *ModerateQuestionRequest) (*QuestionResponse, error)
+	ModerateAnswer(context.Context, *ModerateAnswerRequest) (*AnswerResponse, error)
+	// MODERATION
+	ListPendingProducts(context.Context, *ListPendingProductsRequest) (*ListPendingProductsResponse, error)
+	ApproveProduct(context.Context, *ApproveProductRequest) (*ProductResponse, error)
+	RejectProduct(context.Context, *RejectProductRequest) (*ProductResponse, error)
+	ListModerationLog(context.Context, *ListModerationLogRequest) (*ListModerationLogResponse, error)
+	// SEARCH
+	GetSearchDictionary(context.Context, *GetSearchDictionaryRequest) (*SearchDictionaryResponse, error)
+	CreateSynonymGroup(context.Context, *CreateSynonymGroupRequest) (*SynonymGroupResponse, error)
+	DeleteSynonymGroup(context.Context, *DeleteSynonymGroupRequest) (*SearchDictionaryMessageResponse, error)
+	AddStopWord(context.Context, *StopWordRequest) (*SearchDictionaryMessageResponse, error)
+	RemoveStopWord(context.Context, *StopWordRequest) (*SearchDictionaryMessageResponse, error)
+	RecordSearchClick(context.Context, *RecordSearchClickRequest) (*RecordSearchClickResponse, error)
+	TopQueries(context.Context, *SearchAnalyticsRequest) (*TopQueriesResponse, error)
+	ZeroResultQueries(context.Context, *SearchAnalyticsRequest) (*ZeroResultQueriesResponse, error)
+	SearchCTR(context.Context, *SearchAnalyticsRequest) (*SearchCTRResponse, error)
+	// WISHLIST
+	AddToWishlist(context.Context, *AddToWishlistRequest) (*WishlistResponse, error)
+	RemoveFromWishlist(context.Context, *RemoveFromWishlistRequest) (*WishlistResponse, error)
+	ListWishlist(context.Context, *ListWishlistRequest) (*ListWishlistResponse, error)
+	// NOTIFICATION
+	ListNotifications(context.Context, *ListNotificationsRequest) (*ListNotificationsResponse, error)
+	MarkNotificationRead(context.Context, *MarkNotificationReadRequest) (*NotificationResponse, error)
+	// ORDER
+	CreateOrder(context.Context, *CreateOrderRequest) (*OrderResponse, error)
+	GetOrderByID(context.Context, *GetOrderRequest) (*OrderResponse, error)
+	ListOrders(context.Context, *ListOrdersByUserRequest) (*ListOrdersResponse, error)
+	UpdateOrderStatus(context.Context, *UpdateOrderStatusRequest) (*OrderResponse, error)
+	DeleteOrder(context.Context, *DeleteOrderRequest) (*DeleteOrderResponse, error)
+	// CART
+	AddToCart(context.Context, *AddToCartRequest) (*CartResponse, error)
+	GetCartByUser(context.Context, *GetCartRequest) (*CartListResponse, error)
+	UpdateCartQuantity(context.Context, *UpdateCartQuantityRequest) (*CartResponse, error)
+	RemoveFromCart(context.Context, *RemoveFromCartRequest) (*CartResponse, error)
+	ClearCart(context.Context, *ClearCartRequest) (*CartResponse, error)
+	mustEmbedUnimplementedCollageProjectServer()
+}
+
+// UnimplementedCollageProjectServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedCollageProjectServer struct{}
+
+func (UnimplementedCollageProjectServer) SignUpUser(context.Context, *SignUpRequest) (*AuthResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SignUpUser not implemented")
+}
+func (UnimplementedCollageProjectServer) LoginUser(context.Context, *LoginRequest) (*AuthResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method LoginUser not implemented")
+}
+func (UnimplementedCollageProjectServer) GetUserByID(context.Context, *GetUserRequest) (*UserResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetUserByID not implemented")
+}
+func (UnimplementedCollageProjectServer) GetUserByEmail(context.Context, *GetUserByEmailRequest) (*UserResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetUserByEmail not implemented")
+}
+func (UnimplementedCollageProjectServer) UpdateUser(context.Context, *UpdateUserRequest) (*UserResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateUser not implemented")
+}
+func (UnimplementedCollageProjectServer) DeleteUser(context.Context, *DeleteUserRequest) (*DeleteUserResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteUser not implemented")
+}
+func (UnimplementedCollageProjectServer) RefreshToken(context.Context, *RefreshTokenRequest) (*RefreshTokenResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RefreshToken not implemented")
+}
+func (UnimplementedCollageProjectServer) CreateProduct(context.Context, *CreateProductRequest) (*ProductResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateProduct not implemented")
+}
+func (UnimplementedCollageProjectServer) GetProductByID(context.Context, *GetProductRequest) (*ProductResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetProductByID not implemented")
+}
+func (UnimplementedCollageProjectServer) GetOnlyProductRequest(context.Context, *GetProductRequest) (*ProductResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetOnlyProductRequest not implemented")
+}
+func (UnimplementedCollageProjectServer) GetProductByUserID(context.Context, *ListAllProductsByCreateBy) (*ListAllProductsByNameResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetProductByUserID not implemented")
+}
+func (UnimplementedCollageProjectServer) ListProducts(context.Context, *ListAllProductsRequest) (*ListProductsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListProducts not implemented")
+}
+func (UnimplementedCollageProjectServer) UpdateProduct(context.Context, *UpdateProductRequest) (*ProductResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateProduct not implemented")
+}
+func (UnimplementedCollageProjectServer) DeleteProduct(context.Context, *DeleteProductRequest) (*DeleteProductResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteProduct not implemented")
+}
+func (UnimplementedCollageProjectServer) PublishProduct(context.Context, *PublishProductRequest) (*ProductResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PublishProduct not implemented")
+}
+func (UnimplementedCollageProjectServer) ArchiveProduct(context.Context, *ArchiveProductRequest) (*ProductResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ArchiveProduct not implemented")
+}
+func (UnimplementedCollageProjectServer) RestoreProduct(context.Context, *RestoreProductRequest) (*ProductResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RestoreProduct not implemented")
+}
+func (UnimplementedCollageProjectServer) ImportProducts(grpc.ClientStreamingServer[ImportProductsRequest, ImportProductsResponse]) error {
+	return status.Errorf(codes.Unimplemented, "method ImportProducts not implemented")
+}
+func (UnimplementedCollageProjectServer) ExportProducts(*ExportProductsRequest, grpc.ServerStreamingServer[ExportProductsResponse]) error {
+	return status.Errorf(codes.Unimplemented, "method ExportProducts not implemented")
+}
+func (UnimplementedCollageProjectServer) ListProductsByName(context.Context, *ListAllProductsByNameRequest) (*ListAllProductsByNameResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListProductsByName not implemented")
+}
+func (UnimplementedCollageProjectServer) ListProductsByCategory(context.Context, *ListAllProductsByCategoryRequest) (*ListAllProductsByCategoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListProductsByCategory not implemented")
+}
+func (UnimplementedCollageProjectServer) ListProductsByType(context.Context, *ListAllProductsByTypeRequest) (*ListAllProductsByCategoryResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ListProductsByType not implemented")
 }
-func (UnimplementedCollageProjectServer) SearchProducts(context.Context, *SearchProductsRequest) (*SearchProductsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method SearchProducts not implemented")
+func (UnimplementedCollageProjectServer) SearchProducts(context.Context, *SearchProductsRequest) (*SearchProductsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SearchProducts not implemented")
+}
+func (UnimplementedCollageProjectServer) AutocompleteSearch(context.Context, *AutocompleteRequest) (*AutocompleteResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AutocompleteSearch not implemented")
+}
+func (UnimplementedCollageProjectServer) QueryProducts(context.Context, *QueryProductsRequest) (*QueryProductsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method QueryProducts not implemented")
+}
+func (UnimplementedCollageProjectServer) CreateCategory(context.Context, *CreateCategoryRequest) (*CategoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateCategory not implemented")
+}
+func (UnimplementedCollageProjectServer) UpdateCategory(context.Context, *UpdateCategoryRequest) (*CategoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateCategory not implemented")
+}
+func (UnimplementedCollageProjectServer) DeleteCategory(context.Context, *DeleteCategoryRequest) (*DeleteCategoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteCategory not implemented")
+}
+func (UnimplementedCollageProjectServer) GetCategoryTree(context.Context, *GetCategoryTreeRequest) (*GetCategoryTreeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetCategoryTree not implemented")
+}
+func (UnimplementedCollageProjectServer) CreateReview(context.Context, *CreateReviewRequest) (*ReviewResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateReview not implemented")
+}
+func (UnimplementedCollageProjectServer) ListProductReviews(context.Context, *ListProductReviewsRequest) (*ListProductReviewsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListProductReviews not implemented")
+}
+func (UnimplementedCollageProjectServer) MarkReviewHelpful(context.Context, *MarkReviewHelpfulRequest) (*ReviewResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MarkReviewHelpful not implemented")
+}
+func (UnimplementedCollageProjectServer) ReplyToReview(context.Context, *ReplyToReviewRequest) (*ReviewResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReplyToReview not implemented")
+}
+func (UnimplementedCollageProjectServer) AdjustStock(context.Context, *AdjustStockRequest) (*AdjustStockResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AdjustStock not implemented")
+}
+func (UnimplementedCollageProjectServer) ListStockMovements(context.Context, *ListStockMovementsRequest) (*ListStockMovementsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListStockMovements not implemented")
+}
+func (UnimplementedCollageProjectServer) SetLowStockThreshold(context.Context, *SetLowStockThresholdRequest) (*ProductResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetLowStockThreshold not implemented")
+}
+func (UnimplementedCollageProjectServer) ListLowStockProducts(context.Context, *ListLowStockProductsRequest) (*ListLowStockProductsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListLowStockProducts not implemented")
+}
+func (UnimplementedCollageProjectServer) ScheduleSale(context.Context, *ScheduleSaleRequest) (*ScheduleSaleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ScheduleSale not implemented")
+}
+func (UnimplementedCollageProjectServer) ListPriceHistory(context.Context, *ListPriceHistoryRequest) (*ListPriceHistoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListPriceHistory not implemented")
+}
+func (UnimplementedCollageProjectServer) CreateCoupon(context.Context, *CreateCouponRequest) (*CouponResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateCoupon not implemented")
+}
+func (UnimplementedCollageProjectServer) ValidateCoupon(context.Context, *ValidateCouponRequest) (*ValidateCouponResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ValidateCoupon not implemented")
+}
+func (UnimplementedCollageProjectServer) GetDownloadLink(context.Context, *GetDownloadLinkRequest) (*GetDownloadLinkResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetDownloadLink not implemented")
+}
+func (UnimplementedCollageProjectServer) AskQuestion(context.Context, *AskQuestionRequest) (*QuestionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AskQuestion not implemented")
+}
+func (UnimplementedCollageProjectServer) AnswerQuestion(context.Context, *AnswerQuestionRequest) (*AnswerResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AnswerQuestion not implemented")
+}
+func (UnimplementedCollageProjectServer) ListProductQuestions(context.Context, *ListProductQuestionsRequest) (*ListProductQuestionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListProductQuestions not implemented")
+}
+func (UnimplementedCollageProjectServer) UpvoteQuestion(context.Context, *UpvoteQuestionRequest) (*QuestionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpvoteQuestion not implemented")
+}
+func (UnimplementedCollageProjectServer) UpvoteAnswer(context.Context, *UpvoteAnswerRequest) (*AnswerResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpvoteAnswer not implemented")
+}
+func (UnimplementedCollageProjectServer) FlagQuestion(context.Context, *FlagQuestionRequest) (*FlagResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FlagQuestion not implemented")
+}
+func (UnimplementedCollageProjectServer) FlagAnswer(context.Context, *FlagAnswerRequest) (*FlagResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FlagAnswer not implemented")
+}
+func (UnimplementedCollageProjectServer) ModerateQuestion(context.Context, *ModerateQuestionRequest) (*QuestionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ModerateQuestion not implemented")
+}
+func (UnimplementedCollageProjectServer) ModerateAnswer(context.Context, *ModerateAnswerRequest) (*AnswerResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ModerateAnswer not implemented")
+}
+func (UnimplementedCollageProjectServer) ListPendingProducts(context.Context, *ListPendingProductsRequest) (*ListPendingProductsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListPendingProducts not implemented")
+}
+func (UnimplementedCollageProjectServer) ApproveProduct(context.Context, *ApproveProductRequest) (*ProductResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ApproveProduct not implemented")
+}
+func (UnimplementedCollageProjectServer) RejectProduct(context.Context, *RejectProductRequest) (*ProductResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RejectProduct not implemented")
+}
+func (UnimplementedCollageProjectServer) ListModerationLog(context.Context, *ListModerationLogRequest) (*ListModerationLogResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListModerationLog not implemented")
+}
+func (UnimplementedCollageProjectServer) GetSearchDictionary(context.Context, *GetSearchDictionaryRequest) (*SearchDictionaryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetSearchDictionary not implemented")
+}
+func (UnimplementedCollageProjectServer) CreateSynonymGroup(context.Context, *CreateSynonymGroupRequest) (*SynonymGroupResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateSynonymGroup not implemented")
+}
+func (UnimplementedCollageProjectServer) DeleteSynonymGroup(context.Context, *DeleteSynonymGroupRequest) (*SearchDictionaryMessageResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteSynonymGroup not implemented")
+}
+func (UnimplementedCollageProjectServer) AddStopWord(context.Context, *StopWordRequest) (*SearchDictionaryMessageResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddStopWord not implemented")
+}
+func (UnimplementedCollageProjectServer) RemoveStopWord(context.Context, *StopWordRequest) (*SearchDictionaryMessageResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveStopWord not implemented")
+}
+func (UnimplementedCollageProjectServer) RecordSearchClick(context.Context, *RecordSearchClickRequest) (*RecordSearchClickResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RecordSearchClick not implemented")
+}
+func (UnimplementedCollageProjectServer) TopQueries(context.Context, *SearchAnalyticsRequest) (*TopQueriesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TopQueries not implemented")
+}
+func (UnimplementedCollageProjectServer) ZeroResultQueries(context.Context, *SearchAnalyticsRequest) (*ZeroResultQueriesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ZeroResultQueries not implemented")
+}
+func (UnimplementedCollageProjectServer) SearchCTR(context.Context, *SearchAnalyticsRequest) (*SearchCTRResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SearchCTR not implemented")
+}
+func (UnimplementedCollageProjectServer) AddToWishlist(context.Context, *AddToWishlistRequest) (*WishlistResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddToWishlist not implemented")
+}
+func (UnimplementedCollageProjectServer) RemoveFromWishlist(context.Context, *RemoveFromWishlistRequest) (*WishlistResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveFromWishlist not implemented")
+}
+func (UnimplementedCollageProjectServer) ListWishlist(context.Context, *ListWishlistRequest) (*ListWishlistResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListWishlist not implemented")
+}
+func (UnimplementedCollageProjectServer) ListNotifications(context.Context, *ListNotificationsRequest) (*ListNotificationsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListNotifications not implemented")
+}
+func (UnimplementedCollageProjectServer) MarkNotificationRead(context.Context, *MarkNotificationReadRequest) (*NotificationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MarkNotificationRead not implemented")
+}
+func (UnimplementedCollageProjectServer) CreateOrder(context.Context, *CreateOrderRequest) (*OrderResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateOrder not implemented")
+}
+func (UnimplementedCollageProjectServer) GetOrderByID(context.Context, *GetOrderRequest) (*OrderResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetOrderByID not implemented")
+}
+func (UnimplementedCollageProjectServer) ListOrders(context.Context, *ListOrdersByUserRequest) (*ListOrdersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListOrders not implemented")
+}
+func (UnimplementedCollageProjectServer) UpdateOrderStatus(context.Context, *UpdateOrderStatusRequest) (*OrderResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateOrderStatus not implemented")
+}
+func (UnimplementedCollageProjectServer) DeleteOrder(context.Context, *DeleteOrderRequest) (*DeleteOrderResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteOrder not implemented")
+}
+func (UnimplementedCollageProjectServer) AddToCart(context.Context, *AddToCartRequest) (*CartResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddToCart not implemented")
+}
+func (UnimplementedCollageProjectServer) GetCartByUser(context.Context, *GetCartRequest) (*CartListResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetCartByUser not implemented")
+}
+func (UnimplementedCollageProjectServer) UpdateCartQuantity(context.Context, *UpdateCartQuantityRequest) (*CartResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateCartQuantity not implemented")
+}
+func (UnimplementedCollageProjectServer) RemoveFromCart(context.Context, *RemoveFromCartRequest) (*CartResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveFromCart not implemented")
+}
+func (UnimplementedCollageProjectServer) ClearCart(context.Context, *ClearCartRequest) (*CartResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ClearCart not implemented")
+}
+func (UnimplementedCollageProjectServer) mustEmbedUnimplementedCollageProjectServer() {}
+func (UnimplementedCollageProjectServer) testEmbeddedByValue()                        {}
+
+// UnsafeCollageProjectServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to CollageProjectServer will
+// result in compilation errors.
+type UnsafeCollageProjectServer interface {
+	mustEmbedUnimplementedCollageProjectServer()
+}
+
+func RegisterCollageProjectServer(s grpc.ServiceRegistrar, srv CollageProjectServer) {
+	// If the following call pancis, it indicates UnimplementedCollageProjectServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&CollageProject_ServiceDesc, srv)
+}
+
+func _CollageProject_SignUpUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SignUpRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CollageProjectServer).SignUpUser(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CollageProject_SignUpUser_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CollageProjectServer).SignUpUser(ctx, req.(*SignUpRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CollageProject_LoginUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LoginRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CollageProjectServer).LoginUser(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CollageProject_LoginUser_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CollageProjectServer).LoginUser(ctx, req.(*LoginRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CollageProject_GetUserByID_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetUserRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CollageProjectServer).GetUserByID(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CollageProject_GetUserByID_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CollageProjectServer).GetUserByID(ctx, req.(*GetUserRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CollageProject_GetUserByEmail_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetUserByEmailRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CollageProjectServer).GetUserByEmail(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CollageProject_GetUserByEmail_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CollageProjectServer).GetUserByEmail(ctx, req.(*GetUserByEmailRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CollageProject_UpdateUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateUserRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CollageProjectServer).UpdateUser(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CollageProject_UpdateUser_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CollageProjectServer).UpdateUser(ctx, req.(*UpdateUserRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CollageProject_DeleteUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteUserRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CollageProjectServer).DeleteUser(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CollageProject_DeleteUser_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CollageProjectServer).DeleteUser(ctx, req.(*DeleteUserRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CollageProject_RefreshToken_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RefreshTokenRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CollageProjectServer).RefreshToken(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CollageProject_RefreshToken_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CollageProjectServer).RefreshToken(ctx, req.(*RefreshTokenRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CollageProject_CreateProduct_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateProductRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CollageProjectServer).CreateProduct(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CollageProject_CreateProduct_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CollageProjectServer).CreateProduct(ctx, req.(*CreateProductRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CollageProject_GetProductByID_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetProductRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CollageProjectServer).GetProductByID(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CollageProject_GetProductByID_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CollageProjectServer).GetProductByID(ctx, req.(*GetProductRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CollageProject_GetOnlyProductRequest_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetProductRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CollageProjectServer).GetOnlyProductRequest(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CollageProject_GetOnlyProductRequest_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CollageProjectServer).GetOnlyProductRequest(ctx, req.(*GetProductRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CollageProject_GetProductByUserID_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListAllProductsByCreateBy)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CollageProjectServer).GetProductByUserID(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CollageProject_GetProductByUserID_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CollageProjectServer).GetProductByUserID(ctx, req.(*ListAllProductsByCreateBy))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CollageProject_ListProducts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListAllProductsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CollageProjectServer).ListProducts(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CollageProject_ListProducts_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CollageProjectServer).ListProducts(ctx, req.(*ListAllProductsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CollageProject_UpdateProduct_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateProductRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CollageProjectServer).UpdateProduct(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CollageProject_UpdateProduct_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CollageProjectServer).UpdateProduct(ctx, req.(*UpdateProductRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CollageProject_DeleteProduct_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteProductRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CollageProjectServer).DeleteProduct(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CollageProject_DeleteProduct_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CollageProjectServer).DeleteProduct(ctx, req.(*DeleteProductRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CollageProject_PublishProduct_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PublishProductRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CollageProjectServer).PublishProduct(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CollageProject_PublishProduct_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CollageProjectServer).PublishProduct(ctx, req.(*PublishProductRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CollageProject_ArchiveProduct_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ArchiveProductRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CollageProjectServer).ArchiveProduct(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CollageProject_ArchiveProduct_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CollageProjectServer).ArchiveProduct(ctx, req.(*ArchiveProductRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CollageProject_RestoreProduct_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RestoreProductRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CollageProjectServer).RestoreProduct(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CollageProject_RestoreProduct_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CollageProjectServer).RestoreProduct(ctx, req.(*RestoreProductRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CollageProject_ImportProducts_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(CollageProjectServer).ImportProducts(&grpc.GenericServerStream[ImportProductsRequest, ImportProductsResponse]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type CollageProject_ImportProductsServer = grpc.ClientStreamingServer[ImportProductsRequest, ImportProductsResponse]
+
+func _CollageProject_ExportProducts_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(ExportProductsRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(CollageProjectServer).ExportProducts(m, &grpc.GenericServerStream[ExportProductsRequest, ExportProductsResponse]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type CollageProject_ExportProductsServer = grpc.ServerStreamingServer[ExportProductsResponse]
+
+func _CollageProject_ListProductsByName_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListAllProductsByNameRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CollageProjectServer).ListProductsByName(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CollageProject_ListProductsByName_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CollageProjectServer).ListProductsByName(ctx, req.(*ListAllProductsByNameRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CollageProject_ListProductsByCategory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListAllProductsByCategoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CollageProjectServer).ListProductsByCategory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CollageProject_ListProductsByCategory_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CollageProjectServer).ListProductsByCategory(ctx, req.(*ListAllProductsByCategoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CollageProject_ListProductsByType_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListAllProductsByTypeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CollageProjectServer).ListProductsByType(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CollageProject_ListProductsByType_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CollageProjectServer).ListProductsByType(ctx, req.(*ListAllProductsByTypeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CollageProject_SearchProducts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SearchProductsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CollageProjectServer).SearchProducts(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CollageProject_SearchProducts_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CollageProjectServer).SearchProducts(ctx, req.(*SearchProductsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CollageProject_AutocompleteSearch_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AutocompleteRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CollageProjectServer).AutocompleteSearch(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CollageProject_AutocompleteSearch_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CollageProjectServer).AutocompleteSearch(ctx, req.(*AutocompleteRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CollageProject_QueryProducts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryProductsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CollageProjectServer).QueryProducts(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CollageProject_QueryProducts_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CollageProjectServer).QueryProducts(ctx, req.(*QueryProductsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CollageProject_CreateCategory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateCategoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CollageProjectServer).CreateCategory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CollageProject_CreateCategory_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CollageProjectServer).CreateCategory(ctx, req.(*CreateCategoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CollageProject_UpdateCategory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateCategoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CollageProjectServer).UpdateCategory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CollageProject_UpdateCategory_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CollageProjectServer).UpdateCategory(ctx, req.(*UpdateCategoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CollageProject_DeleteCategory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteCategoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CollageProjectServer).DeleteCategory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CollageProject_DeleteCategory_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CollageProjectServer).DeleteCategory(ctx, req.(*DeleteCategoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CollageProject_GetCategoryTree_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetCategoryTreeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CollageProjectServer).GetCategoryTree(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CollageProject_GetCategoryTree_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CollageProjectServer).GetCategoryTree(ctx, req.(*GetCategoryTreeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedCollageProjectServer) AutocompleteSearch(context.Context, *AutocompleteRequest) (*AutocompleteResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method AutocompleteSearch not implemented")
+
+func _CollageProject_CreateReview_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateReviewRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CollageProjectServer).CreateReview(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CollageProject_CreateReview_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CollageProjectServer).CreateReview(ctx, req.(*CreateReviewRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedCollageProjectServer) CreateOrder(context.Context, *CreateOrderRequest) (*OrderResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method CreateOrder not implemented")
+
+func _CollageProject_ListProductReviews_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListProductReviewsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CollageProjectServer).ListProductReviews(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CollageProject_ListProductReviews_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CollageProjectServer).ListProductReviews(ctx, req.(*ListProductReviewsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedCollageProjectServer) GetOrderByID(context.Context, *GetOrderRequest) (*OrderResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetOrderByID not implemented")
+
+func _CollageProject_MarkReviewHelpful_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MarkReviewHelpfulRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CollageProjectServer).MarkReviewHelpful(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CollageProject_MarkReviewHelpful_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CollageProjectServer).MarkReviewHelpful(ctx, req.(*MarkReviewHelpfulRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedCollageProjectServer) ListOrders(context.Context, *ListOrdersByUserRequest) (*ListOrdersResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ListOrders not implemented")
+
+func _CollageProject_ReplyToReview_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReplyToReviewRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CollageProjectServer).ReplyToReview(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CollageProject_ReplyToReview_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CollageProjectServer).ReplyToReview(ctx, req.(*ReplyToReviewRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedCollageProjectServer) UpdateOrderStatus(context.Context, *UpdateOrderStatusRequest) (*OrderResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method UpdateOrderStatus not implemented")
+
+func _CollageProject_AdjustStock_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AdjustStockRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CollageProjectServer).AdjustStock(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CollageProject_AdjustStock_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CollageProjectServer).AdjustStock(ctx, req.(*AdjustStockRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedCollageProjectServer) DeleteOrder(context.Context, *DeleteOrderRequest) (*DeleteOrderResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method DeleteOrder not implemented")
+
+func _CollageProject_ListStockMovements_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListStockMovementsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CollageProjectServer).ListStockMovements(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CollageProject_ListStockMovements_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CollageProjectServer).ListStockMovements(ctx, req.(*ListStockMovementsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedCollageProjectServer) AddToCart(context.Context, *AddToCartRequest) (*CartResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method AddToCart not implemented")
+
+func _CollageProject_SetLowStockThreshold_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetLowStockThresholdRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CollageProjectServer).SetLowStockThreshold(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CollageProject_SetLowStockThreshold_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CollageProjectServer).SetLowStockThreshold(ctx, req.(*SetLowStockThresholdRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedCollageProjectServer) GetCartByUser(context.Context, *GetCartRequest) (*CartListResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetCartByUser not implemented")
+
+func _CollageProject_ListLowStockProducts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListLowStockProductsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CollageProjectServer).ListLowStockProducts(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CollageProject_ListLowStockProducts_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CollageProjectServer).ListLowStockProducts(ctx, req.(*ListLowStockProductsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedCollageProjectServer) UpdateCartQuantity(context.Context, *UpdateCartQuantityRequest) (*CartResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method UpdateCartQuantity not implemented")
+
+func _CollageProject_ScheduleSale_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ScheduleSaleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CollageProjectServer).ScheduleSale(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CollageProject_ScheduleSale_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CollageProjectServer).ScheduleSale(ctx, req.(*ScheduleSaleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedCollageProjectServer) RemoveFromCart(context.Context, *RemoveFromCartRequest) (*CartResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method RemoveFromCart not implemented")
+
+func _CollageProject_ListPriceHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListPriceHistoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CollageProjectServer).ListPriceHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CollageProject_ListPriceHistory_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CollageProjectServer).ListPriceHistory(ctx, req.(*ListPriceHistoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CollageProject_CreateCoupon_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateCouponRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CollageProjectServer).CreateCoupon(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CollageProject_CreateCoupon_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CollageProjectServer).CreateCoupon(ctx, req.(*CreateCouponRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CollageProject_ValidateCoupon_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ValidateCouponRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CollageProjectServer).ValidateCoupon(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CollageProject_ValidateCoupon_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CollageProjectServer).ValidateCoupon(ctx, req.(*ValidateCouponRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CollageProject_GetDownloadLink_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetDownloadLinkRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CollageProjectServer).GetDownloadLink(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CollageProject_GetDownloadLink_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CollageProjectServer).GetDownloadLink(ctx, req.(*GetDownloadLinkRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CollageProject_AskQuestion_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AskQuestionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CollageProjectServer).AskQuestion(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CollageProject_AskQuestion_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CollageProjectServer).AskQuestion(ctx, req.(*AskQuestionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CollageProject_AnswerQuestion_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AnswerQuestionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CollageProjectServer).AnswerQuestion(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CollageProject_AnswerQuestion_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CollageProjectServer).AnswerQuestion(ctx, req.(*AnswerQuestionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CollageProject_ListProductQuestions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListProductQuestionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CollageProjectServer).ListProductQuestions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CollageProject_ListProductQuestions_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CollageProjectServer).ListProductQuestions(ctx, req.(*ListProductQuestionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CollageProject_UpvoteQuestion_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpvoteQuestionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CollageProjectServer).UpvoteQuestion(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CollageProject_UpvoteQuestion_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CollageProjectServer).UpvoteQuestion(ctx, req.(*UpvoteQuestionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CollageProject_UpvoteAnswer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpvoteAnswerRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CollageProjectServer).UpvoteAnswer(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CollageProject_UpvoteAnswer_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CollageProjectServer).UpvoteAnswer(ctx, req.(*UpvoteAnswerRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedCollageProjectServer) ClearCart(context.Context, *ClearCartRequest) (*CartResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ClearCart not implemented")
+
+func _CollageProject_FlagQuestion_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FlagQuestionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CollageProjectServer).FlagQuestion(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CollageProject_FlagQuestion_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CollageProjectServer).FlagQuestion(ctx, req.(*FlagQuestionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedCollageProjectServer) mustEmbedUnimplementedCollageProjectServer() {}
-func (UnimplementedCollageProjectServer) testEmbeddedByValue()                        {}
 
-// UnsafeCollageProjectServer may be embedded to opt out of forward compatibility for this service.
-// Use of this interface is not recommended, as added methods to CollageProjectServer will
-// result in compilation errors.
-type UnsafeCollageProjectServer interface {
-	mustEmbedUnimplementedCollageProjectServer()
+func _CollageProject_FlagAnswer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FlagAnswerRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CollageProjectServer).FlagAnswer(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CollageProject_FlagAnswer_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CollageProjectServer).FlagAnswer(ctx, req.(*FlagAnswerRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func RegisterCollageProjectServer(s grpc.ServiceRegistrar, srv CollageProjectServer) {
-	// If the following call pancis, it indicates UnimplementedCollageProjectServer was
-	// embedded by pointer and is nil.  This will cause panics if an
-	// unimplemented method is ever invoked, so we test this at initialization
-	// time to prevent it from happening at runtime later due to I/O.
-	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
-		t.testEmbeddedByValue()
+func _CollageProject_ModerateQuestion_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ModerateQuestionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	s.RegisterService(&CollageProject_ServiceDesc, srv)
+	if interceptor == nil {
+		return srv.(CollageProjectServer).ModerateQuestion(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CollageProject_ModerateQuestion_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CollageProjectServer).ModerateQuestion(ctx, req.(*ModerateQuestionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func _CollageProject_SignUpUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(SignUpRequest)
+func _CollageProject_ModerateAnswer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ModerateAnswerRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(CollageProjectServer).SignUpUser(ctx, in)
+		return srv.(CollageProjectServer).ModerateAnswer(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: CollageProject_SignUpUser_FullMethodName,
+		FullMethod: CollageProject_ModerateAnswer_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(CollageProjectServer).SignUpUser(ctx, req.(*SignUpRequest))
+		return srv.(CollageProjectServer).ModerateAnswer(ctx, req.(*ModerateAnswerRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _CollageProject_LoginUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(LoginRequest)
+func _CollageProject_ListPendingProducts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListPendingProductsRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(CollageProjectServer).LoginUser(ctx, in)
+		return srv.(CollageProjectServer).ListPendingProducts(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: CollageProject_LoginUser_FullMethodName,
+		FullMethod: CollageProject_ListPendingProducts_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(CollageProjectServer).LoginUser(ctx, req.(*LoginRequest))
+		return srv.(CollageProjectServer).ListPendingProducts(ctx, req.(*ListPendingProductsRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _CollageProject_GetUserByID_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetUserRequest)
+func _CollageProject_ApproveProduct_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ApproveProductRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(CollageProjectServer).GetUserByID(ctx, in)
+		return srv.(CollageProjectServer).ApproveProduct(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: CollageProject_GetUserByID_FullMethodName,
+		FullMethod: CollageProject_ApproveProduct_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(CollageProjectServer).GetUserByID(ctx, req.(*GetUserRequest))
+		return srv.(CollageProjectServer).ApproveProduct(ctx, req.(*ApproveProductRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _CollageProject_GetUserByEmail_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetUserByEmailRequest)
+func _CollageProject_RejectProduct_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RejectProductRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(CollageProjectServer).GetUserByEmail(ctx, in)
+		return srv.(CollageProjectServer).RejectProduct(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: CollageProject_GetUserByEmail_FullMethodName,
+		FullMethod: CollageProject_RejectProduct_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(CollageProjectServer).GetUserByEmail(ctx, req.(*GetUserByEmailRequest))
+		return srv.(CollageProjectServer).RejectProduct(ctx, req.(*RejectProductRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _CollageProject_UpdateUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(UpdateUserRequest)
+func _CollageProject_ListModerationLog_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListModerationLogRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(CollageProjectServer).UpdateUser(ctx, in)
+		return srv.(CollageProjectServer).ListModerationLog(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: CollageProject_UpdateUser_FullMethodName,
+		FullMethod: CollageProject_ListModerationLog_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(CollageProjectServer).UpdateUser(ctx, req.(*UpdateUserRequest))
+		return srv.(CollageProjectServer).ListModerationLog(ctx, req.(*ListModerationLogRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _CollageProject_DeleteUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(DeleteUserRequest)
+func _CollageProject_GetSearchDictionary_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetSearchDictionaryRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(CollageProjectServer).DeleteUser(ctx, in)
+		return srv.(CollageProjectServer).GetSearchDictionary(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: CollageProject_DeleteUser_FullMethodName,
+		FullMethod: CollageProject_GetSearchDictionary_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(CollageProjectServer).DeleteUser(ctx, req.(*DeleteUserRequest))
+		return srv.(CollageProjectServer).GetSearchDictionary(ctx, req.(*GetSearchDictionaryRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _CollageProject_RefreshToken_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(RefreshTokenRequest)
+func _CollageProject_CreateSynonymGroup_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateSynonymGroupRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(CollageProjectServer).RefreshToken(ctx, in)
+		return srv.(CollageProjectServer).CreateSynonymGroup(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: CollageProject_RefreshToken_FullMethodName,
+		FullMethod: CollageProject_CreateSynonymGroup_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(CollageProjectServer).RefreshToken(ctx, req.(*RefreshTokenRequest))
+		return srv.(CollageProjectServer).CreateSynonymGroup(ctx, req.(*CreateSynonymGroupRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _CollageProject_CreateProduct_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(CreateProductRequest)
+func _CollageProject_DeleteSynonymGroup_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteSynonymGroupRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(CollageProjectServer).CreateProduct(ctx, in)
+		return srv.(CollageProjectServer).DeleteSynonymGroup(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: CollageProject_CreateProduct_FullMethodName,
+		FullMethod: CollageProject_DeleteSynonymGroup_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(CollageProjectServer).CreateProduct(ctx, req.(*CreateProductRequest))
+		return srv.(CollageProjectServer).DeleteSynonymGroup(ctx, req.(*DeleteSynonymGroupRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _CollageProject_GetProductByID_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetProductRequest)
+func _CollageProject_AddStopWord_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(StopWordRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(CollageProjectServer).GetProductByID(ctx, in)
+		return srv.(CollageProjectServer).AddStopWord(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: CollageProject_GetProductByID_FullMethodName,
+		FullMethod: CollageProject_AddStopWord_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(CollageProjectServer).GetProductByID(ctx, req.(*GetProductRequest))
+		return srv.(CollageProjectServer).AddStopWord(ctx, req.(*StopWordRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _CollageProject_GetOnlyProductRequest_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetProductRequest)
+func _CollageProject_RemoveStopWord_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(StopWordRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(CollageProjectServer).GetOnlyProductRequest(ctx, in)
+		return srv.(CollageProjectServer).RemoveStopWord(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: CollageProject_GetOnlyProductRequest_FullMethodName,
+		FullMethod: CollageProject_RemoveStopWord_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(CollageProjectServer).GetOnlyProductRequest(ctx, req.(*GetProductRequest))
+		return srv.(CollageProjectServer).RemoveStopWord(ctx, req.(*StopWordRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _CollageProject_GetProductByUserID_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ListAllProductsByCreateBy)
+func _CollageProject_RecordSearchClick_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RecordSearchClickRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(CollageProjectServer).GetProductByUserID(ctx, in)
+		return srv.(CollageProjectServer).RecordSearchClick(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: CollageProject_GetProductByUserID_FullMethodName,
+		FullMethod: CollageProject_RecordSearchClick_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(CollageProjectServer).GetProductByUserID(ctx, req.(*ListAllProductsByCreateBy))
+		return srv.(CollageProjectServer).RecordSearchClick(ctx, req.(*RecordSearchClickRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _CollageProject_ListProducts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ListAllProductsRequest)
+func _CollageProject_TopQueries_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SearchAnalyticsRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(CollageProjectServer).ListProducts(ctx, in)
+		return srv.(CollageProjectServer).TopQueries(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: CollageProject_ListProducts_FullMethodName,
+		FullMethod: CollageProject_TopQueries_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(CollageProjectServer).ListProducts(ctx, req.(*ListAllProductsRequest))
+		return srv.(CollageProjectServer).TopQueries(ctx, req.(*SearchAnalyticsRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _CollageProject_UpdateProduct_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(UpdateProductRequest)
+func _CollageProject_ZeroResultQueries_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SearchAnalyticsRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(CollageProjectServer).UpdateProduct(ctx, in)
+		return srv.(CollageProjectServer).ZeroResultQueries(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: CollageProject_UpdateProduct_FullMethodName,
+		FullMethod: CollageProject_ZeroResultQueries_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(CollageProjectServer).UpdateProduct(ctx, req.(*UpdateProductRequest))
+		return srv.(CollageProjectServer).ZeroResultQueries(ctx, req.(*SearchAnalyticsRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _CollageProject_DeleteProduct_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(DeleteProductRequest)
+func _CollageProject_SearchCTR_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SearchAnalyticsRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(CollageProjectServer).DeleteProduct(ctx, in)
+		return srv.(CollageProjectServer).SearchCTR(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: CollageProject_DeleteProduct_FullMethodName,
+		FullMethod: CollageProject_SearchCTR_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(CollageProjectServer).DeleteProduct(ctx, req.(*DeleteProductRequest))
+		return srv.(CollageProjectServer).SearchCTR(ctx, req.(*SearchAnalyticsRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _CollageProject_ListProductsByName_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ListAllProductsByNameRequest)
+func _CollageProject_AddToWishlist_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddToWishlistRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(CollageProjectServer).ListProductsByName(ctx, in)
+		return srv.(CollageProjectServer).AddToWishlist(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: CollageProject_ListProductsByName_FullMethodName,
+		FullMethod: CollageProject_AddToWishlist_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(CollageProjectServer).ListProductsByName(ctx, req.(*ListAllProductsByNameRequest))
+		return srv.(CollageProjectServer).AddToWishlist(ctx, req.(*AddToWishlistRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _CollageProject_ListProductsByCategory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ListAllProductsByCategoryRequest)
+func _CollageProject_RemoveFromWishlist_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RemoveFromWishlistRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(CollageProjectServer).ListProductsByCategory(ctx, in)
+		return srv.(CollageProjectServer).RemoveFromWishlist(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: CollageProject_ListProductsByCategory_FullMethodName,
+		FullMethod: CollageProject_RemoveFromWishlist_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(CollageProjectServer).ListProductsByCategory(ctx, req.(*ListAllProductsByCategoryRequest))
+		return srv.(CollageProjectServer).RemoveFromWishlist(ctx, req.(*RemoveFromWishlistRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _CollageProject_ListProductsByType_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ListAllProductsByTypeRequest)
+func _CollageProject_ListWishlist_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListWishlistRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(CollageProjectServer).ListProductsByType(ctx, in)
+		return srv.(CollageProjectServer).ListWishlist(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: CollageProject_ListProductsByType_FullMethodName,
+		FullMethod: CollageProject_ListWishlist_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(CollageProjectServer).ListProductsByType(ctx, req.(*ListAllProductsByTypeRequest))
+		return srv.(CollageProjectServer).ListWishlist(ctx, req.(*ListWishlistRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _CollageProject_SearchProducts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(SearchProductsRequest)
+func _CollageProject_ListNotifications_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListNotificationsRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(CollageProjectServer).SearchProducts(ctx, in)
+		return srv.(CollageProjectServer).ListNotifications(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: CollageProject_SearchProducts_FullMethodName,
+		FullMethod: CollageProject_ListNotifications_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(CollageProjectServer).SearchProducts(ctx, req.(*SearchProductsRequest))
+		return srv.(CollageProjectServer).ListNotifications(ctx, req.(*ListNotificationsRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _CollageProject_AutocompleteSearch_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(AutocompleteRequest)
+func _CollageProject_MarkNotificationRead_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MarkNotificationReadRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(CollageProjectServer).AutocompleteSearch(ctx, in)
+		return srv.(CollageProjectServer).MarkNotificationRead(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: CollageProject_AutocompleteSearch_FullMethodName,
+		FullMethod: CollageProject_MarkNotificationRead_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(CollageProjectServer).AutocompleteSearch(ctx, req.(*AutocompleteRequest))
+		return srv.(CollageProjectServer).MarkNotificationRead(ctx, req.(*MarkNotificationReadRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
@@ -1127,6 +2851,18 @@ var CollageProject_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "DeleteProduct",
 			Handler:    _CollageProject_DeleteProduct_Handler,
 		},
+		{
+			MethodName: "PublishProduct",
+			Handler:    _CollageProject_PublishProduct_Handler,
+		},
+		{
+			MethodName: "ArchiveProduct",
+			Handler:    _CollageProject_ArchiveProduct_Handler,
+		},
+		{
+			MethodName: "RestoreProduct",
+			Handler:    _CollageProject_RestoreProduct_Handler,
+		},
 		{
 			MethodName: "ListProductsByName",
 			Handler:    _CollageProject_ListProductsByName_Handler,
@@ -1147,6 +2883,186 @@ var CollageProject_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "AutocompleteSearch",
 			Handler:    _CollageProject_AutocompleteSearch_Handler,
 		},
+		{
+			MethodName: "QueryProducts",
+			Handler:    _CollageProject_QueryProducts_Handler,
+		},
+		{
+			MethodName: "CreateCategory",
+			Handler:    _CollageProject_CreateCategory_Handler,
+		},
+		{
+			MethodName: "UpdateCategory",
+			Handler:    _CollageProject_UpdateCategory_Handler,
+		},
+		{
+			MethodName: "DeleteCategory",
+			Handler:    _CollageProject_DeleteCategory_Handler,
+		},
+		{
+			MethodName: "GetCategoryTree",
+			Handler:    _CollageProject_GetCategoryTree_Handler,
+		},
+		{
+			MethodName: "CreateReview",
+			Handler:    _CollageProject_CreateReview_Handler,
+		},
+		{
+			MethodName: "ListProductReviews",
+			Handler:    _CollageProject_ListProductReviews_Handler,
+		},
+		{
+			MethodName: "MarkReviewHelpful",
+			Handler:    _CollageProject_MarkReviewHelpful_Handler,
+		},
+		{
+			MethodName: "ReplyToReview",
+			Handler:    _CollageProject_ReplyToReview_Handler,
+		},
+		{
+			MethodName: "AdjustStock",
+			Handler:    _CollageProject_AdjustStock_Handler,
+		},
+		{
+			MethodName: "ListStockMovements",
+			Handler:    _CollageProject_ListStockMovements_Handler,
+		},
+		{
+			MethodName: "SetLowStockThreshold",
+			Handler:    _CollageProject_SetLowStockThreshold_Handler,
+		},
+		{
+			MethodName: "ListLowStockProducts",
+			Handler:    _CollageProject_ListLowStockProducts_Handler,
+		},
+		{
+			MethodName: "ScheduleSale",
+			Handler:    _CollageProject_ScheduleSale_Handler,
+		},
+		{
+			MethodName: "ListPriceHistory",
+			Handler:    _CollageProject_ListPriceHistory_Handler,
+		},
+		{
+			MethodName: "CreateCoupon",
+			Handler:    _CollageProject_CreateCoupon_Handler,
+		},
+		{
+			MethodName: "ValidateCoupon",
+			Handler:    _CollageProject_ValidateCoupon_Handler,
+		},
+		{
+			MethodName: "GetDownloadLink",
+			Handler:    _CollageProject_GetDownloadLink_Handler,
+		},
+		{
+			MethodName: "AskQuestion",
+			Handler:    _CollageProject_AskQuestion_Handler,
+		},
+		{
+			MethodName: "AnswerQuestion",
+			Handler:    _CollageProject_AnswerQuestion_Handler,
+		},
+		{
+			MethodName: "ListProductQuestions",
+			Handler:    _CollageProject_ListProductQuestions_Handler,
+		},
+		{
+			MethodName: "UpvoteQuestion",
+			Handler:    _CollageProject_UpvoteQuestion_Handler,
+		},
+		{
+			MethodName: "UpvoteAnswer",
+			Handler:    _CollageProject_UpvoteAnswer_Handler,
+		},
+		{
+			MethodName: "FlagQuestion",
+			Handler:    _CollageProject_FlagQuestion_Handler,
+		},
+		{
+			MethodName: "FlagAnswer",
+			Handler:    _CollageProject_FlagAnswer_Handler,
+		},
+		{
+			MethodName: "ModerateQuestion",
+			Handler:    _CollageProject_ModerateQuestion_Handler,
+		},
+		{
+			MethodName: "ModerateAnswer",
+			Handler:    _CollageProject_ModerateAnswer_Handler,
+		},
+		{
+			MethodName: "ListPendingProducts",
+			Handler:    _CollageProject_ListPendingProducts_Handler,
+		},
+		{
+			MethodName: "ApproveProduct",
+			Handler:    _CollageProject_ApproveProduct_Handler,
+		},
+		{
+			MethodName: "RejectProduct",
+			Handler:    _CollageProject_RejectProduct_Handler,
+		},
+		{
+			MethodName: "ListModerationLog",
+			Handler:    _CollageProject_ListModerationLog_Handler,
+		},
+		{
+			MethodName: "GetSearchDictionary",
+			Handler:    _CollageProject_GetSearchDictionary_Handler,
+		},
+		{
+			MethodName: "CreateSynonymGroup",
+			Handler:    _CollageProject_CreateSynonymGroup_Handler,
+		},
+		{
+			MethodName: "DeleteSynonymGroup",
+			Handler:    _CollageProject_DeleteSynonymGroup_Handler,
+		},
+		{
+			MethodName: "AddStopWord",
+			Handler:    _CollageProject_AddStopWord_Handler,
+		},
+		{
+			MethodName: "RemoveStopWord",
+			Handler:    _CollageProject_RemoveStopWord_Handler,
+		},
+		{
+			MethodName: "RecordSearchClick",
+			Handler:    _CollageProject_RecordSearchClick_Handler,
+		},
+		{
+			MethodName: "TopQueries",
+			Handler:    _CollageProject_TopQueries_Handler,
+		},
+		{
+			MethodName: "ZeroResultQueries",
+			Handler:    _CollageProject_ZeroResultQueries_Handler,
+		},
+		{
+			MethodName: "SearchCTR",
+			Handler:    _CollageProject_SearchCTR_Handler,
+		},
+		{
+			MethodName: "AddToWishlist",
+			Handler:    _CollageProject_AddToWishlist_Handler,
+		},
+		{
+			MethodName: "RemoveFromWishlist",
+			Handler:    _CollageProject_RemoveFromWishlist_Handler,
+		},
+		{
+			MethodName: "ListWishlist",
+			Handler:    _CollageProject_ListWishlist_Handler,
+		},
+		{
+			MethodName: "ListNotifications",
+			Handler:    _CollageProject_ListNotifications_Handler,
+		},
+		{
+			MethodName: "MarkNotificationRead",
+			Handler:    _CollageProject_MarkNotificationRead_Handler,
+		},
 		{
 			MethodName: "CreateOrder",
 			Handler:    _CollageProject_CreateOrder_Handler,
@@ -1188,6 +3104,17 @@ var CollageProject_ServiceDesc = grpc.ServiceDesc{
 			Handler:    _CollageProject_ClearCart_Handler,
 		},
 	},
-	Streams:  []grpc.StreamDesc{},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "ImportProducts",
+			Handler:       _CollageProject_ImportProducts_Handler,
+			ClientStreams: true,
+		},
+		{
+			StreamName:    "ExportProducts",
+			Handler:       _CollageProject_ExportProducts_Handler,
+			ServerStreams: true,
+		},
+	},
 	Metadata: "service_collage_project.proto",
 }
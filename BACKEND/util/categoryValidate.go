@@ -0,0 +1,60 @@
+package util
+
+import (
+	"errors"
+	"regexp"
+	"strings"
+
+	"github.com/siddheshRajendraNimbalkar/collage-prject-backend/pb"
+)
+
+var slugSeparator = regexp.MustCompile(`[^a-z0-9]+`)
+
+// Slugify turns a display name such as "Books & Notes" into "books-notes".
+// It must stay in sync with the mapping in 000004_categories.up.sql.
+func Slugify(s string) string {
+	slug := slugSeparator.ReplaceAllString(strings.ToLower(strings.TrimSpace(s)), "-")
+	return strings.Trim(slug, "-")
+}
+
+func ValidateCreateCategoryInput(req *pb.CreateCategoryRequest) error {
+	return validateCategory(req.GetName(), req.GetSlug(), req.GetIcon())
+}
+
+func ValidateUpdateCategoryInput(req *pb.UpdateCategoryRequest) error {
+	if req.GetId() == "" {
+		return errors.New("category ID is required")
+	}
+	if req.GetParentId() != "" && req.GetParentId() == req.GetId() {
+		return errors.New("category cannot be its own parent")
+	}
+	return validateCategory(req.GetName(), req.GetSlug(), req.GetIcon())
+}
+
+func validateCategory(name, slug, icon string) error {
+	// Name validation
+	if len(strings.TrimSpace(name)) == 0 {
+		return errors.New("name cannot be empty")
+	}
+	if len(name) > 100 {
+		return errors.New("name must not exceed 100 characters")
+	}
+
+	// Slug validation (derived from name when empty)
+	if slug == "" {
+		slug = Slugify(name)
+	}
+	if slug == "" || slug != Slugify(slug) {
+		return errors.New("slug may only contain lowercase letters, digits and dashes")
+	}
+	if len(slug) > 100 {
+		return errors.New("slug must not exceed 100 characters")
+	}
+
+	// Icon validation (optional, an icon name or image URL)
+	if len(icon) > 255 {
+		return errors.New("icon must not exceed 255 characters")
+	}
+
+	return nil
+}
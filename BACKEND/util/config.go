@@ -3,15 +3,28 @@ package util
 import "github.com/spf13/viper"
 
 type Config struct {
-	DBDriver              string `mapstructure:"DBDRIVE"`
-	DBSource              string `mapstructure:"DBSOURCE"`
-	Addr                  string `mapstructure:"ADDR"`
-	SecretKey             string `mapstructure:"SECRET_KEY"`
-	REFRESHTOKENEXPIRESIN string `mapstructure:"REFRESH_TOKEN_EXPIRES_IN"`
-	ACCESSTOKENEXPIRESIN  string `mapstructure:"ACCESS_TOKEN_EXPIRES_IN"`
-	APIADDR               string `mapstructure:"APIADDR"`
-	RedisURL              string `mapstructure:"REDIS_URL"`
-	EnableGPT5            bool   `mapstructure:"ENABLE_GPT5"`
+	DBDriver              string  `mapstructure:"DBDRIVE"`
+	DBSource              string  `mapstructure:"DBSOURCE"`
+	Addr                  string  `mapstructure:"ADDR"`
+	SecretKey             string  `mapstructure:"SECRET_KEY"`
+	REFRESHTOKENEXPIRESIN string  `mapstructure:"REFRESH_TOKEN_EXPIRES_IN"`
+	ACCESSTOKENEXPIRESIN  string  `mapstructure:"ACCESS_TOKEN_EXPIRES_IN"`
+	APIADDR               string  `mapstructure:"APIADDR"`
+	RedisURL              string  `mapstructure:"REDIS_URL"`
+	EnableGPT5            bool    `mapstructure:"ENABLE_GPT5"`
+	SMTPAddr              string  `mapstructure:"SMTP_ADDR"`
+	SMTPUsername          string  `mapstructure:"SMTP_USERNAME"`
+	SMTPPassword          string  `mapstructure:"SMTP_PASSWORD"`
+	EmailFrom             string  `mapstructure:"EMAIL_FROM"`
+	StorageDir            string  `mapstructure:"STORAGE_DIR"`
+	PublicURL             string  `mapstructure:"PUBLIC_URL"`
+	DownloadLinkExpiresIn string  `mapstructure:"DOWNLOAD_LINK_EXPIRES_IN"`
+	ModerationEnabled     bool    `mapstructure:"MODERATION_ENABLED"`
+	BannedWords           string  `mapstructure:"MODERATION_BANNED_WORDS"`
+	BlockedHosts          string  `mapstructure:"MODERATION_BLOCKED_HOSTS"`
+	SearchLanguage        string  `mapstructure:"SEARCH_LANGUAGE"`
+	SimilarityThreshold   float64 `mapstructure:"SEARCH_SIMILARITY_THRESHOLD"`
+	FuzzyMinResults       int     `mapstructure:"SEARCH_FUZZY_MIN_RESULTS"`
 }
 
 func LoadConfig(path string) (config Config, err error) {
@@ -0,0 +1,72 @@
+package util
+
+import (
+	"errors"
+	"regexp"
+	"strings"
+
+	"github.com/siddheshRajendraNimbalkar/collage-prject-backend/pb"
+)
+
+var couponCodePattern = regexp.MustCompile(`^[A-Z0-9_-]{3,50}$`)
+
+// NormalizeCouponCode makes codes case-insensitive, they are stored upper case.
+func NormalizeCouponCode(code string) string {
+	return strings.ToUpper(strings.TrimSpace(code))
+}
+
+func ValidateCreateCouponInput(req *pb.CreateCouponRequest) error {
+	// Code validation
+	if !couponCodePattern.MatchString(NormalizeCouponCode(req.GetCode())) {
+		return errors.New("code must be 3 to 50 letters, digits, dashes or underscores")
+	}
+
+	// Discount validation
+	switch req.GetDiscountType() {
+	case "percentage":
+		if req.GetAmount() <= 0 || req.GetAmount() > 100 {
+			return errors.New("percentage must be between 0 and 100")
+		}
+	case "fixed":
+		if req.GetAmount() <= 0 {
+			return errors.New("amount must be greater than zero")
+		}
+	default:
+		return errors.New("discount type must be percentage or fixed")
+	}
+
+	if req.GetMinOrderValue() < 0 {
+		return errors.New("minimum order value cannot be negative")
+	}
+
+	// Usage limits (0 means unlimited)
+	if req.GetMaxUses() < 0 || req.GetMaxUsesPerUser() < 0 {
+		return errors.New("usage limits cannot be negative")
+	}
+
+	// Scope validation
+	switch req.GetScope() {
+	case "seller":
+	case "category", "product":
+		if req.GetScopeId() == "" {
+			return errors.New("scope ID is required for category and product coupons")
+		}
+	default:
+		return errors.New("scope must be seller, category or product")
+	}
+
+	return nil
+}
+
+func ValidateValidateCouponInput(req *pb.ValidateCouponRequest) error {
+	if NormalizeCouponCode(req.GetCode()) == "" {
+		return errors.New("code is required")
+	}
+	if req.GetProductId() == "" {
+		return errors.New("product ID is required")
+	}
+	if req.GetQuantity() <= 0 {
+		return errors.New("quantity must be greater than zero")
+	}
+	return nil
+}
@@ -0,0 +1,23 @@
+package util
+
+import (
+	"errors"
+	"strings"
+)
+
+func ValidateDigitalAssetInput(fileName string, downloadLimit int32) error {
+	// File name validation
+	if strings.TrimSpace(fileName) == "" {
+		return errors.New("file name is required")
+	}
+	if len(fileName) > 255 {
+		return errors.New("file name should not exceed 255 characters")
+	}
+
+	// Download limit validation (per order)
+	if downloadLimit < 1 || downloadLimit > 100 {
+		return errors.New("download limit must be between 1 and 100")
+	}
+
+	return nil
+}
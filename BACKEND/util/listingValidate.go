@@ -0,0 +1,50 @@
+package util
+
+import (
+	"errors"
+
+	"github.com/siddheshRajendraNimbalkar/collage-prject-backend/pb"
+)
+
+var validProductSorts = map[string]bool{
+	"":           true,
+	"newest":     true,
+	"price_asc":  true,
+	"price_desc": true,
+	"popularity": true,
+	"rating":     true,
+	"relevance":  true,
+}
+
+func ValidateQueryProductsInput(req *pb.QueryProductsRequest) error {
+	if !validProductSorts[req.GetSort()] {
+		return errors.New("sort must be newest, price_asc, price_desc, popularity, rating or relevance")
+	}
+	if req.GetSort() == "relevance" && req.GetQuery() == "" {
+		return errors.New("relevance sort needs a query")
+	}
+
+	// Price range validation
+	if req.GetMinPrice() < 0 || req.GetMaxPrice() < 0 {
+		return errors.New("price bounds cannot be negative")
+	}
+	if req.GetMaxPrice() > 0 && req.GetMinPrice() > req.GetMaxPrice() {
+		return errors.New("min price cannot be greater than max price")
+	}
+
+	if req.GetMinRating() < 0 || req.GetMinRating() > 5 {
+		return errors.New("min rating must be between 0 and 5")
+	}
+
+	bounds := req.GetPriceBounds()
+	if len(bounds) > 20 {
+		return errors.New("at most 20 price bounds are allowed")
+	}
+	for i, bound := range bounds {
+		if bound < 0 || (i > 0 && bound <= bounds[i-1]) {
+			return errors.New("price bounds must be ascending and not negative")
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,34 @@
+package util
+
+import (
+	"errors"
+	"strings"
+
+	"github.com/siddheshRajendraNimbalkar/collage-prject-backend/pb"
+)
+
+func ValidateApproveProductInput(req *pb.ApproveProductRequest) error {
+	if req.GetId() == "" {
+		return errors.New("product ID is required")
+	}
+	if len(req.GetReason()) > 500 {
+		return errors.New("reason must not exceed 500 characters")
+	}
+	return nil
+}
+
+func ValidateRejectProductInput(req *pb.RejectProductRequest) error {
+	if req.GetId() == "" {
+		return errors.New("product ID is required")
+	}
+
+	// Reason validation (the seller needs to know what to fix)
+	if len(strings.TrimSpace(req.GetReason())) == 0 {
+		return errors.New("reason is required")
+	}
+	if len(req.GetReason()) > 500 {
+		return errors.New("reason must not exceed 500 characters")
+	}
+
+	return nil
+}
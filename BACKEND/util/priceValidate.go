@@ -0,0 +1,25 @@
+package util
+
+import (
+	"errors"
+
+	"github.com/siddheshRajendraNimbalkar/collage-prject-backend/pb"
+)
+
+func ValidateScheduleSaleInput(req *pb.ScheduleSaleRequest) error {
+	if req.GetProductId() == "" {
+		return errors.New("product ID is required")
+	}
+
+	// Price validation
+	if req.GetSalePrice() <= 0 {
+		return errors.New("sale price must be greater than zero")
+	}
+
+	// Window validation, the start defaults to now
+	if req.GetEndsAt() == "" {
+		return errors.New("end time is required")
+	}
+
+	return nil
+}
@@ -25,13 +25,18 @@ func ValidateCreateProductInput(req *pb.CreateProductRequest) error {
 		return fmt.Errorf("stock cannot be negative")
 	}
 
-	// Category validation
-	if len(req.GetCategory()) == 0 {
+	// Low stock threshold validation
+	if req.GetLowStockThreshold() < 0 {
+		return fmt.Errorf("low stock threshold cannot be negative")
+	}
+
+	// Category validation (category_id or a category/type pair)
+	if len(req.GetCategoryId()) == 0 && len(req.GetCategory()) == 0 {
 		return fmt.Errorf("category cannot be empty")
 	}
 
 	// Type validation
-	if len(req.GetType()) == 0 {
+	if len(req.GetCategoryId()) == 0 && len(req.GetType()) == 0 {
 		return fmt.Errorf("type cannot be empty")
 	}
 
@@ -45,6 +50,60 @@ func ValidateCreateProductInput(req *pb.CreateProductRequest) error {
 		return fmt.Errorf("invalid product URL")
 	}
 
+	// Kind validation (bundles are made of other products, neither they nor
+	// digital products hold stock of their own)
+	switch req.GetKind() {
+	case "", "physical":
+		if len(req.GetBundleItems()) > 0 {
+			return fmt.Errorf("only bundles can have bundle items")
+		}
+	case "bundle":
+		if req.GetStock() != 0 {
+			return fmt.Errorf("bundle stock is derived from its components")
+		}
+		if err := validateBundleItems(req.GetBundleItems()); err != nil {
+			return err
+		}
+	case "digital":
+		if req.GetStock() != 0 {
+			return fmt.Errorf("digital products do not hold stock")
+		}
+		if len(req.GetBundleItems()) > 0 {
+			return fmt.Errorf("only bundles can have bundle items")
+		}
+	default:
+		return fmt.Errorf("kind must be physical, bundle or digital")
+	}
+
+	// Status validation (new products can't start archived)
+	validStatuses := map[string]bool{"": true, "draft": true, "published": true}
+	if !validStatuses[req.GetStatus()] {
+		return fmt.Errorf("status must be draft or published")
+	}
+
+	return nil
+}
+
+func validateBundleItems(items []*pb.BundleItem) error {
+	if len(items) == 0 || len(items) > 20 {
+		return fmt.Errorf("a bundle needs between 1 and 20 components")
+	}
+
+	seen := make(map[string]bool, len(items))
+	for _, item := range items {
+		if item.GetProductId() == "" {
+			return fmt.Errorf("bundle component product ID is required")
+		}
+		if seen[item.GetProductId()] {
+			return fmt.Errorf("bundle component %s is listed twice", item.GetProductId())
+		}
+		seen[item.GetProductId()] = true
+
+		if item.GetQuantity() <= 0 {
+			return fmt.Errorf("bundle component quantity must be greater than zero")
+		}
+	}
+
 	return nil
 }
 
@@ -82,13 +141,13 @@ func ValidateUpdateProductInput(req *pb.UpdateProductRequest) error {
 		return fmt.Errorf("stock cannot be negative")
 	}
 
-	// Category validation
-	if len(req.GetCategory()) == 0 {
+	// Category validation (category_id or a category/type pair)
+	if len(req.GetCategoryId()) == 0 && len(req.GetCategory()) == 0 {
 		return fmt.Errorf("category cannot be empty")
 	}
 
 	// Type validation
-	if len(req.GetType()) == 0 {
+	if len(req.GetCategoryId()) == 0 && len(req.GetType()) == 0 {
 		return fmt.Errorf("type cannot be empty")
 	}
 
@@ -104,3 +163,24 @@ func ValidateUpdateProductInput(req *pb.UpdateProductRequest) error {
 
 	return nil
 }
+
+// updatableProductFields are the FieldMask paths accepted by UpdateProduct
+var updatableProductFields = map[string]bool{
+	"name":        true,
+	"description": true,
+	"price":       true,
+	"stock":       true,
+	"product_url": true,
+	"category":    true,
+	"type":        true,
+	"category_id": true,
+}
+
+func ValidateUpdateProductMask(paths []string) error {
+	for _, path := range paths {
+		if !updatableProductFields[path] {
+			return fmt.Errorf("field %q cannot be updated", path)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,51 @@
+package util
+
+import (
+	"errors"
+	"strings"
+
+	"github.com/siddheshRajendraNimbalkar/collage-prject-backend/pb"
+)
+
+func ValidateAskQuestionInput(req *pb.AskQuestionRequest) error {
+	if req.GetProductId() == "" {
+		return errors.New("product ID is required")
+	}
+
+	// Body validation
+	if len(strings.TrimSpace(req.GetBody())) == 0 {
+		return errors.New("question cannot be empty")
+	}
+	if len(req.GetBody()) > 500 {
+		return errors.New("question must not exceed 500 characters")
+	}
+
+	return nil
+}
+
+func ValidateAnswerQuestionInput(req *pb.AnswerQuestionRequest) error {
+	if req.GetQuestionId() == "" {
+		return errors.New("question ID is required")
+	}
+
+	// Body validation
+	if len(strings.TrimSpace(req.GetBody())) == 0 {
+		return errors.New("answer cannot be empty")
+	}
+	if len(req.GetBody()) > 2000 {
+		return errors.New("answer must not exceed 2000 characters")
+	}
+
+	return nil
+}
+
+// ValidateFlagReason returns the reason to store, "other" when none is given.
+func ValidateFlagReason(reason string) (string, error) {
+	switch reason {
+	case "":
+		return "other", nil
+	case "spam", "offensive", "off_topic", "other":
+		return reason, nil
+	}
+	return "", errors.New("reason must be spam, offensive, off_topic or other")
+}
@@ -0,0 +1,57 @@
+package util
+
+import (
+	"errors"
+	"strings"
+
+	"github.com/siddheshRajendraNimbalkar/collage-prject-backend/pb"
+)
+
+func ValidateCreateReviewInput(req *pb.CreateReviewRequest) error {
+	if req.GetProductId() == "" {
+		return errors.New("product ID is required")
+	}
+
+	// Rating validation
+	if req.GetRating() < 1 || req.GetRating() > 5 {
+		return errors.New("rating must be between 1 and 5")
+	}
+
+	// Title validation
+	if len(strings.TrimSpace(req.GetTitle())) == 0 {
+		return errors.New("title cannot be empty")
+	}
+	if len(req.GetTitle()) > 150 {
+		return errors.New("title must not exceed 150 characters")
+	}
+
+	// Body validation
+	if len(req.GetBody()) > 2000 {
+		return errors.New("review must not exceed 2000 characters")
+	}
+
+	// Images validation (at most 5 image URLs)
+	if len(req.GetImages()) > 5 {
+		return errors.New("a review can have at most 5 images")
+	}
+	for _, image := range req.GetImages() {
+		if err := validateURL(image); err != nil {
+			return errors.New("invalid review image URL")
+		}
+	}
+
+	return nil
+}
+
+func ValidateReplyToReviewInput(req *pb.ReplyToReviewRequest) error {
+	if req.GetReviewId() == "" {
+		return errors.New("review ID is required")
+	}
+	if len(strings.TrimSpace(req.GetReply())) == 0 {
+		return errors.New("reply cannot be empty")
+	}
+	if len(req.GetReply()) > 2000 {
+		return errors.New("reply must not exceed 2000 characters")
+	}
+	return nil
+}
@@ -0,0 +1,32 @@
+package util
+
+import (
+	"errors"
+
+	"github.com/siddheshRajendraNimbalkar/collage-prject-backend/pb"
+)
+
+func ValidateRecordSearchClickInput(req *pb.RecordSearchClickRequest) error {
+	if req.GetSearchId() == "" {
+		return errors.New("search ID is required")
+	}
+	if req.GetProductId() == "" {
+		return errors.New("product ID is required")
+	}
+	if req.GetPosition() < 0 {
+		return errors.New("position cannot be negative")
+	}
+	return nil
+}
+
+func ValidateSearchAnalyticsInput(req *pb.SearchAnalyticsRequest) error {
+	switch req.GetSource() {
+	case "", "search", "autocomplete", "all":
+	default:
+		return errors.New(`source must be "search", "autocomplete" or "all"`)
+	}
+	if req.GetLimit() < 0 {
+		return errors.New("limit cannot be negative")
+	}
+	return nil
+}
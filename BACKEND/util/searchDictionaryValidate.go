@@ -0,0 +1,41 @@
+package util
+
+import (
+	"errors"
+	"strings"
+
+	"github.com/siddheshRajendraNimbalkar/collage-prject-backend/pb"
+)
+
+func ValidateCreateSynonymGroupInput(req *pb.CreateSynonymGroupRequest) error {
+	terms := req.GetTerms()
+	if len(terms) < 2 {
+		return errors.New("a synonym group needs at least two terms")
+	}
+	if len(terms) > 20 {
+		return errors.New("a synonym group must not exceed 20 terms")
+	}
+	for _, term := range terms {
+		if len(strings.TrimSpace(term)) == 0 {
+			return errors.New("terms cannot be empty")
+		}
+		if len(term) > 100 {
+			return errors.New("terms must not exceed 100 characters")
+		}
+	}
+	return nil
+}
+
+func ValidateStopWordInput(req *pb.StopWordRequest) error {
+	word := strings.TrimSpace(req.GetWord())
+	if len(word) == 0 {
+		return errors.New("word cannot be empty")
+	}
+	if len(word) > 50 {
+		return errors.New("word must not exceed 50 characters")
+	}
+	if strings.ContainsAny(word, " \t\n") {
+		return errors.New("stop words must be a single word")
+	}
+	return nil
+}
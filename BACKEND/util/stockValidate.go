@@ -0,0 +1,36 @@
+package util
+
+import (
+	"errors"
+
+	"github.com/siddheshRajendraNimbalkar/collage-prject-backend/pb"
+)
+
+func ValidateAdjustStockInput(req *pb.AdjustStockRequest) error {
+	if req.GetProductId() == "" {
+		return errors.New("product ID is required")
+	}
+
+	// Delta validation
+	if req.GetDelta() == 0 {
+		return errors.New("delta cannot be zero")
+	}
+
+	// Reason validation (sales, cancellations and imports are recorded by the server)
+	switch req.GetReason() {
+	case "", "manual_adjustment":
+	case "return":
+		if req.GetDelta() < 0 {
+			return errors.New("a return must add stock")
+		}
+	default:
+		return errors.New("reason must be manual_adjustment or return")
+	}
+
+	// Note validation
+	if len(req.GetNote()) > 500 {
+		return errors.New("note must not exceed 500 characters")
+	}
+
+	return nil
+}